@@ -7,4 +7,56 @@ var (
 	ErrNotFound = fmt.Errorf("data not found")
 	// ErrConflict is
 	ErrConflict = fmt.Errorf("conflict, data already exist")
+	// ErrInvalidSyncToken is
+	ErrInvalidSyncToken = fmt.Errorf("invalid sync token")
+	// ErrInvalidImportFile is
+	ErrInvalidImportFile = fmt.Errorf("invalid import file, header must contain name, type and price columns")
+	// ErrImportRowInvalid is
+	ErrImportRowInvalid = fmt.Errorf("import contains invalid rows, nothing was saved")
+	// ErrOutOfStock is
+	ErrOutOfStock = fmt.Errorf("menu is sold out or stock is not enough")
+	// ErrImageMandatory is
+	ErrImageMandatory = fmt.Errorf("images is mandatory")
+	// ErrImageTooLarge is
+	ErrImageTooLarge = fmt.Errorf("image is too large")
+	// ErrImageTooManyPixels is
+	ErrImageTooManyPixels = fmt.Errorf("image has too many pixels")
+	// ErrInvalidImageType is
+	ErrInvalidImageType = fmt.Errorf("unsupported image type, use jpeg, png, gif or webp")
+	// ErrInvalidImageOrder is
+	ErrInvalidImageOrder = fmt.Errorf("image order must contain every image of the menu exactly once")
+	// ErrInvalidVariantPrice is
+	ErrInvalidVariantPrice = fmt.Errorf("variant price can not be less than zero")
+	// ErrInvalidModifierGroup is
+	ErrInvalidModifierGroup = fmt.Errorf("modifier group selection limit does not fit its options")
+	// ErrInvalidModifierLink is
+	ErrInvalidModifierLink = fmt.Errorf("modifier group must exist and belong to the warteg of the menu")
+	// ErrInvalidModifierSelection is
+	ErrInvalidModifierSelection = fmt.Errorf("modifier selection does not match group rules")
+	// ErrInvalidBundleItem is
+	ErrInvalidBundleItem = fmt.Errorf("bundle item must be another single menu of the same warteg and listed once")
+	// ErrMenuInBundle is
+	ErrMenuInBundle = fmt.Errorf("menu is still used in a bundle")
+	// ErrInvalidPromotion is
+	ErrInvalidPromotion = fmt.Errorf("invalid promotion value, scope or schedule")
+	// ErrInvalidPriceSchedule is
+	ErrInvalidPriceSchedule = fmt.Errorf("effective date of price schedule must be in the future")
+	// ErrInvalidWebhook is
+	ErrInvalidWebhook = fmt.Errorf("webhook url must be http or https and secret at least 16 characters")
+	// ErrWebhookAddress is
+	ErrWebhookAddress = fmt.Errorf("webhook url must resolve to a public address")
+	// ErrInvalidSearchQuery is
+	ErrInvalidSearchQuery = fmt.Errorf("search query must contain at least one letter or digit")
+	// ErrInvalidWartegHours is
+	ErrInvalidWartegHours = fmt.Errorf("opening hours must be HH:MM, timezone an IANA name like Asia/Jakarta and closure dates YYYY-MM-DD listed once")
+	// ErrInvalidMenuWindow is
+	ErrInvalidMenuWindow = fmt.Errorf("menu window must list weekdays 0 to 6 once and start and end as HH:MM")
+	// ErrInvalidMenuStatus is
+	ErrInvalidMenuStatus = fmt.Errorf("publish_at must be in the future and is only allowed for draft menus")
+	// ErrPriceChangePending is
+	ErrPriceChangePending = fmt.Errorf("menu already has a price change waiting for approval")
+	// ErrPriceChangeReviewer is
+	ErrPriceChangeReviewer = fmt.Errorf("price change must be reviewed by an identified actor other than its requester")
+	// ErrActorRequired is
+	ErrActorRequired = fmt.Errorf("staff requests must carry the id of an identified actor")
 )
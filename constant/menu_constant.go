@@ -0,0 +1,211 @@
+package constant
+
+const (
+	// MenuCreated is change type recorded when menu is added
+	MenuCreated = "created"
+	// MenuUpdated is change type recorded when menu is updated
+	MenuUpdated = "updated"
+	// MenuDeleted is change type recorded when menu is deleted
+	MenuDeleted = "deleted"
+
+	// MenuChangesLimit is maximum number of change log rows read per sync request
+	MenuChangesLimit = 500
+	// MenuListLimit is default number of menus in a list
+	MenuListLimit = 50
+	// MenuListStreamBatch is number of menus read per page when a list is streamed, the stream has no limit
+	MenuListStreamBatch = 500
+
+	// ImportRowValid is status of import row that passed validation
+	ImportRowValid = "valid"
+	// ImportRowInvalid is status of import row that failed validation
+	ImportRowInvalid = "invalid"
+	// ImportRowCreated is status of import row that has been inserted
+	ImportRowCreated = "created"
+
+	// MaxImageSize is default maximum upload size of menu image in bytes
+	MaxImageSize = 5 << 20
+	// ThumbnailWidth is default width of menu image thumbnail in pixel
+	ThumbnailWidth = 320
+	// MaxImagePixels is default maximum width times height of menu image
+	MaxImagePixels = 40000000
+
+	// VariantPriceDelta is variant price type added to menu price
+	VariantPriceDelta = "delta"
+	// VariantPriceAbsolute is variant price type replacing menu price
+	VariantPriceAbsolute = "absolute"
+
+	// PromotionPercentage is promotion type taking a percentage off menu price
+	PromotionPercentage = "percentage"
+	// PromotionFixed is promotion type taking a fixed amount off menu price
+	PromotionFixed = "fixed"
+	// PromotionBuyGet is promotion type giving free portions after buying some
+	PromotionBuyGet = "buy_x_get_y"
+
+	// PromotionScopeMenu is promotion scope of a single menu
+	PromotionScopeMenu = "menu"
+	// PromotionScopeMenuType is promotion scope of every menu of a menu type
+	PromotionScopeMenuType = "menu_type"
+	// PromotionScopeWarteg is promotion scope of every menu of a warteg
+	PromotionScopeWarteg = "warteg"
+
+	// PromotionLookbackDays is how long ended promotions are still read, their end moves last modified time of menus
+	PromotionLookbackDays = 31
+
+	// PriceSchedulePending is status of price schedule waiting for its effective date
+	PriceSchedulePending = "pending"
+	// PriceScheduleApplied is status of price schedule already set as menu price
+	PriceScheduleApplied = "applied"
+	// PriceScheduleCancelled is status of price schedule cancelled before its effective date
+	PriceScheduleCancelled = "cancelled"
+	// PriceScheduleRequested is status of due price schedule waiting for approval of its price change
+	PriceScheduleRequested = "requested"
+	// PriceScheduleRejected is status of price schedule whose price change was rejected
+	PriceScheduleRejected = "rejected"
+
+	// PriceScheduleBatch is max number of due price schedules applied in one scheduler run
+	PriceScheduleBatch = 100
+
+	// PriceChangePending is status of price change waiting for approval
+	PriceChangePending = "pending"
+	// PriceChangeApproved is status of price change approved and set as menu price
+	PriceChangeApproved = "approved"
+	// PriceChangeRejected is status of price change rejected, menu price is kept
+	PriceChangeRejected = "rejected"
+
+	// PriceChangeRequestedEvent is event type written when a menu update waits for price approval
+	PriceChangeRequestedEvent = "menu.price_change_requested"
+	// PriceChangeApprovedEvent is event type written when a price change is approved
+	PriceChangeApprovedEvent = "menu.price_change_approved"
+	// PriceChangeRejectedEvent is event type written when a price change is rejected
+	PriceChangeRejectedEvent = "menu.price_change_rejected"
+
+	// MenuStatusDraft is status of menu prepared by staff and hidden from customers
+	MenuStatusDraft = "draft"
+	// MenuStatusPublished is status of menu shown to customers, menus without status are published
+	MenuStatusPublished = "published"
+	// MenuStatusArchived is status of menu taken off the menu and hidden from customers
+	MenuStatusArchived = "archived"
+
+	// PublishScheduleBatch is max number of due drafts published in one scheduler run
+	PublishScheduleBatch = 100
+
+	// BrandMenuCreated is propagation action of a branch menu created from its template
+	BrandMenuCreated = "created"
+	// BrandMenuUpdated is propagation action of a branch menu updated to its template
+	BrandMenuUpdated = "updated"
+
+	// AuditCreated is audit action of a new entity
+	AuditCreated = "created"
+	// AuditUpdated is audit action of a changed entity
+	AuditUpdated = "updated"
+	// AuditDeleted is audit action of a removed entity
+	AuditDeleted = "deleted"
+
+	// AuditEntityMenu is audited menu, snapshot is menu detail
+	AuditEntityMenu = "menu"
+	// AuditEntityMenuImage is audited menu gallery, snapshot is images of the menu
+	AuditEntityMenuImage = "menu_image"
+	// AuditEntityMenuAvailability is audited daily availability, snapshot is availability of the menu
+	AuditEntityMenuAvailability = "menu_availability"
+	// AuditEntityMenuVariant is audited menu variant
+	AuditEntityMenuVariant = "menu_variant"
+	// AuditEntityModifierGroup is audited modifier group with its options
+	AuditEntityModifierGroup = "modifier_group"
+	// AuditEntityMenuModifier is audited modifier link, snapshot is modifier groups of the menu
+	AuditEntityMenuModifier = "menu_modifier"
+	// AuditEntityMenuBundle is audited bundle, snapshot is components of the bundle menu
+	AuditEntityMenuBundle = "menu_bundle"
+	// AuditEntityPromotion is audited promotion
+	AuditEntityPromotion = "promotion"
+	// AuditEntityMenuPriceSchedule is audited scheduled menu price
+	AuditEntityMenuPriceSchedule = "menu_price_schedule"
+	// AuditEntityMenuPriceChange is audited price change waiting for approval
+	AuditEntityMenuPriceChange = "menu_price_change"
+	// AuditEntityBrand is audited franchise brand
+	AuditEntityBrand = "brand"
+	// AuditEntityBrandMenu is audited menu template of a brand
+	AuditEntityBrandMenu = "brand_menu"
+	// AuditEntityBrandWarteg is audited assignment of a branch warteg to a brand
+	AuditEntityBrandWarteg = "brand_warteg"
+	// AuditEntityWebhook is audited webhook subscription, snapshot never contains the secret
+	AuditEntityWebhook = "webhook"
+	// AuditEntityWartegLocation is audited warteg coordinates
+	AuditEntityWartegLocation = "warteg_location"
+	// AuditEntityWartegHours is audited warteg timezone, weekly opening hours and closure dates
+	AuditEntityWartegHours = "warteg_hours"
+	// AuditEntityMenuWindow is audited serving windows, snapshot is windows of the menu
+	AuditEntityMenuWindow = "menu_window"
+	// AuditEntityMenuStatus is audited publishing status, snapshot is status with publish time
+	AuditEntityMenuStatus = "menu_status"
+
+	// AuditLogLimit is default number of audit entries returned in one page
+	AuditLogLimit = 50
+	// AuditLogMaxLimit is max number of audit entries returned in one page
+	AuditLogMaxLimit = 200
+
+	// MenuEventPrefix is prefix of menu event types, followed by change type such as menu.updated
+	MenuEventPrefix = "menu."
+	// OutboxBatch is max number of outbox events read in one relay run
+	OutboxBatch = 100
+	// OutboxLeaseSeconds is how long claimed events are held by their relay, it outlasts publishing a whole batch so
+	// another instance does not publish them meanwhile
+	OutboxLeaseSeconds = 600
+	// OutboxRetentionDays is how long published events are kept in the outbox
+	OutboxRetentionDays = 7
+	// OutboxMaxAttempts is number of publish attempts of an event before it is dead lettered, later events of its
+	// menu are relayed without it
+	OutboxMaxAttempts = 12
+
+	// WebhookDeliveryPending is delivery waiting for its next attempt
+	WebhookDeliveryPending = "pending"
+	// WebhookDeliverySuccess is delivery accepted by the subscriber
+	WebhookDeliverySuccess = "success"
+	// WebhookDeliveryFailed is delivery given up after WebhookMaxAttempts, it can still be redelivered manually
+	WebhookDeliveryFailed = "failed"
+	// WebhookMaxAttempts is number of automatic attempts of a delivery
+	WebhookMaxAttempts = 8
+	// WebhookDeliveryBatch is max number of due deliveries sent in one run
+	WebhookDeliveryBatch = 100
+	// WebhookDeliveryLeaseSeconds is how long claimed deliveries are held by their sender, it outlasts posting a whole
+	// batch so another instance does not post them meanwhile
+	WebhookDeliveryLeaseSeconds = 600
+	// WebhookDeliveryLimit is default number of delivery logs returned in one page
+	WebhookDeliveryLimit = 50
+	// WebhookDeliveryMaxLimit is max number of delivery logs returned in one page
+	WebhookDeliveryMaxLimit = 200
+
+	// MenuStreamReady is stream event sent first with the position the stream starts from
+	MenuStreamReady = "ready"
+	// MenuStreamHeartbeat is stream event sent when nothing changed for a while to keep the connection open
+	MenuStreamHeartbeat = "heartbeat"
+	// MenuStreamHeartbeatSeconds is idle time before a heartbeat, the change log is read again on every heartbeat
+	// to pick up changes made by other instances
+	MenuStreamHeartbeatSeconds = 15
+	// MenuStreamRecheckSeconds is delay before the change log is read once more when a notification found no change,
+	// a notification published before its transaction committed is then still delivered without waiting for heartbeat
+	MenuStreamRecheckSeconds = 1
+
+	// GraphqlListCost is multiplier applied to the complexity of fields selected under a list field
+	GraphqlListCost = 10
+
+	// MenuSearchLimit is default number of search results
+	MenuSearchLimit = 20
+	// MenuSearchMaxLimit is max number of search results
+	MenuSearchMaxLimit = 50
+
+	// MenuSuggestMinLength is number of characters typed before suggestions are given
+	MenuSuggestMinLength = 2
+	// MenuSuggestLimit is default number of suggestions
+	MenuSuggestLimit = 10
+	// MenuSuggestMaxLimit is max number of suggestions
+	MenuSuggestMaxLimit = 20
+	// SuggestMenu is suggestion completing a menu name
+	SuggestMenu = "menu"
+	// SuggestMenuType is suggestion completing a menu type name
+	SuggestMenuType = "menu_type"
+
+	// MenuListRadius is default radius in meters of menu list near a location
+	MenuListRadius = 1000
+	// MenuListMaxRadius is max radius in meters of menu list near a location
+	MenuListMaxRadius = 50000
+)
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/cpartogi/foodmenu/module/menu"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/pkg/spreadsheet"
+	"github.com/cpartogi/foodmenu/schema/request"
+)
+
+// runImport imports menus from csv or xlsx file, usage : main import -file menu.csv -warteg_id <id> [-dry_run] [-actor <id>]
+func runImport(menuUc menu.Usecase, args []string) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	path := fs.String("file", "", "csv or xlsx file to import")
+	wartegId := fs.String("warteg_id", "", "warteg id of imported menus")
+	dryRun := fs.Bool("dry_run", false, "validate only, nothing is saved")
+	actorId := fs.String("actor", "cli", "actor recorded in price history of imported menus")
+
+	err := fs.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	if *path == "" || *wartegId == "" {
+		fs.Usage()
+		return fmt.Errorf("file and warteg_id are mandatory")
+	}
+
+	f, err := os.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	records, err := spreadsheet.ReadAll(f, spreadsheet.FormatFromFilename(*path))
+	if err != nil {
+		return err
+	}
+
+	report, err := menuUc.MenuImport(actor.NewContext(context.Background(), *actorId), request.MenuImport{
+		WartegId: *wartegId,
+		DryRun:   *dryRun,
+		Records:  records,
+	})
+
+	out := json.NewEncoder(os.Stdout)
+	out.SetIndent("", "  ")
+	if encErr := out.Encode(report); encErr != nil {
+		return encErr
+	}
+
+	return err
+}
@@ -0,0 +1,17 @@
+package init
+
+import (
+	"github.com/cpartogi/foodmenu/pkg/businessday"
+	"github.com/spf13/viper"
+	log "go.uber.org/zap"
+)
+
+// SetupBusinessClock is a function to init business day clock used for daily menu availability, wartegs with opening
+// hours keep its opening time in their own timezone
+func SetupBusinessClock() (*businessday.Clock, error) {
+	timezone := viper.GetString("availability.timezone")
+	opening := viper.GetString("availability.opening_time")
+	log.S().Info("Business day timezone: ", timezone, " opening time: ", opening)
+
+	return businessday.New(timezone, opening)
+}
@@ -0,0 +1,50 @@
+package init
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cpartogi/foodmenu/pkg/event"
+	"github.com/spf13/viper"
+	log "go.uber.org/zap"
+)
+
+// SetupEventSink is a function to init sinks receiving menu events from the outbox relay
+func SetupEventSink() (event.Sink, error) {
+	timeout := time.Duration(viper.GetInt("events.timeout")) * time.Second
+	sinks := []event.Sink{}
+
+	for _, name := range strings.Split(viper.GetString("events.sinks"), ",") {
+		name = strings.TrimSpace(name)
+		log.S().Info("Event sink: ", name)
+
+		var sink event.Sink
+		var err error
+
+		switch name {
+		case "", "stdout":
+			sink = event.NewWriter(os.Stdout)
+		case "webhook":
+			sink, err = event.NewWebhook(viper.GetString("events.webhook.url"), timeout)
+		case "nats":
+			sink, err = event.NewNATS(viper.GetString("events.nats.address"), viper.GetString("events.nats.subject_prefix"), timeout)
+		case "kafka":
+			sink, err = event.NewKafkaRest(viper.GetString("events.kafka.rest_url"), viper.GetString("events.kafka.topic"), timeout)
+		default:
+			err = event.ErrUnknownSink
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+
+	return event.Multi(sinks...), nil
+}
+
+// SetupWebhookPoster is a function to init client delivering menu events to webhook subscriptions
+func SetupWebhookPoster() event.Poster {
+	return event.NewPoster(time.Duration(viper.GetInt("webhooks.timeout")) * time.Second)
+}
@@ -0,0 +1,24 @@
+package init
+
+import (
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// SetupGrpcServer is a function to init grpc server with actor interceptors, health and reflection services
+func SetupGrpcServer() *grpc.Server {
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(actor.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(actor.StreamServerInterceptor()),
+	)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+
+	return server
+}
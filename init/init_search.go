@@ -0,0 +1,19 @@
+package init
+
+import (
+	"strings"
+
+	"github.com/cpartogi/foodmenu/pkg/search"
+	"github.com/spf13/viper"
+)
+
+// SetupSearchIndex is a function to init in memory menu search index, every synonym entry is a comma separated group
+// of equivalent phrases indexed as the first one
+func SetupSearchIndex() *search.Index {
+	groups := [][]string{}
+	for _, entry := range viper.GetStringSlice("search.synonyms") {
+		groups = append(groups, strings.Split(entry, ","))
+	}
+
+	return search.NewIndex(search.NewAnalyzer(groups))
+}
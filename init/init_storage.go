@@ -0,0 +1,34 @@
+package init
+
+import (
+	"github.com/cpartogi/foodmenu/pkg/storage"
+	"github.com/spf13/viper"
+	log "go.uber.org/zap"
+)
+
+// SetupStorage is a function to init file storage for uploaded images
+func SetupStorage() (storage.Storage, error) {
+	driver := viper.GetString("storage.driver")
+	log.S().Info("Storage driver: ", driver)
+
+	if storage.IsLocal(driver) {
+		return storage.NewLocal(
+			viper.GetString("storage.local.dir"),
+			viper.GetString("storage.local.base_url")+viper.GetString("storage.local.url_path"),
+		)
+	}
+
+	switch driver {
+	case "s3":
+		return storage.NewS3(
+			viper.GetString("storage.s3.endpoint"),
+			viper.GetString("storage.s3.access_key"),
+			viper.GetString("storage.s3.secret_key"),
+			viper.GetString("storage.s3.bucket"),
+			viper.GetString("storage.s3.base_url"),
+			viper.GetBool("storage.s3.use_ssl"),
+		)
+	}
+
+	return nil, storage.ErrUnknownDriver
+}
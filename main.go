@@ -1,12 +1,22 @@
 package main
 
 import (
+	"context"
+	"net"
 	"net/http"
+	"os"
 	"time"
+	_ "time/tzdata" // timezone database for minimal container images
 
+	_menuGraphqlHandler "github.com/cpartogi/foodmenu/module/menu/handler/graphql"
+	_menuGrpcHandler "github.com/cpartogi/foodmenu/module/menu/handler/grpc"
 	_menuHttpHandler "github.com/cpartogi/foodmenu/module/menu/handler/http"
 	_menuRepo "github.com/cpartogi/foodmenu/module/menu/store"
 	_menu "github.com/cpartogi/foodmenu/module/menu/usecase"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/pkg/pubsub"
+	"github.com/cpartogi/foodmenu/pkg/scheduler"
+	"github.com/cpartogi/foodmenu/pkg/storage"
 
 	_ "github.com/cpartogi/foodmenu/docs"
 	appInit "github.com/cpartogi/foodmenu/init"
@@ -29,6 +39,21 @@ func main() {
 		log.S().Fatal(err)
 	}
 
+	fileStorage, err := appInit.SetupStorage()
+	if err != nil {
+		log.S().Fatal(err)
+	}
+
+	businessClock, err := appInit.SetupBusinessClock()
+	if err != nil {
+		log.S().Fatal(err)
+	}
+
+	eventSink, err := appInit.SetupEventSink()
+	if err != nil {
+		log.S().Fatal(err)
+	}
+
 	// init router
 	e := echo.New()
 
@@ -36,6 +61,7 @@ func main() {
 	e.Use(middleware.Recover())
 	e.Use(middleware.RequestID())
 	e.Use(middleware.Logger())
+	e.Use(actor.Middleware())
 
 	// Routes
 	e.GET("/", func(c echo.Context) error {
@@ -47,14 +73,60 @@ func main() {
 	// DI: Repository & Usecase
 	menuRepo := _menuRepo.NewStore(mysqlDb.DB)
 
-	menuUc := _menu.NewMenuUsecase(menuRepo, timeoutContext)
+	priceApproval := viper.GetFloat64("price_approval.threshold_percent")
+	maxPixels := viper.GetInt64("upload.max_pixels")
+
+	menuUc := _menu.NewMenuUsecase(menuRepo, fileStorage, businessClock, eventSink, appInit.SetupWebhookPoster(), pubsub.NewHub(), appInit.SetupSearchIndex(), priceApproval, maxPixels, timeoutContext)
 
 	// End of DI Stepss
 
+	// CLI commands
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		if err := runImport(menuUc, os.Args[2:]); err != nil {
+			log.S().Fatal(err)
+		}
+		return
+	}
+
+	// Background jobs
+	go scheduler.At(context.Background(), "menu availability reset", businessClock.NextOpeningAnywhere, menuUc.MenuAvailabilityReset)
+	priceInterval := time.Duration(viper.GetInt("price_schedule.interval")) * time.Second
+	go scheduler.Every(context.Background(), "menu price schedule", priceInterval, menuUc.MenuPriceScheduleRun)
+	promotionInterval := time.Duration(viper.GetInt("promotion_schedule.interval")) * time.Second
+	go scheduler.Every(context.Background(), "promotion boundary", promotionInterval, menuUc.PromotionBoundaryRun)
+	publishInterval := time.Duration(viper.GetInt("publish_schedule.interval")) * time.Second
+	go scheduler.Every(context.Background(), "menu publish schedule", publishInterval, menuUc.MenuPublishRun)
+	relayInterval := time.Duration(viper.GetInt("events.relay_interval")) * time.Second
+	go scheduler.Every(context.Background(), "menu event relay", relayInterval, menuUc.MenuEventRelay)
+	webhookInterval := time.Duration(viper.GetInt("webhooks.interval")) * time.Second
+	go scheduler.Every(context.Background(), "webhook delivery", webhookInterval, menuUc.WebhookDeliveryRun)
+	searchInterval := time.Duration(viper.GetInt("search.sync_interval")) * time.Second
+	go scheduler.Every(context.Background(), "menu search sync", searchInterval, menuUc.MenuSearchSync)
+
 	_menuHttpHandler.NewMenuHandler(e, menuUc)
+	_menuGraphqlHandler.NewMenuHandler(e, menuUc)
 
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
 
+	// uploaded files, only used by local storage driver
+	if storage.IsLocal(viper.GetString("storage.driver")) {
+		e.Static(viper.GetString("storage.local.url_path"), viper.GetString("storage.local.dir"))
+	}
+
+	// grpc server shares the usecase with http
+	grpcServer := appInit.SetupGrpcServer()
+	_menuGrpcHandler.NewMenuServer(grpcServer, menuUc)
+
+	lis, err := net.Listen("tcp", viper.GetString("grpc.port"))
+	if err != nil {
+		log.S().Fatal(err)
+	}
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.S().Fatal(err)
+		}
+	}()
+
 	// start serve
 	e.Logger.Fatal(e.Start(viper.GetString("api.port")))
 }
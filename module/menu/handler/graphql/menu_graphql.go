@@ -0,0 +1,207 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	log "go.uber.org/zap"
+)
+
+// MenuHandler represent the graphql handler for menu
+type MenuHandler struct {
+	menuUsecase   menu.Usecase
+	schema        graphql.Schema
+	maxDepth      int
+	maxComplexity int
+}
+
+// NewMenuHandler will initialize the graphql endpoint
+func NewMenuHandler(e *echo.Echo, us menu.Usecase) {
+	schema, err := newSchema(us)
+	if err != nil {
+		log.S().Fatal(err)
+	}
+
+	handler := &MenuHandler{
+		menuUsecase:   us,
+		schema:        schema,
+		maxDepth:      viper.GetInt("graphql.max_depth"),
+		maxComplexity: viper.GetInt("graphql.max_complexity"),
+	}
+
+	e.POST("/graphql", handler.Graphql)
+}
+
+// Graphql godoc
+// @Summary  GraphQL
+// @Description Query menus, menu types and wartegs or change menus in one round trip, response follows graphql format
+// @Tags Menu
+// @Accept  json
+// @Produce  json
+// @Param request body request.Graphql true "graphql query, operation name and variables"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} map[string]interface{}
+// @Failure 422 {object} response.Base
+// @Router /graphql [post]
+// Graphql handles HTTP request for graphql queries and mutations
+func (h *MenuHandler) Graphql(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := request.Graphql{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	doc, err := parser.Parse(parser.ParseParams{Source: req.Query})
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+	}
+
+	validation := graphql.ValidateDocument(&h.schema, doc, nil)
+	if !validation.IsValid {
+		return c.JSON(http.StatusBadRequest, &graphql.Result{Errors: validation.Errors})
+	}
+
+	err = h.checkLimits(doc, req.OperationName)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, &graphql.Result{Errors: gqlerrors.FormatErrors(err)})
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         h.schema,
+		RequestString:  req.Query,
+		VariableValues: req.Variables,
+		OperationName:  req.OperationName,
+		Context:        context.WithValue(ctx, loadersKey{}, newLoaders(h.menuUsecase)),
+	})
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// checkLimits rejects operation nested deeper than max depth or costing more than max complexity, every field costs
+// one and selections under a list field cost constant.GraphqlListCost times more, introspection fields are free
+func (h *MenuHandler) checkLimits(doc *ast.Document, operationName string) error {
+	w := costWalker{fragments: map[string]*ast.FragmentDefinition{}}
+
+	var operation *ast.OperationDefinition
+	for _, def := range doc.Definitions {
+		switch d := def.(type) {
+		case *ast.FragmentDefinition:
+			w.fragments[d.Name.Value] = d
+		case *ast.OperationDefinition:
+			if operation == nil && (operationName == "" || (d.Name != nil && d.Name.Value == operationName)) {
+				operation = d
+			}
+		}
+	}
+
+	// unknown operation is reported when executing
+	if operation == nil {
+		return nil
+	}
+
+	root := h.schema.QueryType()
+	if operation.Operation == ast.OperationTypeMutation {
+		root = h.schema.MutationType()
+	}
+
+	depth, complexity := w.selectionSet(root, operation.SelectionSet, map[string]bool{})
+	if depth > h.maxDepth {
+		return fmt.Errorf("query depth %d exceeds max depth %d", depth, h.maxDepth)
+	}
+	if complexity > h.maxComplexity {
+		return fmt.Errorf("query complexity %d exceeds max complexity %d", complexity, h.maxComplexity)
+	}
+
+	return nil
+}
+
+type costWalker struct {
+	fragments map[string]*ast.FragmentDefinition
+}
+
+// selectionSet returns depth and complexity of set selected on parent, parent is nil for fields unknown to the schema
+func (w costWalker) selectionSet(parent *graphql.Object, set *ast.SelectionSet, visiting map[string]bool) (depth, complexity int) {
+	if set == nil {
+		return 0, 0
+	}
+
+	for _, sel := range set.Selections {
+		var d, c int
+
+		switch s := sel.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+
+			var child *graphql.Object
+			list := false
+			if parent != nil {
+				if field, ok := parent.Fields()[s.Name.Value]; ok {
+					child, list = namedObject(field.Type)
+				}
+			}
+
+			d, c = w.selectionSet(child, s.SelectionSet, visiting)
+			if list {
+				c *= constant.GraphqlListCost
+			}
+			d, c = d+1, c+1
+		case *ast.InlineFragment:
+			d, c = w.selectionSet(parent, s.SelectionSet, visiting)
+		case *ast.FragmentSpread:
+			// fragment cycles are rejected by validation, visiting only guards the walk
+			f, ok := w.fragments[s.Name.Value]
+			if !ok || visiting[s.Name.Value] {
+				continue
+			}
+			visiting[s.Name.Value] = true
+			d, c = w.selectionSet(parent, f.SelectionSet, visiting)
+			delete(visiting, s.Name.Value)
+		}
+
+		if d > depth {
+			depth = d
+		}
+		complexity += c
+	}
+
+	return depth, complexity
+}
+
+// namedObject unwraps non null and list types, list tells whether one of them was a list
+func namedObject(t graphql.Type) (obj *graphql.Object, list bool) {
+	for {
+		switch v := t.(type) {
+		case *graphql.NonNull:
+			t = v.OfType
+		case *graphql.List:
+			t, list = v.OfType, true
+		case *graphql.Object:
+			return v, list
+		default:
+			return nil, list
+		}
+	}
+}
@@ -0,0 +1,283 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errorMenu = errors.New("error menu")
+
+func TestGraphql(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+		body       string
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success warteg menus with details loaded in one batch",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `{ warteg(warteg_id: "w1") { menus { menu_id menu_detail variants { variant_name } } } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK, `{"data":{"warteg":{"menus":[{"menu_detail":"goreng","menu_id":"m1","variants":[{"variant_name":"pedas"}]},{"menu_detail":"bacem","menu_id":"m2","variants":[]}]}}}`},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mlResponse := []response.MenuList{{MenuId: "m1", WartegId: "w1"}, {MenuId: "m2", WartegId: "w1"}}
+
+				mockMenu.
+					On("MenuList", mock.Anything, mock.Anything).
+					Return(mlResponse, nil)
+
+				mockMenu.
+					On("MenuDetails", mock.Anything, mock.Anything).
+					Return(func(ctx context.Context, menu_ids []string) []response.MenuDetail {
+						if len(menu_ids) != 2 {
+							return []response.MenuDetail{}
+						}
+						return []response.MenuDetail{
+							{MenuId: "m1", MenuDetail: "goreng", Variants: []response.MenuVariant{{VariantName: "pedas"}}},
+							{MenuId: "m2", MenuDetail: "bacem", Variants: []response.MenuVariant{}},
+						}
+					}, nil).
+					Once()
+			},
+		},
+		{
+			name: "#2 success menu not found is null",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `query Menu($id: String!) { menu(menu_id: $id) { menu_name } }`,
+					"variables": map[string]interface{}{
+						"id": "m9",
+					},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK, `{"data":{"menu":null}}`},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuDetails", mock.Anything, mock.Anything).
+					Return([]response.MenuDetail{}, nil)
+			},
+		},
+		{
+			name: "#3 success add menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `mutation { menu_add(input: {menu_type_id: 1, warteg_id: "w1", menu_name: "Tempe", menu_price: 2000}) { menu_id menu_type_name } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK, `{"data":{"menu_add":{"menu_id":"m1","menu_type_name":"Lauk"}}}`},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuAdd", mock.Anything, mock.Anything).
+					Return(response.MenuAdd{MenuId: "m1"}, nil)
+
+				mockMenu.
+					On("MenuDetails", mock.Anything, mock.Anything).
+					Return([]response.MenuDetail{{MenuId: "m1", MenuTypeName: "Lauk"}}, nil)
+			},
+		},
+		{
+			name: "#4 error status in extensions",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `{ menus { menu_id } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK, `"extensions":{"status":500}`},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuList", mock.Anything, mock.Anything).
+					Return([]response.MenuList{}, errorMenu)
+			},
+		},
+		{
+			name: "#5 bad request query too deep",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `{ warteg(warteg_id: "w1") { menus { warteg { menus { warteg { menus { menu_id } } } } } } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, "query depth 7 exceeds max depth 6"},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#6 bad request query too complex",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `{ menu_types { menus { menu_id variants { variant_id variant_name } } } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, "query complexity 2211 exceeds max complexity 1000"},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#7 bad request depth counted through fragments",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `{ warteg(warteg_id: "w1") { ...deep } } fragment deep on Warteg { menus { warteg { menus { warteg { menus { menu_id } } } } } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, "query depth 7 exceeds max depth 6"},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#8 bad request unknown field",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `{ menus { price } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, `Cannot query field \"price\" on type \"Menu\".`},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#9 bad request syntax error",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `{ menus { menu_id }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, "Syntax Error"},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#10 bad request missing query",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, ""},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#11 success update menu keeps price while change waits for approval",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"query": `mutation { menu_update(menu_id: "m1", input: {menu_type_id: 1, warteg_id: "w1", menu_name: "Tempe", menu_price: 20000}) { menu_price price_change { old_price new_price status } } }`,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK, `{"data":{"menu_update":{"menu_price":2000,"price_change":{"new_price":20000,"old_price":2000,"status":"pending"}}}}`},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pc := response.MenuPriceChange{ChangeId: "pc1", MenuId: "m1", OldPrice: 2000, NewPrice: 20000, Status: "pending"}
+
+				mockMenu.
+					On("MenuUpdate", mock.Anything, mock.Anything, mock.Anything).
+					Return(response.MenuUpdate{MenuId: "m1", MenuPrice: 2000, PriceChange: &pc}, nil)
+
+				mockMenu.
+					On("MenuDetails", mock.Anything, mock.Anything).
+					Return([]response.MenuDetail{{MenuId: "m1", MenuPrice: 2000}}, nil)
+
+				mockMenu.
+					On("MenuPriceChangeList", mock.Anything, mock.Anything).
+					Return([]response.MenuPriceChange{pc}, nil)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/graphql",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/graphql")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			schema, err := newSchema(mockMenu)
+			assert.NoError(t, err)
+
+			handler := MenuHandler{
+				menuUsecase:   mockMenu,
+				schema:        schema,
+				maxDepth:      6,
+				maxComplexity: 1000,
+			}
+
+			err = handler.Graphql(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), testCase.expectedOutput.body)
+			mockMenu.AssertExpectations(t)
+		})
+	}
+}
@@ -0,0 +1,486 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu"
+	"github.com/cpartogi/foodmenu/pkg/dataloader"
+	"github.com/cpartogi/foodmenu/pkg/helper"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/go-playground/validator/v10"
+	"github.com/graphql-go/graphql"
+)
+
+// warteg is source of Warteg objects, wartegs have no table of their own and are known only by id
+type warteg struct {
+	WartegId string `json:"warteg_id"`
+}
+
+// loaders batch lookups of one request, menus selected from lists only carry list columns and load the rest here
+type loaders struct {
+	menu     *dataloader.Loader
+	menuType *dataloader.Loader
+}
+
+type loadersKey struct{}
+
+func newLoaders(us menu.Usecase) *loaders {
+	return &loaders{
+		menu: dataloader.New(func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+			list, err := us.MenuDetails(ctx, keys)
+			values := map[string]interface{}{}
+			for _, m := range list {
+				values[m.MenuId] = m
+			}
+			return values, err
+		}),
+		menuType: dataloader.New(func(ctx context.Context, keys []string) (map[string]interface{}, error) {
+			list, err := us.MenuType(ctx)
+			values := map[string]interface{}{}
+			for _, t := range list {
+				values[strconv.Itoa(t.MenuTypeId)] = t
+			}
+			return values, err
+		}),
+	}
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+// resolveError carries status of domain errors in the extensions of graphql errors
+type resolveError struct {
+	status int
+	err    error
+}
+
+func (e resolveError) Error() string {
+	return e.err.Error()
+}
+
+func (e resolveError) Extensions() map[string]interface{} {
+	return map[string]interface{}{"status": e.status}
+}
+
+func newResolveError(err error) error {
+	status, err := helper.CommonError(err)
+	return resolveError{status: status, err: err}
+}
+
+// newSchema builds schema over menus, menu types and wartegs backed by us
+func newSchema(us menu.Usecase) (graphql.Schema, error) {
+	var menuType, wartegType *graphql.Object
+
+	promotionType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "AppliedPromotion",
+		Fields: graphql.Fields{
+			"promotion_id":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"promotion_name": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"promotion_type": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"value":          &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"buy_quantity":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"get_quantity":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"discount":       &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"ends_at":        &graphql.Field{Type: graphql.DateTime},
+		},
+	})
+
+	variantType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "MenuVariant",
+		Fields: graphql.Fields{
+			"variant_id":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"variant_name":    &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"price_type":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"price":           &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"variant_price":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"effective_price": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"is_default":      &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+			"updated_date":    &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+		},
+	})
+
+	priceChangeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "PriceChange",
+		Fields: graphql.Fields{
+			"change_id":      &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"old_price":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"new_price":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"status":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"requested_by":   &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"requested_date": &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+		},
+	})
+
+	menuObject := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Menu",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"menu_id":         &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"menu_type_id":    &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"menu_type_name":  &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"warteg_id":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"menu_name":       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+				"menu_price":      &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"effective_price": &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+				"promotion":       &graphql.Field{Type: promotionType},
+				"is_sold_out":     &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+				"stock":           &graphql.Field{Type: graphql.Int},
+				"is_bundle":       &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+				"updated_date":    &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+				"menu_detail": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: menuDetailField(func(m response.MenuDetail) interface{} {
+						return m.MenuDetail
+					}),
+				},
+				"menu_picture": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.String),
+					Resolve: menuDetailField(func(m response.MenuDetail) interface{} {
+						return m.MenuPicture
+					}),
+				},
+				"variants": &graphql.Field{
+					Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(variantType))),
+					Resolve: menuDetailField(func(m response.MenuDetail) interface{} {
+						return m.Variants
+					}),
+				},
+				"menu_type": &graphql.Field{
+					Type: menuType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadersFrom(p.Context).menuType.Load(p.Context, strconv.Itoa(menuTypeIdOf(p.Source))), nil
+					},
+				},
+				"warteg": &graphql.Field{
+					Type: graphql.NewNonNull(wartegType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return warteg{WartegId: wartegIdOf(p.Source)}, nil
+					},
+				},
+				// menu_price stays the current price while a change beyond the approval threshold waits here
+				"price_change": &graphql.Field{
+					Type: priceChangeType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						list, err := us.MenuPriceChangeList(p.Context, request.MenuPriceChangeFilter{
+							Status: constant.PriceChangePending,
+							MenuId: menuIdOf(p.Source),
+						})
+						if err != nil {
+							return nil, newResolveError(err)
+						}
+						if len(list) == 0 {
+							return nil, nil
+						}
+						return list[0], nil
+					},
+				},
+			}
+		}),
+	})
+
+	menuListArgs := func(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+		args["menu_name"] = &graphql.ArgumentConfig{Type: graphql.String}
+		args["available_only"] = &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false}
+		return args
+	}
+
+	menuType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "MenuType",
+		Fields: graphql.Fields{
+			"menu_type_id":   &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+			"menu_type_name": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"updated_date":   &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+			"menus": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(menuObject))),
+				Args: menuListArgs(graphql.FieldConfigArgument{
+					"warteg_id": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					mt := p.Source.(response.MenuType)
+					filter := menuListFilter(p.Args)
+					filter.MenuTypeId = strconv.Itoa(mt.MenuTypeId)
+
+					list, err := menuList(p.Context, us, filter)
+					if err != nil {
+						return nil, err
+					}
+
+					// menu type filter of the list is a partial match, keep only this type
+					menus := []response.MenuList{}
+					for _, m := range list {
+						if m.MenuTypeId == mt.MenuTypeId {
+							menus = append(menus, m)
+						}
+					}
+					return menus, nil
+				},
+			},
+		},
+	})
+
+	wartegType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Warteg",
+		Fields: graphql.Fields{
+			"warteg_id": &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+			"menus": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(menuObject))),
+				Args: menuListArgs(graphql.FieldConfigArgument{
+					"menu_type_id": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					filter := menuListFilter(p.Args)
+					filter.WartegId = p.Source.(warteg).WartegId
+					return menuList(p.Context, us, filter)
+				},
+			},
+			"menu_types": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(menuType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					list, err := menuList(p.Context, us, request.MenuList{WartegId: p.Source.(warteg).WartegId})
+					if err != nil {
+						return nil, err
+					}
+
+					used := map[int]bool{}
+					for _, m := range list {
+						used[m.MenuTypeId] = true
+					}
+
+					mtype, err := us.MenuType(p.Context)
+					if err != nil {
+						return nil, newResolveError(err)
+					}
+
+					types := []response.MenuType{}
+					for _, t := range mtype {
+						if used[t.MenuTypeId] {
+							types = append(types, t)
+						}
+					}
+					return types, nil
+				},
+			},
+		},
+	})
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"menu_types": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(menuType))),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					mtype, err := us.MenuType(p.Context)
+					if err != nil {
+						return nil, newResolveError(err)
+					}
+					return mtype, nil
+				},
+			},
+			"menus": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(menuObject))),
+				Args: menuListArgs(graphql.FieldConfigArgument{
+					"warteg_id":    &graphql.ArgumentConfig{Type: graphql.String},
+					"menu_type_id": &graphql.ArgumentConfig{Type: graphql.String},
+				}),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return menuList(p.Context, us, menuListFilter(p.Args))
+				},
+			},
+			"menu": &graphql.Field{
+				Type: menuObject,
+				Args: graphql.FieldConfigArgument{
+					"menu_id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return loadersFrom(p.Context).menu.Load(p.Context, p.Args["menu_id"].(string)), nil
+				},
+			},
+			"warteg": &graphql.Field{
+				Type: graphql.NewNonNull(wartegType),
+				Args: graphql.FieldConfigArgument{
+					"warteg_id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					return warteg{WartegId: p.Args["warteg_id"].(string)}, nil
+				},
+			},
+		},
+	})
+
+	menuInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "MenuInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"menu_type_id": &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+			"warteg_id":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"menu_name":    &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			"menu_detail":  &graphql.InputObjectFieldConfig{Type: graphql.String, DefaultValue: ""},
+			"menu_picture": &graphql.InputObjectFieldConfig{Type: graphql.String, DefaultValue: ""},
+			"menu_price":   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.Int)},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"menu_add": &graphql.Field{
+				Type: graphql.NewNonNull(menuObject),
+				Args: graphql.FieldConfigArgument{
+					"input": &graphql.ArgumentConfig{Type: graphql.NewNonNull(menuInput)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					in := menuInputOf(p.Args["input"])
+					addm := request.Menu{
+						MenuTypeId:  in.MenuTypeId,
+						WartegId:    in.WartegId,
+						MenuName:    in.MenuName,
+						MenuDetail:  in.MenuDetail,
+						MenuPicture: in.MenuPicture,
+						MenuPrice:   in.MenuPrice,
+					}
+
+					//validate
+					err := validator.New().Struct(&addm)
+					if err != nil {
+						return nil, resolveError{status: http.StatusBadRequest, err: err}
+					}
+
+					mn, err := us.MenuAdd(p.Context, addm)
+					if err != nil {
+						return nil, newResolveError(err)
+					}
+
+					return loadersFrom(p.Context).menu.Load(p.Context, mn.MenuId), nil
+				},
+			},
+			"menu_update": &graphql.Field{
+				Type: graphql.NewNonNull(menuObject),
+				Args: graphql.FieldConfigArgument{
+					"menu_id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					"input":   &graphql.ArgumentConfig{Type: graphql.NewNonNull(menuInput)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					upm := menuInputOf(p.Args["input"])
+
+					//validate
+					err := validator.New().Struct(&upm)
+					if err != nil {
+						return nil, resolveError{status: http.StatusBadRequest, err: err}
+					}
+
+					mu, err := us.MenuUpdate(p.Context, p.Args["menu_id"].(string), upm)
+					if err != nil {
+						return nil, newResolveError(err)
+					}
+
+					return loadersFrom(p.Context).menu.Load(p.Context, mu.MenuId), nil
+				},
+			},
+			"menu_delete": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Args: graphql.FieldConfigArgument{
+					"menu_id": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					md, err := us.MenuDelete(p.Context, p.Args["menu_id"].(string))
+					if err != nil {
+						return nil, newResolveError(err)
+					}
+					return md.MenuId, nil
+				},
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: mutation,
+	})
+}
+
+// menuDetailField resolves field missing from menu list rows through the menu loader
+func menuDetailField(fn func(response.MenuDetail) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		switch m := p.Source.(type) {
+		case response.MenuDetail:
+			return fn(m), nil
+		case response.MenuList:
+			thunk := loadersFrom(p.Context).menu.Load(p.Context, m.MenuId)
+			return func() (interface{}, error) {
+				v, err := thunk()
+				if err != nil || v == nil {
+					return nil, err
+				}
+				return fn(v.(response.MenuDetail)), nil
+			}, nil
+		}
+		return nil, nil
+	}
+}
+
+func menuIdOf(source interface{}) string {
+	switch m := source.(type) {
+	case response.MenuDetail:
+		return m.MenuId
+	case response.MenuList:
+		return m.MenuId
+	}
+	return ""
+}
+
+func menuTypeIdOf(source interface{}) int {
+	switch m := source.(type) {
+	case response.MenuDetail:
+		return m.MenuTypeId
+	case response.MenuList:
+		return m.MenuTypeId
+	}
+	return 0
+}
+
+func wartegIdOf(source interface{}) string {
+	switch m := source.(type) {
+	case response.MenuDetail:
+		return m.WartegId
+	case response.MenuList:
+		return m.WartegId
+	}
+	return ""
+}
+
+func menuListFilter(args map[string]interface{}) request.MenuList {
+	filter := request.MenuList{}
+	filter.WartegId, _ = args["warteg_id"].(string)
+	filter.MenuTypeId, _ = args["menu_type_id"].(string)
+	filter.MenuName, _ = args["menu_name"].(string)
+	filter.AvailableOnly, _ = args["available_only"].(bool)
+	return filter
+}
+
+// menuList treats not found as an empty list, an empty list is a valid graphql value
+func menuList(ctx context.Context, us menu.Usecase, filter request.MenuList) ([]response.MenuList, error) {
+	list, err := us.MenuList(ctx, filter)
+	if err == constant.ErrNotFound {
+		return []response.MenuList{}, nil
+	}
+	if err != nil {
+		return nil, newResolveError(err)
+	}
+	return list, nil
+}
+
+func menuInputOf(input interface{}) request.MenuUpdate {
+	in := input.(map[string]interface{})
+	upm := request.MenuUpdate{}
+	upm.MenuTypeId, _ = in["menu_type_id"].(int)
+	upm.WartegId, _ = in["warteg_id"].(string)
+	upm.MenuName, _ = in["menu_name"].(string)
+	upm.MenuDetail, _ = in["menu_detail"].(string)
+	upm.MenuPicture, _ = in["menu_picture"].(string)
+	upm.MenuPrice, _ = in["menu_price"].(int)
+	return upm
+}
@@ -0,0 +1,290 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu"
+	"github.com/cpartogi/foodmenu/pkg/helper"
+	"github.com/cpartogi/foodmenu/schema/pb"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/go-playground/validator/v10"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MenuServer represent the grpc handler for menu, it shares menu.Usecase with the http handler
+type MenuServer struct {
+	pb.UnimplementedMenuServiceServer
+	menuUsecase menu.Usecase
+}
+
+// NewMenuServer will register menu service on the grpc server
+func NewMenuServer(s *grpc.Server, us menu.Usecase) {
+	pb.RegisterMenuServiceServer(s, &MenuServer{
+		menuUsecase: us,
+	})
+}
+
+// MenuType handles grpc request for menu types
+func (h *MenuServer) MenuType(ctx context.Context, req *pb.MenuTypeRequest) (*pb.MenuTypeResponse, error) {
+	mtype, err := h.menuUsecase.MenuType(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	resp := &pb.MenuTypeResponse{MenuTypes: make([]*pb.MenuType, len(mtype))}
+	for i, t := range mtype {
+		resp.MenuTypes[i] = &pb.MenuType{
+			MenuTypeId:   int32(t.MenuTypeId),
+			MenuTypeName: t.MenuTypeName,
+			UpdatedDate:  timestamppb.New(t.UpdatedDate),
+		}
+	}
+
+	return resp, nil
+}
+
+// MenuAdd handles grpc request for adding menu
+func (h *MenuServer) MenuAdd(ctx context.Context, req *pb.MenuAddRequest) (*pb.Menu, error) {
+	addm := request.Menu{
+		MenuTypeId:  int(req.MenuTypeId),
+		WartegId:    req.WartegId,
+		MenuName:    req.MenuName,
+		MenuDetail:  req.MenuDetail,
+		MenuPicture: req.MenuPicture,
+		MenuPrice:   int(req.MenuPrice),
+	}
+
+	//validate
+	err := validator.New().Struct(&addm)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mn, err := h.menuUsecase.MenuAdd(ctx, addm)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.Menu{
+		MenuId:      mn.MenuId,
+		MenuTypeId:  int32(mn.MenuTypeId),
+		WartegId:    mn.WartegId,
+		MenuName:    mn.MenuName,
+		MenuDetail:  mn.MenuDetail,
+		MenuPicture: mn.MenuPicture,
+		MenuPrice:   int64(mn.MenuPrice),
+	}, nil
+}
+
+// MenuUpdate handles grpc request for updating menu
+func (h *MenuServer) MenuUpdate(ctx context.Context, req *pb.MenuUpdateRequest) (*pb.Menu, error) {
+	upm := request.MenuUpdate{
+		MenuTypeId:  int(req.MenuTypeId),
+		WartegId:    req.WartegId,
+		MenuName:    req.MenuName,
+		MenuDetail:  req.MenuDetail,
+		MenuPicture: req.MenuPicture,
+		MenuPrice:   int(req.MenuPrice),
+	}
+
+	//validate
+	if req.MenuId == "" {
+		return nil, status.Error(codes.InvalidArgument, "menu_id is mandatory")
+	}
+	err := validator.New().Struct(&upm)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	mu, err := h.menuUsecase.MenuUpdate(ctx, req.MenuId, upm)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.Menu{
+		MenuId:      mu.MenuId,
+		MenuTypeId:  int32(mu.MenuTypeId),
+		WartegId:    mu.WartegId,
+		MenuName:    mu.MenuName,
+		MenuDetail:  mu.MenuDetail,
+		MenuPicture: mu.MenuPicture,
+		MenuPrice:   int64(mu.MenuPrice),
+		PriceChange: priceChange(mu.PriceChange),
+	}, nil
+}
+
+// MenuDelete handles grpc request for deleting menu
+func (h *MenuServer) MenuDelete(ctx context.Context, req *pb.MenuDeleteRequest) (*pb.MenuDeleteResponse, error) {
+	md, err := h.menuUsecase.MenuDelete(ctx, req.MenuId)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.MenuDeleteResponse{MenuId: md.MenuId}, nil
+}
+
+// MenuList handles grpc request for menu list, every menu is sent as its own message. The list is read page by page
+// as served at the time of the request, so it is not cut at the limit of a list page
+func (h *MenuServer) MenuList(req *pb.MenuListRequest, stream pb.MenuService_MenuListServer) error {
+	ctx := stream.Context()
+
+	at := time.Now()
+	filter := request.MenuList{
+		WartegId:      req.WartegId,
+		MenuTypeId:    req.MenuTypeId,
+		MenuName:      req.MenuName,
+		AvailableOnly: req.AvailableOnly,
+		At:            &at,
+		Limit:         constant.MenuListStreamBatch,
+	}
+
+	for {
+		list, err := h.menuUsecase.MenuList(ctx, filter)
+		if err == constant.ErrNotFound && filter.Offset > 0 {
+			return nil
+		}
+		if err != nil {
+			return statusError(err)
+		}
+
+		err = h.sendMenuList(stream, list)
+		if err != nil {
+			return err
+		}
+
+		if len(list) < filter.Limit {
+			return nil
+		}
+		filter.Offset += len(list)
+	}
+}
+
+// sendMenuList sends a page of menu list to stream
+func (h *MenuServer) sendMenuList(stream pb.MenuService_MenuListServer, list []response.MenuList) error {
+	for _, m := range list {
+		err := stream.Send(&pb.MenuListItem{
+			MenuId:         m.MenuId,
+			MenuTypeId:     int32(m.MenuTypeId),
+			MenuTypeName:   m.MenuTypeName,
+			WartegId:       m.WartegId,
+			MenuName:       m.MenuName,
+			MenuPrice:      int64(m.MenuPrice),
+			EffectivePrice: int64(m.EffectivePrice),
+			Promotion:      appliedPromotion(m.Promotion),
+			MinPrice:       int64(m.MinPrice),
+			MaxPrice:       int64(m.MaxPrice),
+			IsSoldOut:      m.IsSoldOut,
+			Stock:          stock(m.Stock),
+			IsBundle:       m.IsBundle,
+			UpdatedDate:    timestamppb.New(m.UpdatedDate),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MenuDetail handles grpc request for menu detail
+func (h *MenuServer) MenuDetail(ctx context.Context, req *pb.MenuDetailRequest) (*pb.MenuDetailResponse, error) {
+	mnd, err := h.menuUsecase.MenuDetail(ctx, req.MenuId)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	return &pb.MenuDetailResponse{
+		MenuId:         mnd.MenuId,
+		MenuTypeId:     int32(mnd.MenuTypeId),
+		MenuTypeName:   mnd.MenuTypeName,
+		WartegId:       mnd.WartegId,
+		MenuName:       mnd.MenuName,
+		MenuDetail:     mnd.MenuDetail,
+		MenuPicture:    mnd.MenuPicture,
+		MenuPrice:      int64(mnd.MenuPrice),
+		EffectivePrice: int64(mnd.EffectivePrice),
+		Promotion:      appliedPromotion(mnd.Promotion),
+		IsSoldOut:      mnd.IsSoldOut,
+		Stock:          stock(mnd.Stock),
+		IsBundle:       mnd.IsBundle,
+		UpdatedDate:    timestamppb.New(mnd.UpdatedDate),
+	}, nil
+}
+
+// statusError maps domain errors to grpc codes the same way the http handler maps them to status codes
+func statusError(err error) error {
+	switch err {
+	case context.Canceled:
+		return status.Error(codes.Canceled, err.Error())
+	case context.DeadlineExceeded:
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+
+	code, err := helper.CommonError(err)
+	switch code {
+	case http.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case http.StatusConflict:
+		if err == constant.ErrConflict {
+			return status.Error(codes.AlreadyExists, err.Error())
+		}
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+func priceChange(pc *response.MenuPriceChange) *pb.PriceChange {
+	if pc == nil {
+		return nil
+	}
+
+	return &pb.PriceChange{
+		ChangeId:      pc.ChangeId,
+		OldPrice:      int64(pc.OldPrice),
+		NewPrice:      int64(pc.NewPrice),
+		Status:        pc.Status,
+		RequestedBy:   pc.RequestedBy,
+		RequestedDate: timestamppb.New(pc.RequestedDate),
+	}
+}
+
+func appliedPromotion(p *response.AppliedPromotion) *pb.AppliedPromotion {
+	if p == nil {
+		return nil
+	}
+
+	promo := &pb.AppliedPromotion{
+		PromotionId:   p.PromotionId,
+		PromotionName: p.PromotionName,
+		PromotionType: p.PromotionType,
+		Value:         int64(p.Value),
+		BuyQuantity:   int32(p.BuyQuantity),
+		GetQuantity:   int32(p.GetQuantity),
+		Discount:      int64(p.Discount),
+	}
+	if p.EndsAt != nil {
+		promo.EndsAt = timestamppb.New(*p.EndsAt)
+	}
+
+	return promo
+}
+
+func stock(s *int) *int32 {
+	if s == nil {
+		return nil
+	}
+	v := int32(*s)
+	return &v
+}
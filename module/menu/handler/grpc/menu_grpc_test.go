@@ -0,0 +1,339 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/pb"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// newClient serves the menu service over an in memory listener
+func newClient(t *testing.T, mockMenu *mocks.Usecase) pb.MenuServiceClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	NewMenuServer(s, mockMenu)
+	go s.Serve(lis)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+
+	return pb.NewMenuServiceClient(conn)
+}
+
+func TestMenuType(t *testing.T) {
+	cases := []struct {
+		name          string
+		expectedCode  codes.Code
+		configureMock func(mockMenu *mocks.Usecase)
+	}{
+		{
+			name:         "#1 success get menu type",
+			expectedCode: codes.OK,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuType", mock.Anything).Return([]response.MenuType{{MenuTypeId: 1, MenuTypeName: "Makanan"}}, nil)
+			},
+		},
+		{
+			name:         "#2 internal server error get menu type",
+			expectedCode: codes.Internal,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuType", mock.Anything).Return([]response.MenuType{}, errors.New("db down"))
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+			testCase.configureMock(mockMenu)
+
+			resp, err := newClient(t, mockMenu).MenuType(context.Background(), &pb.MenuTypeRequest{})
+
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+			if err == nil {
+				assert.Equal(t, "Makanan", resp.MenuTypes[0].MenuTypeName)
+			}
+		})
+	}
+}
+
+func TestMenuAdd(t *testing.T) {
+	cases := []struct {
+		name          string
+		req           *pb.MenuAddRequest
+		expectedCode  codes.Code
+		configureMock func(mockMenu *mocks.Usecase)
+	}{
+		{
+			name:         "#1 success add menu",
+			req:          &pb.MenuAddRequest{MenuTypeId: 1, WartegId: "w1", MenuName: "Tempe", MenuPrice: 2000},
+			expectedCode: codes.OK,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuAdd", mock.Anything, mock.Anything).Return(response.MenuAdd{MenuId: "abc", MenuName: "Tempe"}, nil)
+			},
+		},
+		{
+			name:          "#2 invalid argument add menu",
+			req:           &pb.MenuAddRequest{WartegId: "w1"},
+			expectedCode:  codes.InvalidArgument,
+			configureMock: func(mockMenu *mocks.Usecase) {},
+		},
+		{
+			name:         "#3 already exists add menu",
+			req:          &pb.MenuAddRequest{MenuTypeId: 1, WartegId: "w1", MenuName: "Tempe", MenuPrice: 2000},
+			expectedCode: codes.AlreadyExists,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuAdd", mock.Anything, mock.Anything).Return(response.MenuAdd{}, constant.ErrConflict)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+			testCase.configureMock(mockMenu)
+
+			_, err := newClient(t, mockMenu).MenuAdd(context.Background(), testCase.req)
+
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestMenuUpdate(t *testing.T) {
+	cases := []struct {
+		name          string
+		req           *pb.MenuUpdateRequest
+		expectedCode  codes.Code
+		configureMock func(mockMenu *mocks.Usecase)
+	}{
+		{
+			name:         "#1 success update menu",
+			req:          &pb.MenuUpdateRequest{MenuId: "abc", MenuTypeId: 1, WartegId: "w1", MenuName: "Tempe", MenuPrice: 2500},
+			expectedCode: codes.OK,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuUpdate", mock.Anything, mock.Anything, mock.Anything).Return(response.MenuUpdate{MenuId: "abc"}, nil)
+			},
+		},
+		{
+			name:          "#2 invalid argument update menu without id",
+			req:           &pb.MenuUpdateRequest{MenuTypeId: 1, WartegId: "w1", MenuName: "Tempe", MenuPrice: 2500},
+			expectedCode:  codes.InvalidArgument,
+			configureMock: func(mockMenu *mocks.Usecase) {},
+		},
+		{
+			name:         "#3 not found update menu",
+			req:          &pb.MenuUpdateRequest{MenuId: "abc", MenuTypeId: 1, WartegId: "w1", MenuName: "Tempe", MenuPrice: 2500},
+			expectedCode: codes.NotFound,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuUpdate", mock.Anything, mock.Anything, mock.Anything).Return(response.MenuUpdate{}, constant.ErrNotFound)
+			},
+		},
+		{
+			name:         "#4 success update menu with price change waiting for approval",
+			req:          &pb.MenuUpdateRequest{MenuId: "abc", MenuTypeId: 1, WartegId: "w1", MenuName: "Tempe", MenuPrice: 25000},
+			expectedCode: codes.OK,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuUpdate", mock.Anything, mock.Anything, mock.Anything).Return(response.MenuUpdate{
+					MenuId:      "abc",
+					MenuPrice:   2500,
+					PriceChange: &response.MenuPriceChange{ChangeId: "pc1", OldPrice: 2500, NewPrice: 25000, Status: constant.PriceChangePending},
+				}, nil)
+			},
+		},
+		{
+			name:         "#5 failed precondition update menu with pending price change",
+			req:          &pb.MenuUpdateRequest{MenuId: "abc", MenuTypeId: 1, WartegId: "w1", MenuName: "Tempe", MenuPrice: 3000},
+			expectedCode: codes.FailedPrecondition,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuUpdate", mock.Anything, mock.Anything, mock.Anything).Return(response.MenuUpdate{}, constant.ErrPriceChangePending)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+			testCase.configureMock(mockMenu)
+
+			_, err := newClient(t, mockMenu).MenuUpdate(context.Background(), testCase.req)
+
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestMenuDelete(t *testing.T) {
+	cases := []struct {
+		name          string
+		expectedCode  codes.Code
+		configureMock func(mockMenu *mocks.Usecase)
+	}{
+		{
+			name:         "#1 success delete menu",
+			expectedCode: codes.OK,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuDelete", mock.Anything, mock.Anything).Return(response.MenuDelete{MenuId: "abc"}, nil)
+			},
+		},
+		{
+			name:         "#2 failed precondition delete menu used in bundle",
+			expectedCode: codes.FailedPrecondition,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuDelete", mock.Anything, mock.Anything).Return(response.MenuDelete{}, constant.ErrMenuInBundle)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+			testCase.configureMock(mockMenu)
+
+			_, err := newClient(t, mockMenu).MenuDelete(context.Background(), &pb.MenuDeleteRequest{MenuId: "abc"})
+
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestMenuList(t *testing.T) {
+	stock := 3
+
+	page := make([]response.MenuList, constant.MenuListStreamBatch)
+	page[0] = response.MenuList{MenuId: "abc", MenuName: "Tempe", Stock: &stock, Promotion: &response.AppliedPromotion{PromotionId: "p1"}}
+
+	cases := []struct {
+		name          string
+		expectedCode  codes.Code
+		expectedCount int
+		configureMock func(mockMenu *mocks.Usecase)
+	}{
+		{
+			name:          "#1 success stream menu list",
+			expectedCode:  codes.OK,
+			expectedCount: 2,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuList", mock.Anything, mock.Anything).Return([]response.MenuList{
+					{MenuId: "abc", MenuName: "Tempe", Stock: &stock, Promotion: &response.AppliedPromotion{PromotionId: "p1"}},
+					{MenuId: "def", MenuName: "Tahu"},
+				}, nil)
+			},
+		},
+		{
+			name:         "#2 invalid argument menu list",
+			expectedCode: codes.InvalidArgument,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuList", mock.Anything, mock.Anything).Return([]response.MenuList{}, constant.ErrInvalidSyncToken)
+			},
+		},
+		{
+			name:          "#3 success stream menu list beyond one page",
+			expectedCode:  codes.OK,
+			expectedCount: constant.MenuListStreamBatch + 2,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuList", mock.Anything, mock.Anything).Return(page, nil).Once()
+				mockMenu.On("MenuList", mock.Anything, mock.Anything).Return([]response.MenuList{
+					{MenuId: "def", MenuName: "Tahu"},
+					{MenuId: "ghi", MenuName: "Telur"},
+				}, nil).Once()
+			},
+		},
+		{
+			name:          "#4 success stream menu list ending on a full page",
+			expectedCode:  codes.OK,
+			expectedCount: constant.MenuListStreamBatch,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuList", mock.Anything, mock.Anything).Return(page, nil).Once()
+				mockMenu.On("MenuList", mock.Anything, mock.Anything).Return([]response.MenuList{}, constant.ErrNotFound).Once()
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+			testCase.configureMock(mockMenu)
+
+			stream, err := newClient(t, mockMenu).MenuList(context.Background(), &pb.MenuListRequest{WartegId: "w1", AvailableOnly: true})
+			assert.NoError(t, err)
+
+			var items []*pb.MenuListItem
+			for {
+				item, err := stream.Recv()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					assert.Equal(t, testCase.expectedCode, status.Code(err))
+					break
+				}
+				items = append(items, item)
+			}
+
+			assert.Len(t, items, testCase.expectedCount)
+			if testCase.expectedCount > 0 {
+				assert.Equal(t, int32(3), items[0].GetStock())
+				assert.Equal(t, "p1", items[0].Promotion.PromotionId)
+				assert.Nil(t, items[1].Stock)
+			}
+		})
+	}
+}
+
+func TestMenuDetail(t *testing.T) {
+	cases := []struct {
+		name          string
+		expectedCode  codes.Code
+		configureMock func(mockMenu *mocks.Usecase)
+	}{
+		{
+			name:         "#1 success get menu detail",
+			expectedCode: codes.OK,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuDetail", mock.Anything, mock.Anything).Return(response.MenuDetail{MenuId: "abc"}, nil)
+			},
+		},
+		{
+			name:         "#2 not found menu detail",
+			expectedCode: codes.NotFound,
+			configureMock: func(mockMenu *mocks.Usecase) {
+				mockMenu.On("MenuDetail", mock.Anything, mock.Anything).Return(response.MenuDetail{}, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+			testCase.configureMock(mockMenu)
+
+			_, err := newClient(t, mockMenu).MenuDetail(context.Background(), &pb.MenuDetailRequest{MenuId: "abc"})
+
+			assert.Equal(t, testCase.expectedCode, status.Code(err))
+		})
+	}
+}
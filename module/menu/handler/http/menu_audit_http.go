@@ -0,0 +1,89 @@
+package http
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// AuditList godoc
+// @Summary Audit Trail
+// @Description Who changed menus, their gallery, availability, variants, modifiers, bundles, promotions, scheduled prices, webhooks and warteg locations and opening hours, with snapshots before and after every change, newest first. Pass audit_id of the last entry as before_id to get older entries
+// @Tags Audit
+// @Accept  json
+// @Produce  json
+// @Param menu_id query string false "Menu Id"
+// @Param warteg_id query string false "Warteg Id"
+// @Param actor query string false "Actor Id"
+// @Param entity_type query string false "menu, menu_image, menu_availability, menu_variant, modifier_group, menu_modifier, menu_bundle, promotion, menu_price_schedule, menu_price_change, webhook, warteg_location, warteg_hours, menu_window, menu_status, brand, brand_menu or brand_warteg"
+// @Param from query string false "RFC3339 time, inclusive"
+// @Param to query string false "RFC3339 time, exclusive"
+// @Param before_id query int false "Audit Id"
+// @Param limit query int false "Max entries, default 50, at most 200"
+// @Success 200 {object} response.SwaggerAuditLogs
+// @Failure 400 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/audit-logs [get]
+// AuditList handles HTTP request for audit trail
+func (h *MenuHandler) AuditList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := auditFilter(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	logs, err := h.menuUsecase.AuditList(ctx, filter)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, logs)
+}
+
+func auditFilter(c echo.Context) (filter request.AuditFilter, err error) {
+	queryValues := c.Request().URL.Query()
+	filter = request.AuditFilter{
+		MenuId:     queryValues.Get("menu_id"),
+		WartegId:   queryValues.Get("warteg_id"),
+		Actor:      queryValues.Get("actor"),
+		EntityType: queryValues.Get("entity_type"),
+	}
+
+	if v := queryValues.Get("from"); v != "" {
+		from, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, fmt.Errorf("from must be RFC3339 time")
+		}
+		filter.From = &from
+	}
+
+	if v := queryValues.Get("to"); v != "" {
+		to, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return filter, fmt.Errorf("to must be RFC3339 time")
+		}
+		filter.To = &to
+	}
+
+	if v := queryValues.Get("before_id"); v != "" {
+		filter.BeforeId, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || filter.BeforeId < 0 {
+			return filter, fmt.Errorf("before_id must be a positive number")
+		}
+	}
+
+	if v := queryValues.Get("limit"); v != "" {
+		filter.Limit, err = strconv.Atoi(v)
+		if err != nil || filter.Limit < 0 {
+			return filter, fmt.Errorf("limit must be a positive number")
+		}
+	}
+
+	return filter, nil
+}
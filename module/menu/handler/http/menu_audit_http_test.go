@@ -0,0 +1,123 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditList(t *testing.T) {
+	type input struct {
+		query string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success get audit logs",
+			expectedInput: input{
+				query: "warteg_id=abc&actor=budi&from=2026-10-01T00:00:00%2B07:00&to=2026-10-19T00:00:00%2B07:00",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				alResponse := []response.AuditLog{}
+
+				mockMenu.
+					On("AuditList", mock.Anything, mock.Anything).
+					Return(alResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request invalid time range",
+			expectedInput: input{
+				query: "from=yesterday",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request invalid limit",
+			expectedInput: input{
+				query: "limit=many",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 internal server error audit logs",
+			expectedInput: input{
+				query: "menu_id=abc",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				alResponse := []response.AuditLog{}
+
+				mockMenu.
+					On("AuditList", mock.Anything, mock.Anything).
+					Return(alResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/audit-logs?"+testCase.expectedInput.query, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/audit-logs")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.AuditList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuAvailability godoc
+// @Summary Menu Availability
+// @Description Today's sold out status and remaining portions of published menu
+// @Tags Menu Availability
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerMenuAvailability
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/availability [get]
+// MenuAvailability handles HTTP request for menu availability
+func (h *MenuHandler) MenuAvailability(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	ma, err := h.menuUsecase.MenuAvailability(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, ma)
+}
+
+// MenuSoldOut godoc
+// @Summary Mark Menu Sold Out
+// @Description Mark menu sold out until it is restocked or the next opening time
+// @Tags Menu Availability
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerMenuAvailability
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/soldout [put]
+// MenuSoldOut handles HTTP request for marking menu sold out
+func (h *MenuHandler) MenuSoldOut(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	ma, err := h.menuUsecase.MenuSoldOut(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success mark menu sold out", ma)
+}
+
+// MenuRestock godoc
+// @Summary Restock Menu
+// @Description Mark menu available, stock is today's remaining portions and daily_stock is portions restored at every opening time, leave stock empty to stop tracking portions today
+// @Tags Menu Availability
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuRestock true "Request Body"
+// @Success 200 {object} response.SwaggerMenuAvailability
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/restock [put]
+// MenuRestock handles HTTP request for menu restock
+func (h *MenuHandler) MenuRestock(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuRestock{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	ma, err := h.menuUsecase.MenuRestock(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success restock menu", ma)
+}
+
+// MenuConsume godoc
+// @Summary Consume Menu Portions
+// @Description Take sold portions from today's stock, menu is marked sold out when stock reaches zero
+// @Tags Menu Availability
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuConsume true "Request Body"
+// @Success 200 {object} response.SwaggerMenuAvailability
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 409 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/consume [post]
+// MenuConsume handles HTTP request for taking portions from stock
+func (h *MenuHandler) MenuConsume(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuConsume{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	ma, err := h.menuUsecase.MenuConsume(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, ma)
+	}
+
+	return utils.SuccessResponse(c, "Success consume menu", ma)
+}
@@ -0,0 +1,400 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuAvailability(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get menu availability",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuAvailability", mock.Anything, mock.Anything).
+					Return(maResponse, nil)
+			},
+		},
+		{
+			name:           "#2 menu not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuAvailability", mock.Anything, mock.Anything).
+					Return(maResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error menu availability",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuAvailability", mock.Anything, mock.Anything).
+					Return(maResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id/availability", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/availability")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuAvailability(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuSoldOut(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success mark menu sold out",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{IsSoldOut: true}
+
+				mockMenu.
+					On("MenuSoldOut", mock.Anything, mock.Anything).
+					Return(maResponse, nil)
+			},
+		},
+		{
+			name:           "#2 menu not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuSoldOut", mock.Anything, mock.Anything).
+					Return(maResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/menu/:menu_id/soldout", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/soldout")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuSoldOut(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuRestock(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success restock menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"stock":       20,
+					"daily_stock": 20,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuRestock", mock.Anything, mock.Anything).
+					Return(maResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request negative stock",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"stock": -1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable restock menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"stock": "a",
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 menu not found",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuRestock", mock.Anything, mock.Anything).
+					Return(maResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/menu/:menu_id/restock",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/restock")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuRestock(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuConsume(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success consume menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"quantity": 2,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuConsume", mock.Anything, mock.Anything).
+					Return(maResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without quantity",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 conflict menu out of stock",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"quantity": 5,
+				},
+			},
+			expectedOutput: output{nil, http.StatusConflict},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				maResponse := response.MenuAvailability{}
+
+				mockMenu.
+					On("MenuConsume", mock.Anything, mock.Anything).
+					Return(maResponse, constant.ErrOutOfStock)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/menu/:menu_id/consume",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/consume")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuConsume(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
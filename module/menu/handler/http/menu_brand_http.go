@@ -0,0 +1,317 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// BrandAdd godoc
+// @Summary Add Brand
+// @Description Add franchise brand sharing menu templates among its branch wartegs
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Param X-Actor-Id header string false "Actor Id"
+// @Param request body request.Brand true "Request Body"
+// @Success 201 {object} response.SwaggerBrand
+// @Failure 400 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/brands [post]
+// BrandAdd handles HTTP request for adding brand
+func (h *MenuHandler) BrandAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := request.Brand{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	brand, err := h.menuUsecase.BrandAdd(ctx, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success add brand", brand)
+}
+
+// BrandList godoc
+// @Summary Brand List
+// @Description Franchise brands with their number of menu templates and branches
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Success 200 {object} response.SwaggerBrands
+// @Failure 500 {object} response.Base
+// @Router /v1/brands [get]
+// BrandList handles HTTP request for brand list
+func (h *MenuHandler) BrandList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	list, err := h.menuUsecase.BrandList(ctx)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, list)
+}
+
+// BrandMenuAdd godoc
+// @Summary Add Brand Menu Template
+// @Description Add menu template of brand, a menu is created from it at every branch of the brand
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Param brand_id path string true "Brand Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Param request body request.BrandMenu true "Request Body"
+// @Success 201 {object} response.SwaggerBrandMenu
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/brands/{brand_id}/menus [post]
+// BrandMenuAdd handles HTTP request for adding brand menu template
+func (h *MenuHandler) BrandMenuAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	brandId := c.Param("brand_id")
+	req := request.BrandMenu{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	bm, err := h.menuUsecase.BrandMenuAdd(ctx, brandId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success add brand menu", bm)
+}
+
+// BrandMenuList godoc
+// @Summary Brand Menu Templates
+// @Description Menu templates of brand
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Param brand_id path string true "Brand Id"
+// @Success 200 {object} response.SwaggerBrandMenus
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/brands/{brand_id}/menus [get]
+// BrandMenuList handles HTTP request for brand menu templates
+func (h *MenuHandler) BrandMenuList(c echo.Context) error {
+	ctx := c.Request().Context()
+	brandId := c.Param("brand_id")
+
+	list, err := h.menuUsecase.BrandMenuList(ctx, brandId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, list)
+}
+
+// BrandMenuUpdate godoc
+// @Summary Update Brand Menu Template
+// @Description Update menu template of brand and propagate it to every branch. Type, name, detail and picture of branch menus follow the template, the price follows while the branch did not set its own price. A price change beyond the approval threshold becomes a price change of the branch menu waiting for approval, the branch follows the template price once it is approved and is asked again after a rejection, and a branch menu with a pending price change keeps its price until a later propagation, publishing status and daily availability of branch menus are kept
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Param brand_id path string true "Brand Id"
+// @Param template_id path string true "Template Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Param request body request.BrandMenu true "Request Body"
+// @Success 200 {object} response.SwaggerBrandMenu
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/brands/{brand_id}/menus/{template_id} [put]
+// BrandMenuUpdate handles HTTP request for updating brand menu template
+func (h *MenuHandler) BrandMenuUpdate(c echo.Context) error {
+	ctx := c.Request().Context()
+	brandId := c.Param("brand_id")
+	templateId := c.Param("template_id")
+	req := request.BrandMenu{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	bm, err := h.menuUsecase.BrandMenuUpdate(ctx, brandId, templateId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success update brand menu", bm)
+}
+
+// BrandMenuDelete godoc
+// @Summary Delete Brand Menu Template
+// @Description Delete menu template of brand, branch menus created from it are archived and stay with their branch
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Param brand_id path string true "Brand Id"
+// @Param template_id path string true "Template Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/brands/{brand_id}/menus/{template_id} [delete]
+// BrandMenuDelete handles HTTP request for deleting brand menu template
+func (h *MenuHandler) BrandMenuDelete(c echo.Context) error {
+	ctx := c.Request().Context()
+	brandId := c.Param("brand_id")
+	templateId := c.Param("template_id")
+
+	err := h.menuUsecase.BrandMenuDelete(ctx, brandId, templateId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success delete brand menu", map[string]interface{}{})
+}
+
+// BrandPropagate godoc
+// @Summary Propagate Brand Menu Templates
+// @Description Bring menus of every branch up to date with the templates of brand, a branch menu that was deleted is created again
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Param brand_id path string true "Brand Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Success 200 {object} response.SwaggerBrandPropagation
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/brands/{brand_id}/propagate [post]
+// BrandPropagate handles HTTP request for propagating brand menu templates
+func (h *MenuHandler) BrandPropagate(c echo.Context) error {
+	ctx := c.Request().Context()
+	brandId := c.Param("brand_id")
+
+	bp, err := h.menuUsecase.BrandPropagate(ctx, brandId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success propagate brand menus", bp)
+}
+
+// BrandDivergence godoc
+// @Summary Brand Divergence Report
+// @Description Template menus of every branch that differ from their template, fields lists missing, status or the differing menu fields
+// @Tags Brand
+// @Accept  json
+// @Produce  json
+// @Param brand_id path string true "Brand Id"
+// @Success 200 {object} response.SwaggerBrandDivergence
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/brands/{brand_id}/divergence [get]
+// BrandDivergence handles HTTP request for brand divergence report
+func (h *MenuHandler) BrandDivergence(c echo.Context) error {
+	ctx := c.Request().Context()
+	brandId := c.Param("brand_id")
+
+	bd, err := h.menuUsecase.BrandDivergence(ctx, brandId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, bd)
+}
+
+// BrandWartegSet godoc
+// @Summary Assign Warteg To Brand
+// @Description Assign warteg as a branch of brand, a menu is created or updated from every template of the brand. Menus of a previous brand stay as menus of the warteg
+// @Tags Warteg
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Warteg Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Param request body request.BrandWarteg true "Request Body"
+// @Success 200 {object} response.SwaggerBrandWarteg
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/wartegs/{id}/brand [put]
+// BrandWartegSet handles HTTP request for assigning warteg to brand
+func (h *MenuHandler) BrandWartegSet(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.Param("id")
+	req := request.BrandWarteg{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	bw, err := h.menuUsecase.BrandWartegSet(ctx, wartegId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success assign warteg to brand", bw)
+}
+
+// BrandWartegDelete godoc
+// @Summary Remove Warteg From Brand
+// @Description Remove warteg from its brand, menus created from templates stay as menus of the warteg
+// @Tags Warteg
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Warteg Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/wartegs/{id}/brand [delete]
+// BrandWartegDelete handles HTTP request for removing warteg from brand
+func (h *MenuHandler) BrandWartegDelete(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.Param("id")
+
+	err := h.menuUsecase.BrandWartegDelete(ctx, wartegId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success remove warteg from brand", map[string]interface{}{})
+}
@@ -0,0 +1,957 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBrandAdd(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success add brand",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"brand_name": "Warteg Bahari",
+				},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bResponse := response.Brand{}
+
+				mockMenu.
+					On("BrandAdd", mock.Anything, mock.Anything).
+					Return(bResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without brand name",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable add brand",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"brand_name": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 internal server error add brand",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"brand_name": "Warteg Bahari",
+				},
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bResponse := response.Brand{}
+
+				mockMenu.
+					On("BrandAdd", mock.Anything, mock.Anything).
+					Return(bResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/brands",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandAdd(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandList(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get brand list",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bResponse := []response.Brand{}
+
+				mockMenu.
+					On("BrandList", mock.Anything, mock.Anything).
+					Return(bResponse, nil)
+			},
+		},
+		{
+			name:           "#2 internal server error brand list",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bResponse := []response.Brand{}
+
+				mockMenu.
+					On("BrandList", mock.Anything, mock.Anything).
+					Return(bResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/brands", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandMenuAdd(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success add brand menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_name":    "Nasi Rames",
+					"menu_price":   15000,
+					"menu_type_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bmResponse := response.BrandMenu{}
+
+				mockMenu.
+					On("BrandMenuAdd", mock.Anything, mock.Anything).
+					Return(bmResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without price",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_name":    "Nasi Rames",
+					"menu_type_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable add brand menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_name":    "Nasi Rames",
+					"menu_price":   "15000",
+					"menu_type_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 brand not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_name":    "Nasi Rames",
+					"menu_price":   15000,
+					"menu_type_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bmResponse := response.BrandMenu{}
+
+				mockMenu.
+					On("BrandMenuAdd", mock.Anything, mock.Anything).
+					Return(bmResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/brands/:brand_id/menus",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands/:brand_id/menus")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandMenuAdd(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandMenuList(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get brand menus",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bmResponse := []response.BrandMenu{}
+
+				mockMenu.
+					On("BrandMenuList", mock.Anything, mock.Anything).
+					Return(bmResponse, nil)
+			},
+		},
+		{
+			name:           "#2 brand not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bmResponse := []response.BrandMenu{}
+
+				mockMenu.
+					On("BrandMenuList", mock.Anything, mock.Anything).
+					Return(bmResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/brands/:brand_id/menus", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands/:brand_id/menus")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandMenuList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandMenuUpdate(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success update brand menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_name":    "Nasi Rames",
+					"menu_price":   15000,
+					"menu_type_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bmResponse := response.BrandMenu{}
+
+				mockMenu.
+					On("BrandMenuUpdate", mock.Anything, mock.Anything).
+					Return(bmResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without name",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_price":   15000,
+					"menu_type_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable update brand menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_name":    "Nasi Rames",
+					"menu_price":   15000,
+					"menu_type_id": "1",
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 brand menu not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_detail":  "nasi, sayur, telur",
+					"menu_name":    "Nasi Rames",
+					"menu_price":   15000,
+					"menu_type_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bmResponse := response.BrandMenu{}
+
+				mockMenu.
+					On("BrandMenuUpdate", mock.Anything, mock.Anything).
+					Return(bmResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/brands/:brand_id/menus/:template_id",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands/:brand_id/menus/:template_id")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandMenuUpdate(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandMenuDelete(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success delete brand menu",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("BrandMenuDelete", mock.Anything, mock.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:           "#2 brand menu not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("BrandMenuDelete", mock.Anything, mock.Anything).
+					Return(constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.DELETE, "/v1/brands/:brand_id/menus/:template_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands/:brand_id/menus/:template_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandMenuDelete(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandPropagate(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success propagate brand menus",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bpResponse := response.BrandPropagation{}
+
+				mockMenu.
+					On("BrandPropagate", mock.Anything, mock.Anything).
+					Return(bpResponse, nil)
+			},
+		},
+		{
+			name:           "#2 brand not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bpResponse := response.BrandPropagation{}
+
+				mockMenu.
+					On("BrandPropagate", mock.Anything, mock.Anything).
+					Return(bpResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/brands/:brand_id/propagate", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands/:brand_id/propagate")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandPropagate(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandDivergence(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get divergence report",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bdResponse := response.BrandDivergence{}
+
+				mockMenu.
+					On("BrandDivergence", mock.Anything, mock.Anything).
+					Return(bdResponse, nil)
+			},
+		},
+		{
+			name:           "#2 brand not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bdResponse := response.BrandDivergence{}
+
+				mockMenu.
+					On("BrandDivergence", mock.Anything, mock.Anything).
+					Return(bdResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error divergence report",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				bdResponse := response.BrandDivergence{}
+
+				mockMenu.
+					On("BrandDivergence", mock.Anything, mock.Anything).
+					Return(bdResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/brands/:brand_id/divergence", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/brands/:brand_id/divergence")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandDivergence(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandWartegSet(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success assign warteg to brand",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"brand_id": "abc",
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bwResponse := response.BrandWarteg{}
+
+				mockMenu.
+					On("BrandWartegSet", mock.Anything, mock.Anything).
+					Return(bwResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without brand id",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable assign warteg to brand",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"brand_id": 1,
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 brand not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"brand_id": "abc",
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				bwResponse := response.BrandWarteg{}
+
+				mockMenu.
+					On("BrandWartegSet", mock.Anything, mock.Anything).
+					Return(bwResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/wartegs/:id/brand",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/wartegs/:id/brand")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandWartegSet(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestBrandWartegDelete(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success remove warteg from brand",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("BrandWartegDelete", mock.Anything, mock.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:           "#2 warteg has no brand",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("BrandWartegDelete", mock.Anything, mock.Anything).
+					Return(constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.DELETE, "/v1/wartegs/:id/brand", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/wartegs/:id/brand")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.BrandWartegDelete(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuBundle godoc
+// @Summary Menu Bundle
+// @Description Components of bundle menu with savings versus buying them a la carte
+// @Tags Menu Bundle
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerMenuBundle
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/bundle [get]
+// MenuBundle handles HTTP request for menu bundle
+func (h *MenuHandler) MenuBundle(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	mb, err := h.menuUsecase.MenuBundle(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, mb)
+}
+
+// MenuBundleSet godoc
+// @Summary Set Menu Bundle
+// @Description Make menu a bundle of other menus of the same warteg at its menu price, send empty items to turn it back into a single menu
+// @Tags Menu Bundle
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuBundle true "Request Body"
+// @Success 200 {object} response.SwaggerMenuBundle
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/bundle [put]
+// MenuBundleSet handles HTTP request for setting bundle components
+func (h *MenuHandler) MenuBundleSet(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuBundle{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	mb, err := h.menuUsecase.MenuBundleSet(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success set menu bundle", mb)
+}
@@ -0,0 +1,235 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuBundle(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get menu bundle",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mbResponse := response.MenuBundle{}
+
+				mockMenu.
+					On("MenuBundle", mock.Anything, mock.Anything).
+					Return(mbResponse, nil)
+			},
+		},
+		{
+			name:           "#2 menu is not a bundle",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mbResponse := response.MenuBundle{}
+
+				mockMenu.
+					On("MenuBundle", mock.Anything, mock.Anything).
+					Return(mbResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error menu bundle",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mbResponse := response.MenuBundle{}
+
+				mockMenu.
+					On("MenuBundle", mock.Anything, mock.Anything).
+					Return(mbResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id/bundle", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/bundle")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuBundle(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuBundleSet(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success set menu bundle",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"items": []map[string]interface{}{{"menu_id": "nasi", "quantity": 1}, {"menu_id": "es-teh", "quantity": 1}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mbResponse := response.MenuBundle{}
+
+				mockMenu.
+					On("MenuBundleSet", mock.Anything, mock.Anything).
+					Return(mbResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without items",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request zero quantity",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"items": []map[string]interface{}{{"menu_id": "nasi", "quantity": 0}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 unprocessable set menu bundle",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"items": "nasi",
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 bad request component of other warteg",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"items": []map[string]interface{}{{"menu_id": "nasi", "quantity": 1}, {"menu_id": "es-teh", "quantity": 1}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mbResponse := response.MenuBundle{}
+
+				mockMenu.
+					On("MenuBundleSet", mock.Anything, mock.Anything).
+					Return(mbResponse, constant.ErrInvalidBundleItem)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/menu/:menu_id/bundle",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/bundle")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuBundleSet(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
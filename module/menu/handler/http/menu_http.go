@@ -1,31 +1,118 @@
 package http
 
 import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
 	"github.com/cpartogi/foodmenu/constant"
 	"github.com/cpartogi/foodmenu/module/menu"
+	"github.com/cpartogi/foodmenu/pkg/spreadsheet"
 	"github.com/cpartogi/foodmenu/pkg/utils"
 	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
 	"github.com/labstack/echo/v4"
+	"github.com/spf13/viper"
+	log "go.uber.org/zap"
 )
 
+const formatJSON = "json"
+
+var exportHeader = []interface{}{"menu_id", "menu_type_name", "warteg_id", "menu_name", "menu_detail", "menu_picture", "menu_price", "effective_price", "updated_date"}
+
+var exportContentTypes = map[string]string{
+	spreadsheet.FormatCSV:  "text/csv; charset=utf-8",
+	spreadsheet.FormatXLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
 // AuthHandler  represent the httphandler for auth
 type MenuHandler struct {
-	menuUsecase menu.Usecase
+	menuUsecase  menu.Usecase
+	maxImageSize int64
 }
 
 // NewAuthHandler will initialize the contact/ resources endpoint
 func NewMenuHandler(e *echo.Echo, us menu.Usecase) {
 	handler := &MenuHandler{
-		menuUsecase: us,
+		menuUsecase:  us,
+		maxImageSize: viper.GetInt64("upload.max_size"),
 	}
 
 	router := e.Group("/v1")
-	router.GET("/menus/typelist", handler.MenuType)
-	router.GET("/menus/list", handler.MenuList)
+	router.GET("/menus/typelist", handler.MenuType, utils.CacheControl(viper.GetString("cache_control.menu_type")))
+	router.GET("/menus/list", handler.MenuList, utils.CacheControl(viper.GetString("cache_control.menu_list")))
 	router.POST("/menu", handler.MenuAdd)
 	router.DELETE("/menu/:menu_id", handler.MenuDelete)
 	router.PUT("/menu/:menu_id", handler.MenuUpdate)
-	router.GET("/menu/:menu_id", handler.MenuDetail)
+	router.GET("/menu/:menu_id", handler.MenuDetail, utils.CacheControl(viper.GetString("cache_control.menu_detail")))
+	router.GET("/menus/search", handler.MenuSearch)
+	router.GET("/menus/suggest", handler.MenuSuggest)
+	router.GET("/menus/changes", handler.MenuChanges)
+	router.GET("/wartegs/:id/menus/stream", handler.MenuStream)
+	router.PUT("/wartegs/:id/location", handler.WartegLocationSet)
+	router.GET("/wartegs/:id/location", handler.WartegLocation)
+	router.PUT("/wartegs/:id/hours", handler.WartegHoursSet)
+	router.GET("/wartegs/:id/hours", handler.WartegHours)
+	router.PUT("/wartegs/:id/brand", handler.BrandWartegSet)
+	router.DELETE("/wartegs/:id/brand", handler.BrandWartegDelete)
+	router.POST("/menus/import", handler.MenuImport)
+	router.GET("/menus/export", handler.MenuExport)
+	router.POST("/menu/:menu_id/images", handler.MenuImageAdd)
+	router.GET("/menu/:menu_id/images", handler.MenuImageList)
+	router.PUT("/menu/:menu_id/images/order", handler.MenuImageOrder)
+	router.DELETE("/menu/:menu_id/images/:image_id", handler.MenuImageDelete)
+	router.GET("/menu/:menu_id/availability", handler.MenuAvailability)
+	router.PUT("/menu/:menu_id/soldout", handler.MenuSoldOut)
+	router.PUT("/menu/:menu_id/restock", handler.MenuRestock)
+	router.POST("/menu/:menu_id/consume", handler.MenuConsume)
+	router.POST("/menu/:menu_id/variants", handler.MenuVariantAdd)
+	router.GET("/menu/:menu_id/variants", handler.MenuVariantList)
+	router.PUT("/menu/:menu_id/variants/:variant_id", handler.MenuVariantUpdate)
+	router.DELETE("/menu/:menu_id/variants/:variant_id", handler.MenuVariantDelete)
+	router.POST("/modifiers", handler.ModifierGroupAdd)
+	router.GET("/modifiers", handler.ModifierGroupList)
+	router.GET("/modifiers/:group_id", handler.ModifierGroupDetail)
+	router.PUT("/modifiers/:group_id", handler.ModifierGroupUpdate)
+	router.DELETE("/modifiers/:group_id", handler.ModifierGroupDelete)
+	router.GET("/menu/:menu_id/modifiers", handler.MenuModifierList)
+	router.PUT("/menu/:menu_id/modifiers", handler.MenuModifierSet)
+	router.POST("/menu/:menu_id/modifiers/validate", handler.MenuModifierValidate)
+	router.GET("/menu/:menu_id/bundle", handler.MenuBundle)
+	router.PUT("/menu/:menu_id/bundle", handler.MenuBundleSet)
+	router.GET("/menu/:menu_id/windows", handler.MenuWindows)
+	router.PUT("/menu/:menu_id/windows", handler.MenuWindowSet)
+	router.PUT("/menu/:menu_id/status", handler.MenuStatusSet)
+	router.GET("/staff/menus", handler.MenuStaffList)
+	router.GET("/staff/menu/:menu_id", handler.MenuPreview)
+	router.POST("/promotions", handler.PromotionAdd)
+	router.GET("/promotions", handler.PromotionList)
+	router.GET("/promotions/:promotion_id", handler.PromotionDetail)
+	router.PUT("/promotions/:promotion_id", handler.PromotionUpdate)
+	router.DELETE("/promotions/:promotion_id", handler.PromotionDelete)
+	router.GET("/menu/:menu_id/prices", handler.MenuPrices)
+	router.POST("/menu/:menu_id/prices", handler.MenuPriceScheduleAdd)
+	router.DELETE("/menu/:menu_id/prices/:schedule_id", handler.MenuPriceScheduleCancel)
+	router.GET("/price-changes", handler.MenuPriceChangeList)
+	router.POST("/menu/:menu_id/price-changes/:change_id/approve", handler.MenuPriceChangeApprove)
+	router.POST("/menu/:menu_id/price-changes/:change_id/reject", handler.MenuPriceChangeReject)
+	router.POST("/brands", handler.BrandAdd)
+	router.GET("/brands", handler.BrandList)
+	router.GET("/brands/:brand_id/menus", handler.BrandMenuList)
+	router.POST("/brands/:brand_id/menus", handler.BrandMenuAdd)
+	router.PUT("/brands/:brand_id/menus/:template_id", handler.BrandMenuUpdate)
+	router.DELETE("/brands/:brand_id/menus/:template_id", handler.BrandMenuDelete)
+	router.POST("/brands/:brand_id/propagate", handler.BrandPropagate)
+	router.GET("/brands/:brand_id/divergence", handler.BrandDivergence)
+	router.GET("/audit-logs", handler.AuditList)
+	router.POST("/webhooks", handler.WebhookAdd)
+	router.GET("/webhooks", handler.WebhookList)
+	router.GET("/webhooks/:webhook_id", handler.WebhookDetail)
+	router.PUT("/webhooks/:webhook_id", handler.WebhookUpdate)
+	router.DELETE("/webhooks/:webhook_id", handler.WebhookDelete)
+	router.GET("/webhooks/:webhook_id/deliveries", handler.WebhookDeliveryList)
+	router.POST("/webhooks/:webhook_id/deliveries/:delivery_id/redeliver", handler.WebhookRedeliver)
 }
 
 // Menu Type godoc
@@ -34,7 +121,10 @@ func NewMenuHandler(e *echo.Echo, us menu.Usecase) {
 // @Tags Menu
 // @Accept  json
 // @Produce  json
+// @Param If-None-Match header string false "ETag from previous response"
+// @Param If-Modified-Since header string false "Last-Modified from previous response"
 // @Success 200 {object} response.SwaggerMenuType
+// @Success 304 "Not Modified"
 // @Failure 400 {object} response.Base
 // @Failure 404 {object} response.Base
 // @Failure 422 {object} response.Base
@@ -49,16 +139,17 @@ func (h *MenuHandler) MenuType(c echo.Context) error {
 		return utils.ErrorResponse(c, err, map[string]interface{}{})
 	}
 
-	return utils.SuccessResponse(c, constant.SuccessGetData, bal)
+	return utils.CacheableResponse(c, constant.SuccessGetData, bal, menuTypeLastModified(bal))
 
 }
 
 // MenuAdd godoc
 // @Summary Add Menu
-// @Description Add Menu
+// @Description Add Menu, published unless status is draft. A draft is hidden from customers and, with publish_at, published by the publish scheduler at that time
 // @Tags Menu
 // @Accept  json
 // @Produce  json
+// @Param X-Actor-Id header string false "Actor Id"
 // @Param request body request.Menu true "Request Body"
 // @Success 201 {object} response.SwaggerMenuAdd
 // @Failure 400 {object} response.Base
@@ -100,6 +191,7 @@ func (h *MenuHandler) MenuAdd(c echo.Context) error {
 // @Success 200 {object} response.Base
 // @Failure 400 {object} response.Base
 // @Failure 404 {object} response.Base
+// @Failure 409 {object} response.Base
 // @Failure 422 {object} response.Base
 // @Failure 500 {object} response.Base
 // @Router /v1/menu/{menu_id} [delete]
@@ -118,15 +210,17 @@ func (h *MenuHandler) MenuDelete(c echo.Context) error {
 
 // MenuUpdate godoc
 // @Summary Update Menu
-// @Description Update Menu
+// @Description Update Menu, a price change beyond the configured percentage of the current price is not applied but returned as price_change waiting for approval by another actor, the price can not be changed while such a change is pending
 // @Tags Menu
 // @Accept  json
 // @Produce  json
 // @Param menu_id path string true "Menu Id"
+// @Param X-Actor-Id header string false "Actor Id"
 // @Param request body request.MenuUpdate true "Request Body"
-// @Success 200 {object} response.Base
+// @Success 200 {object} response.SwaggerMenuUpdate
 // @Failure 400 {object} response.Base
 // @Failure 404 {object} response.Base
+// @Failure 409 {object} response.Base
 // @Failure 422 {object} response.Base
 // @Failure 500 {object} response.Base
 // @Router /v1/menu/{menu_id} [put]
@@ -153,6 +247,10 @@ func (h *MenuHandler) MenuUpdate(c echo.Context) error {
 		return utils.ErrorResponse(c, err, map[string]interface{}{})
 	}
 
+	if reg.PriceChange != nil {
+		return utils.SuccessResponse(c, "Succes update menu, price change is waiting for approval", reg)
+	}
+
 	return utils.SuccessResponse(c, "Succes update menu", reg)
 
 }
@@ -166,7 +264,16 @@ func (h *MenuHandler) MenuUpdate(c echo.Context) error {
 // @Param warteg_id query string false  "warteg id"
 // @Param menu_type_id query string false "menu type id"
 // @Param menu_name query string false "menu name"
+// @Param available_only query boolean false "hide sold out menus"
+// @Param lat query number false "latitude, lists menus of wartegs near lat and lng, nearest first with distance in meters"
+// @Param lng query number false "longitude"
+// @Param radius query number false "radius in meters around lat and lng, default 1000, at most 50000"
+// @Param open_only query boolean false "hide menus of wartegs closed now, wartegs without opening hours are taken as open"
+// @Param at query string false "RFC3339 time to preview the list at instead of now, applies to open_only, serving windows and promotions"
+// @Param If-None-Match header string false "ETag from previous response"
+// @Param If-Modified-Since header string false "Last-Modified from previous response"
 // @Success 200 {object} response.SwaggerMenuList
+// @Success 304 "Not Modified"
 // @Failure 400 {object} response.Base
 // @Failure 404 {object} response.Base
 // @Failure 422 {object} response.Base
@@ -175,17 +282,23 @@ func (h *MenuHandler) MenuUpdate(c echo.Context) error {
 // MenuList handles HTTP request for menu list
 func (h *MenuHandler) MenuList(c echo.Context) error {
 	ctx := c.Request().Context()
-	queryValues := c.Request().URL.Query()
-	wartegId := queryValues.Get("warteg_id")
-	menu_typeid := queryValues.Get("menu_type_id")
-	menu_name := queryValues.Get("menu_name")
 
-	menu, err := h.menuUsecase.MenuList(ctx, wartegId, menu_typeid, menu_name)
+	filter, err := menuListFilter(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	menu, err := h.menuUsecase.MenuList(ctx, filter)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	modified, err := h.menuUsecase.MenuListModified(ctx, filter)
 	if err != nil {
 		return utils.ErrorResponse(c, err, map[string]interface{}{})
 	}
 
-	return utils.SuccessResponse(c, constant.SuccessGetData, menu)
+	return utils.CacheableResponse(c, constant.SuccessGetData, menu, menuListLastModified(menu, modified))
 }
 
 // MenuDetail godoc
@@ -195,7 +308,11 @@ func (h *MenuHandler) MenuList(c echo.Context) error {
 // @Accept  json
 // @Produce  json
 // @Param menu_id path string true "Menu Id"
+// @Param at query string false "RFC3339 time to preview the menu at instead of now, applies to serving windows and promotions"
+// @Param If-None-Match header string false "ETag from previous response"
+// @Param If-Modified-Since header string false "Last-Modified from previous response"
 // @Success 200 {object} response.SwaggerMenuDetail
+// @Success 304 "Not Modified"
 // @Failure 400 {object} response.Base
 // @Failure 404 {object} response.Base
 // @Failure 422 {object} response.Base
@@ -206,10 +323,318 @@ func (h *MenuHandler) MenuDetail(c echo.Context) error {
 	ctx := c.Request().Context()
 	menuId := c.Param("menu_id")
 
-	md, err := h.menuUsecase.MenuDetail(ctx, menuId)
+	at, err := previewTime(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	var md response.MenuDetail
+	if at != nil {
+		md, err = h.menuUsecase.MenuDetailAt(ctx, menuId, *at)
+	} else {
+		md, err = h.menuUsecase.MenuDetail(ctx, menuId)
+	}
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CacheableResponse(c, constant.SuccessGetData, md, md.UpdatedDate)
+}
+
+// MenuChanges godoc
+// @Summary  Menu Changes
+// @Description Created, updated and deleted menus since a sync token, for offline clients
+// @Tags Menu
+// @Accept  json
+// @Produce  json
+// @Param since query string false "sync token from previous response, empty for full sync"
+// @Param warteg_id query string false "warteg id"
+// @Success 200 {object} response.SwaggerMenuChanges
+// @Failure 400 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menus/changes [get]
+// MenuChanges handles HTTP request for menu delta sync
+func (h *MenuHandler) MenuChanges(c echo.Context) error {
+	ctx := c.Request().Context()
+	queryValues := c.Request().URL.Query()
+	since := queryValues.Get("since")
+	wartegId := queryValues.Get("warteg_id")
+
+	mc, err := h.menuUsecase.MenuChanges(ctx, since, wartegId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, mc)
+}
+
+// MenuImport godoc
+// @Summary Import Menu
+// @Description Import menus from csv or xlsx file with columns name, type, price, detail and picture
+// @Tags Menu
+// @Accept  mpfd
+// @Produce  json
+// @Param file formData file true "csv or xlsx file"
+// @Param warteg_id formData string true "warteg id"
+// @Param dry_run formData boolean false "validate only, nothing is saved"
+// @Success 200 {object} response.SwaggerMenuImport
+// @Success 201 {object} response.SwaggerMenuImport
+// @Failure 400 {object} response.SwaggerMenuImport
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menus/import [post]
+// MenuImport handles HTTP request for bulk menu import
+func (h *MenuHandler) MenuImport(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := request.MenuImport{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return utils.ErrorInternalServerResponse(c, err, map[string]interface{}{})
+	}
+	defer src.Close()
+
+	req.Records, err = spreadsheet.ReadAll(src, spreadsheet.FormatFromFilename(file.Filename))
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	mi, err := h.menuUsecase.MenuImport(ctx, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, mi)
+	}
+
+	if !mi.Applied {
+		return utils.SuccessResponse(c, "Success validate menu import", mi)
+	}
+
+	return utils.CreatedResponse(c, "Success import menu", mi)
+}
+
+// MenuExport godoc
+// @Summary  Menu Export
+// @Description Download all published menus matching the filters as csv, xlsx or json with their effective price
+// @Tags Menu
+// @Produce  text/csv
+// @Produce  application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Produce  json
+// @Param format query string false "csv, xlsx or json, default csv"
+// @Param warteg_id query string false  "warteg id"
+// @Param menu_type_id query string false "menu type id"
+// @Param menu_name query string false "menu name"
+// @Param available_only query boolean false "hide sold out menus"
+// @Param lat query number false "latitude, exports menus of wartegs near lat and lng"
+// @Param lng query number false "longitude"
+// @Param radius query number false "radius in meters around lat and lng, default 1000, at most 50000"
+// @Param open_only query boolean false "hide menus of wartegs closed now, wartegs without opening hours are taken as open"
+// @Param at query string false "RFC3339 time to preview the list at instead of now, applies to open_only, serving windows and promotions"
+// @Success 200 {file} file
+// @Failure 400 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menus/export [get]
+// MenuExport handles HTTP request for menu export
+func (h *MenuHandler) MenuExport(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := menuListFilter(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = spreadsheet.FormatCSV
+	}
+
+	var write func(response.MenuExport) error
+	var flush func() error
+
+	res := c.Response()
+	buf := bufio.NewWriter(res)
+
+	switch format {
+	case formatJSON:
+		res.Header().Set(echo.HeaderContentType, echo.MIMEApplicationJSONCharsetUTF8)
+		first := true
+		write = func(m response.MenuExport) error {
+			b, err := json.Marshal(m)
+			if err != nil {
+				return err
+			}
+			sep := ","
+			if first {
+				sep, first = "[", false
+			}
+			buf.WriteString(sep)
+			_, err = buf.Write(b)
+			return err
+		}
+		flush = func() error {
+			if first {
+				buf.WriteString("[")
+			}
+			buf.WriteString("]")
+			return buf.Flush()
+		}
+	default:
+		sw, err := spreadsheet.NewWriter(buf, format)
+		if err != nil {
+			return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+		}
+
+		defer sw.Close()
+
+		res.Header().Set(echo.HeaderContentType, exportContentTypes[format])
+		err = sw.Write(exportHeader)
+		if err != nil {
+			return utils.ErrorInternalServerResponse(c, err, map[string]interface{}{})
+		}
+
+		write = func(m response.MenuExport) error {
+			return sw.Write([]interface{}{
+				m.MenuId,
+				m.MenuTypeName,
+				m.WartegId,
+				m.MenuName,
+				m.MenuDetail,
+				m.MenuPicture,
+				m.MenuPrice,
+				m.EffectivePrice,
+				m.UpdatedDate.UTC().Format(time.RFC3339),
+			})
+		}
+		flush = func() error {
+			if err := sw.Flush(); err != nil {
+				return err
+			}
+			return buf.Flush()
+		}
+	}
+
+	filename := fmt.Sprintf("menus-%s.%s", time.Now().Format("20060102"), format)
+	res.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", filename))
+
+	err = h.menuUsecase.MenuExport(ctx, filter, write)
+	if err == nil {
+		err = flush()
+	}
+
 	if err != nil {
+		// once streaming has started the status can not be changed anymore
+		if res.Committed {
+			log.S().Errorf("export menu error : %s ", err.Error())
+			return nil
+		}
+		res.Header().Del(echo.HeaderContentDisposition)
+		res.Header().Del(echo.HeaderContentType)
 		return utils.ErrorResponse(c, err, map[string]interface{}{})
 	}
 
-	return utils.SuccessResponse(c, constant.SuccessGetData, md)
+	return nil
+}
+
+// menuListFilter reads menu list filters from query string
+func menuListFilter(c echo.Context) (filter request.MenuList, err error) {
+	queryValues := c.Request().URL.Query()
+	filter = request.MenuList{
+		WartegId:   queryValues.Get("warteg_id"),
+		MenuTypeId: queryValues.Get("menu_type_id"),
+		MenuName:   queryValues.Get("menu_name"),
+	}
+
+	if v := queryValues.Get("available_only"); v != "" {
+		filter.AvailableOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			return filter, fmt.Errorf("available_only must be true or false")
+		}
+	}
+
+	if v := queryValues.Get("open_only"); v != "" {
+		filter.OpenOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			return filter, fmt.Errorf("open_only must be true or false")
+		}
+	}
+
+	// ranges are written so NaN, which fails every comparison, is rejected as well as Inf
+	lat, lng := queryValues.Get("lat"), queryValues.Get("lng")
+	if lat != "" || lng != "" {
+		latitude, err := strconv.ParseFloat(lat, 64)
+		if err != nil || !(latitude >= -90 && latitude <= 90) {
+			return filter, fmt.Errorf("lat must be a number between -90 and 90")
+		}
+		longitude, err := strconv.ParseFloat(lng, 64)
+		if err != nil || !(longitude >= -180 && longitude <= 180) {
+			return filter, fmt.Errorf("lng must be a number between -180 and 180")
+		}
+		filter.Lat, filter.Lng = &latitude, &longitude
+		filter.Radius = constant.MenuListRadius
+	}
+
+	if v := queryValues.Get("radius"); v != "" {
+		if filter.Lat == nil {
+			return filter, fmt.Errorf("radius needs lat and lng")
+		}
+		filter.Radius, err = strconv.ParseFloat(v, 64)
+		if err != nil || !(filter.Radius > 0 && filter.Radius <= constant.MenuListMaxRadius) {
+			return filter, fmt.Errorf("radius must be a number of meters up to %d", constant.MenuListMaxRadius)
+		}
+	}
+
+	filter.At, err = previewTime(c)
+	if err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+// previewTime reads the at query parameter, nil when absent
+func previewTime(c echo.Context) (*time.Time, error) {
+	v := c.QueryParam("at")
+	if v == "" {
+		return nil, nil
+	}
+
+	at, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return nil, fmt.Errorf("at must be an RFC3339 time like 2024-01-31T12:00:00+07:00")
+	}
+	return &at, nil
+}
+
+// menuTypeLastModified returns the latest update of menu types, they are only written by migrations and never deleted
+func menuTypeLastModified(mt []response.MenuType) time.Time {
+	times := make([]time.Time, len(mt))
+	for i := range mt {
+		times[i] = mt[i].UpdatedDate
+	}
+	return utils.LatestUpdate(times...)
+}
+
+// menuListLastModified returns the latest of when the list last changed and of the listed menus, whose update
+// includes the start or end of their promotions
+func menuListLastModified(list []response.MenuList, modified time.Time) time.Time {
+	times := make([]time.Time, len(list), len(list)+1)
+	for i := range list {
+		times[i] = list[i].UpdatedDate
+	}
+	return utils.LatestUpdate(append(times, modified)...)
 }
@@ -1,13 +1,17 @@
 package http
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/cpartogi/foodmenu/constant"
 	"github.com/cpartogi/foodmenu/module/menu/mocks"
 	"github.com/cpartogi/foodmenu/schema/response"
 	"github.com/labstack/echo/v4"
@@ -232,6 +236,72 @@ func TestMenuAdd(t *testing.T) {
 					Return(mnResponse, errorMenu)
 			},
 		},
+		{
+			name: "#5 success insert draft with publish time",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_name":    "b",
+					"menu_price":   1,
+					"menu_type_id": 1,
+					"warteg_id":    "d",
+					"status":       "draft",
+					"publish_at":   "2027-03-15T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuAdd{}
+				mnResponse.Status = "draft"
+
+				mockMenu.
+					On("MenuAdd", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#6 bad request add archived menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_name":    "b",
+					"menu_price":   1,
+					"menu_type_id": 1,
+					"warteg_id":    "d",
+					"status":       "archived",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#7 bad request publish time of published menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_name":    "b",
+					"menu_price":   1,
+					"menu_type_id": 1,
+					"warteg_id":    "d",
+					"publish_at":   "2027-03-15T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuAdd{}
+
+				mockMenu.
+					On("MenuAdd", mock.Anything, mock.Anything).
+					Return(mnResponse, constant.ErrInvalidMenuStatus)
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -325,6 +395,23 @@ func TestMenuDelete(t *testing.T) {
 					Return(mnResponse, errorMenu)
 			},
 		},
+		{
+			name: "#3 conflict delete menu used in bundle",
+			expectedInput: input{
+				menu_id: "abc",
+			},
+			expectedOutput: output{nil, http.StatusConflict},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDelete{}
+
+				mockMenu.
+					On("MenuDelete", mock.Anything, mock.Anything).
+					Return(mnResponse, constant.ErrMenuInBundle)
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -481,6 +568,63 @@ func TestMenuUpdate(t *testing.T) {
 					Return(mnResponse, errorMenu)
 			},
 		},
+		{
+			name: "#5 success update with price change waiting for approval",
+			expectedInput: input{
+				menu_id: "abc",
+				req: map[string]interface{}{
+					"menu_detail":  "a",
+					"menu_name":    "b",
+					"menu_picture": "c",
+					"menu_price":   50000,
+					"menu_type_id": 1,
+					"warteg_id":    "d",
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuUpdate{
+					MenuPrice: 10000,
+					PriceChange: &response.MenuPriceChange{
+						OldPrice: 10000,
+						NewPrice: 50000,
+						Status:   constant.PriceChangePending,
+					},
+				}
+
+				mockMenu.
+					On("MenuUpdate", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#6 conflict price change already waiting for approval",
+			expectedInput: input{
+				menu_id: "abc",
+				req: map[string]interface{}{
+					"menu_detail":  "a",
+					"menu_name":    "b",
+					"menu_picture": "c",
+					"menu_price":   50000,
+					"menu_type_id": 1,
+					"warteg_id":    "d",
+				},
+			},
+			expectedOutput: output{nil, http.StatusConflict},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuUpdate{}
+
+				mockMenu.
+					On("MenuUpdate", mock.Anything, mock.Anything).
+					Return(mnResponse, constant.ErrPriceChangePending)
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -522,7 +666,9 @@ func TestMenuUpdate(t *testing.T) {
 
 func TestMenuList(t *testing.T) {
 	type input struct {
-		warteg_id string
+		warteg_id         string
+		query             string
+		if_modified_since string
 	}
 
 	type output struct {
@@ -554,119 +700,544 @@ func TestMenuList(t *testing.T) {
 				mockMenu.
 					On("MenuList", mock.Anything, mock.Anything).
 					Return(mnResponse, nil)
+				mockMenu.
+					On("MenuListModified", mock.Anything, mock.Anything).
+					Return(time.Time{}, nil)
 			},
 		},
-	}
-
-	for _, testCase := range cases {
-		t.Run(testCase.name, func(t *testing.T) {
-			mockMenu := new(mocks.Usecase)
-
-			warteg_id := testCase.expectedInput.warteg_id
-
-			e := echo.New()
-
-			req, err := http.NewRequest(echo.GET, "/v1/menus/list",
-				strings.NewReader(string(warteg_id)))
-
-			assert.NoError(t, err)
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-
-			rec := httptest.NewRecorder()
-			c := e.NewContext(req, rec)
-			c.SetPath("/v1/menus/list")
-
-			testCase.configureMock(
-				testCase.expectedInput,
-				mockMenu,
-			)
-
-			handler := MenuHandler{
-				menuUsecase: mockMenu,
-			}
-
-			err = handler.MenuList(c)
-			assert.Equal(t, testCase.expectedOutput.err, err)
-			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
-
-		})
-	}
-}
-
-func TestMenuDetail(t *testing.T) {
-	type input struct {
-		menu_id string
-	}
-
-	type output struct {
-		err        error
-		statusCode int
-	}
-
-	cases := []struct {
-		name           string
-		expectedInput  input
-		expectedOutput output
-		configureMock  func(
-			payload input,
-			mockMenu *mocks.Usecase,
-		)
-	}{
 		{
-			name: "#1 success get data",
+			name: "#2 success get menu list near location",
 			expectedInput: input{
-				menu_id: "abc",
+				query: "lat=-6.2088&lng=106.8456&radius=1000&menu_name=sayur",
 			},
 			expectedOutput: output{nil, http.StatusOK},
 			configureMock: func(
 				payload input,
 				mockMenu *mocks.Usecase,
 			) {
-				mnResponse := response.MenuDetail{}
+				mnResponse := []response.MenuList{}
 
 				mockMenu.
-					On("MenuDetail", mock.Anything, mock.Anything).
+					On("MenuList", mock.Anything, mock.Anything).
 					Return(mnResponse, nil)
+				mockMenu.
+					On("MenuListModified", mock.Anything, mock.Anything).
+					Return(time.Time{}, nil)
 			},
 		},
 		{
-			name: "#2 internal server error update",
+			name: "#3 bad request location without longitude",
 			expectedInput: input{
-				menu_id: "abc",
+				query: "lat=-6.2088",
 			},
-			expectedOutput: output{nil, http.StatusInternalServerError},
+			expectedOutput: output{nil, http.StatusBadRequest},
 			configureMock: func(
 				payload input,
 				mockMenu *mocks.Usecase,
 			) {
-				mnResponse := response.MenuDetail{}
+			},
+		},
+		{
+			name: "#4 bad request radius without location",
+			expectedInput: input{
+				query: "radius=1000",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 bad request radius too large",
+			expectedInput: input{
+				query: "lat=-6.2088&lng=106.8456&radius=100000",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#6 success get menu list of open wartegs",
+			expectedInput: input{
+				query: "open_only=true&available_only=true",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{}
 
 				mockMenu.
-					On("MenuDetail", mock.Anything, mock.Anything).
-					Return(mnResponse, errorMenu)
+					On("MenuList", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+				mockMenu.
+					On("MenuListModified", mock.Anything, mock.Anything).
+					Return(time.Time{}, nil)
 			},
 		},
-	}
-
-	for _, testCase := range cases {
-		t.Run(testCase.name, func(t *testing.T) {
-			mockMenu := new(mocks.Usecase)
-
-			menu_id := testCase.expectedInput.menu_id
-
-			e := echo.New()
-
-			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id",
-				strings.NewReader(string(menu_id)))
-
-			assert.NoError(t, err)
-			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
-
-			rec := httptest.NewRecorder()
-			c := e.NewContext(req, rec)
-			c.SetPath("/v1/menu/")
+		{
+			name: "#7 bad request invalid open only",
+			expectedInput: input{
+				query: "open_only=later",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#8 success preview menu list at time",
+			expectedInput: input{
+				query: "open_only=true&at=2027-03-10T07:30:00%2B07:00",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{}
 
-			testCase.configureMock(
+				mockMenu.
+					On("MenuList", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+				mockMenu.
+					On("MenuListModified", mock.Anything, mock.Anything).
+					Return(time.Time{}, nil)
+			},
+		},
+		{
+			name: "#9 bad request invalid preview time",
+			expectedInput: input{
+				query: "at=tomorrow",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#10 bad request lat not a number",
+			expectedInput: input{
+				query: "lat=NaN&lng=106.8456",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#11 bad request lng infinite",
+			expectedInput: input{
+				query: "lat=-6.2088&lng=-Inf",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#12 bad request radius not a number",
+			expectedInput: input{
+				query: "lat=-6.2088&lng=106.8456&radius=NaN",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#13 not modified since last change",
+			expectedInput: input{
+				query:             "warteg_id=asdfsdfsd",
+				if_modified_since: "Wed, 10 Feb 2021 22:43:22 GMT",
+			},
+			expectedOutput: output{nil, http.StatusNotModified},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{{MenuId: "abc", UpdatedDate: time.Date(2021, 2, 10, 22, 40, 0, 0, time.UTC)}}
+
+				mockMenu.
+					On("MenuList", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+				mockMenu.
+					On("MenuListModified", mock.Anything, mock.Anything).
+					Return(time.Date(2021, 2, 10, 22, 43, 22, 957000000, time.UTC), nil)
+			},
+		},
+		{
+			name: "#14 modified since by a deleted menu",
+			expectedInput: input{
+				query:             "warteg_id=asdfsdfsd",
+				if_modified_since: "Wed, 10 Feb 2021 22:43:22 GMT",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{{MenuId: "abc", UpdatedDate: time.Date(2021, 2, 10, 22, 40, 0, 0, time.UTC)}}
+
+				mockMenu.
+					On("MenuList", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+				mockMenu.
+					On("MenuListModified", mock.Anything, mock.Anything).
+					Return(time.Date(2021, 2, 10, 22, 50, 0, 0, time.UTC), nil)
+			},
+		},
+		{
+			name: "#15 internal server error last change",
+			expectedInput: input{
+				warteg_id: "asdfsdfsd",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{}
+
+				mockMenu.
+					On("MenuList", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+				mockMenu.
+					On("MenuListModified", mock.Anything, mock.Anything).
+					Return(time.Time{}, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			warteg_id := testCase.expectedInput.warteg_id
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menus/list?"+testCase.expectedInput.query,
+				strings.NewReader(string(warteg_id)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if testCase.expectedInput.if_modified_since != "" {
+				req.Header.Set(echo.HeaderIfModifiedSince, testCase.expectedInput.if_modified_since)
+			}
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menus/list")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuDetail(t *testing.T) {
+	type input struct {
+		menu_id           string
+		query             string
+		if_none_match     string
+		if_modified_since string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success get data",
+			expectedInput: input{
+				menu_id: "abc",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+
+				mockMenu.
+					On("MenuDetail", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#2 internal server error update",
+			expectedInput: input{
+				menu_id: "abc",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+
+				mockMenu.
+					On("MenuDetail", mock.Anything, mock.Anything).
+					Return(mnResponse, errorMenu)
+			},
+		},
+		{
+			name: "#3 not modified by etag",
+			expectedInput: input{
+				menu_id:       "abc",
+				if_none_match: `"abc", *`,
+			},
+			expectedOutput: output{nil, http.StatusNotModified},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+
+				mockMenu.
+					On("MenuDetail", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#4 not modified since",
+			expectedInput: input{
+				menu_id:           "abc",
+				if_modified_since: "Wed, 10 Feb 2021 22:43:22 GMT",
+			},
+			expectedOutput: output{nil, http.StatusNotModified},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+				mnResponse.UpdatedDate = time.Date(2021, 2, 10, 22, 43, 22, 957000000, time.UTC)
+
+				mockMenu.
+					On("MenuDetail", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#5 modified since",
+			expectedInput: input{
+				menu_id:           "abc",
+				if_modified_since: "Wed, 10 Feb 2021 22:43:21 GMT",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+				mnResponse.UpdatedDate = time.Date(2021, 2, 10, 22, 43, 22, 957000000, time.UTC)
+
+				mockMenu.
+					On("MenuDetail", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#6 success preview at time",
+			expectedInput: input{
+				menu_id: "abc",
+				query:   "at=2027-03-10T07:30:00Z",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{IsOffSchedule: true}
+
+				mockMenu.
+					On("MenuDetailAt", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#7 bad request invalid preview time",
+			expectedInput: input{
+				menu_id: "abc",
+				query:   "at=2027-03-10",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			menu_id := testCase.expectedInput.menu_id
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id?"+testCase.expectedInput.query,
+				strings.NewReader(string(menu_id)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if testCase.expectedInput.if_none_match != "" {
+				req.Header.Set("If-None-Match", testCase.expectedInput.if_none_match)
+			}
+			if testCase.expectedInput.if_modified_since != "" {
+				req.Header.Set(echo.HeaderIfModifiedSince, testCase.expectedInput.if_modified_since)
+			}
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuDetail(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuChanges(t *testing.T) {
+	type input struct {
+		since string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success get menu changes",
+			expectedInput: input{
+				since: "10",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mcResponse := response.MenuChanges{}
+				mcResponse.SyncToken = "12"
+
+				mockMenu.
+					On("MenuChanges", mock.Anything, mock.Anything).
+					Return(mcResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request invalid sync token",
+			expectedInput: input{
+				since: "abc",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mcResponse := response.MenuChanges{}
+
+				mockMenu.
+					On("MenuChanges", mock.Anything, mock.Anything).
+					Return(mcResponse, constant.ErrInvalidSyncToken)
+			},
+		},
+		{
+			name: "#3 internal server error menu changes",
+			expectedInput: input{
+				since: "10",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mcResponse := response.MenuChanges{}
+
+				mockMenu.
+					On("MenuChanges", mock.Anything, mock.Anything).
+					Return(mcResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menus/changes?since="+testCase.expectedInput.since, nil)
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menus/changes")
+
+			testCase.configureMock(
 				testCase.expectedInput,
 				mockMenu,
 			)
@@ -675,9 +1246,299 @@ func TestMenuDetail(t *testing.T) {
 				menuUsecase: mockMenu,
 			}
 
-			err = handler.MenuDetail(c)
+			err = handler.MenuChanges(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuImport(t *testing.T) {
+	type input struct {
+		filename string
+		content  string
+		fields   map[string]string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success import menu",
+			expectedInput: input{
+				filename: "menu.csv",
+				content:  "name,type,price\nRendang,Makanan,15000\n",
+				fields:   map[string]string{"warteg_id": "d"},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := response.MenuImport{}
+				miResponse.Applied = true
+
+				mockMenu.
+					On("MenuImport", mock.Anything, mock.Anything).
+					Return(miResponse, nil)
+			},
+		},
+		{
+			name: "#2 success dry run import menu",
+			expectedInput: input{
+				filename: "menu.csv",
+				content:  "name,type,price\nRendang,Makanan,15000\n",
+				fields:   map[string]string{"warteg_id": "d", "dry_run": "true"},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := response.MenuImport{}
+				miResponse.DryRun = true
+
+				mockMenu.
+					On("MenuImport", mock.Anything, mock.Anything).
+					Return(miResponse, nil)
+			},
+		},
+		{
+			name: "#3 bad request without warteg id",
+			expectedInput: input{
+				filename: "menu.csv",
+				content:  "name,type,price\nRendang,Makanan,15000\n",
+				fields:   map[string]string{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request unsupported format",
+			expectedInput: input{
+				filename: "menu.txt",
+				content:  "name,type,price\nRendang,Makanan,15000\n",
+				fields:   map[string]string{"warteg_id": "d"},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 bad request invalid rows",
+			expectedInput: input{
+				filename: "menu.csv",
+				content:  "name,type,price\nRendang,Makanan,abc\n",
+				fields:   map[string]string{"warteg_id": "d"},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := response.MenuImport{}
+				miResponse.Invalid = 1
+
+				mockMenu.
+					On("MenuImport", mock.Anything, mock.Anything).
+					Return(miResponse, constant.ErrImportRowInvalid)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			for k, v := range testCase.expectedInput.fields {
+				assert.NoError(t, writer.WriteField(k, v))
+			}
+			part, err := writer.CreateFormFile("file", testCase.expectedInput.filename)
+			assert.NoError(t, err)
+			_, err = part.Write([]byte(testCase.expectedInput.content))
+			assert.NoError(t, err)
+			assert.NoError(t, writer.Close())
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/menus/import", body)
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menus/import")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuImport(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuExport(t *testing.T) {
+	type input struct {
+		format string
+	}
+
+	type output struct {
+		err         error
+		statusCode  int
+		contentType string
+	}
+
+	rows := []response.MenuExport{
+		{
+			MenuId:       "a",
+			MenuTypeName: "Makanan",
+			WartegId:     "d",
+			MenuName:     "Rendang",
+			MenuPrice:    15000,
+		},
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success export csv",
+			expectedInput: input{
+				format: "csv",
+			},
+			expectedOutput: output{nil, http.StatusOK, "text/csv; charset=utf-8"},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuExport", mock.Anything, mock.Anything).
+					Return(rows, nil)
+			},
+		},
+		{
+			name: "#2 success export json",
+			expectedInput: input{
+				format: "json",
+			},
+			expectedOutput: output{nil, http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuExport", mock.Anything, mock.Anything).
+					Return(rows, nil)
+			},
+		},
+		{
+			name: "#3 success export xlsx",
+			expectedInput: input{
+				format: "xlsx",
+			},
+			expectedOutput: output{nil, http.StatusOK, "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuExport", mock.Anything, mock.Anything).
+					Return(rows, nil)
+			},
+		},
+		{
+			name: "#4 bad request unsupported format",
+			expectedInput: input{
+				format: "pdf",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, echo.MIMEApplicationJSON},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 internal server error export",
+			expectedInput: input{
+				format: "csv",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError, echo.MIMEApplicationJSON},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuExport", mock.Anything, mock.Anything).
+					Return(nil, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menus/export?format="+testCase.expectedInput.format, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menus/export")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuExport(c)
 			assert.Equal(t, testCase.expectedOutput.err, err)
 			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+			assert.Contains(t, rec.Header().Get(echo.HeaderContentType), testCase.expectedOutput.contentType)
 
 		})
 	}
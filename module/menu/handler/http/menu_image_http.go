@@ -0,0 +1,162 @@
+package http
+
+import (
+	"io/ioutil"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuImageAdd godoc
+// @Summary Upload Menu Images
+// @Description Upload one or more images, they are appended to the end of menu gallery. An image above the configured size or pixel count is rejected and nothing is stored when any file fails
+// @Tags Menu Image
+// @Accept  mpfd
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param images formData file true "jpeg, png, gif or webp image, repeat the field for multiple images"
+// @Success 201 {object} response.SwaggerMenuImage
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/images [post]
+// MenuImageAdd handles HTTP request for menu image upload
+func (h *MenuHandler) MenuImageAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	form, err := c.MultipartForm()
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	headers := form.File["images"]
+	if len(headers) == 0 {
+		return utils.ErrorResponse(c, constant.ErrImageMandatory, map[string]interface{}{})
+	}
+
+	maxSize := h.maxImageSize
+	if maxSize <= 0 {
+		maxSize = constant.MaxImageSize
+	}
+
+	files := make([]request.MenuImageFile, 0, len(headers))
+	for _, fh := range headers {
+		if fh.Size > maxSize {
+			return utils.ErrorResponse(c, constant.ErrImageTooLarge, map[string]interface{}{})
+		}
+
+		src, err := fh.Open()
+		if err != nil {
+			return utils.ErrorInternalServerResponse(c, err, map[string]interface{}{})
+		}
+
+		data, err := ioutil.ReadAll(src)
+		src.Close()
+		if err != nil {
+			return utils.ErrorInternalServerResponse(c, err, map[string]interface{}{})
+		}
+
+		files = append(files, request.MenuImageFile{
+			Filename: fh.Filename,
+			Data:     data,
+		})
+	}
+
+	images, err := h.menuUsecase.MenuImageAdd(ctx, menuId, files)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success upload menu image", images)
+}
+
+// MenuImageList godoc
+// @Summary Menu Images
+// @Description Menu gallery in display order
+// @Tags Menu Image
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerMenuImage
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/images [get]
+// MenuImageList handles HTTP request for menu image list
+func (h *MenuHandler) MenuImageList(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	images, err := h.menuUsecase.MenuImageList(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, images)
+}
+
+// MenuImageOrder godoc
+// @Summary Reorder Menu Images
+// @Description Set gallery order, image_ids must contain every image of the menu
+// @Tags Menu Image
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuImageOrder true "Request Body"
+// @Success 200 {object} response.SwaggerMenuImage
+// @Failure 400 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/images/order [put]
+// MenuImageOrder handles HTTP request for menu image reorder
+func (h *MenuHandler) MenuImageOrder(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuImageOrder{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	images, err := h.menuUsecase.MenuImageOrder(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success reorder menu image", images)
+}
+
+// MenuImageDelete godoc
+// @Summary Delete Menu Image
+// @Description Delete Menu Image with all of its variants
+// @Tags Menu Image
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param image_id path string true "Image Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/images/{image_id} [delete]
+// MenuImageDelete handles HTTP request for menu image delete
+func (h *MenuHandler) MenuImageDelete(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	imageId := c.Param("image_id")
+
+	err := h.menuUsecase.MenuImageDelete(ctx, menuId, imageId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success delete menu image", map[string]interface{}{})
+}
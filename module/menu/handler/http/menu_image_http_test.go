@@ -0,0 +1,420 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuImageAdd(t *testing.T) {
+	type input struct {
+		files        []string
+		maxImageSize int64
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success upload menu image",
+			expectedInput: input{
+				files: []string{"a.png", "b.png"},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := []response.MenuImage{}
+
+				mockMenu.
+					On("MenuImageAdd", mock.Anything, mock.Anything).
+					Return(miResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without image",
+			expectedInput: input{
+				files: []string{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request image too large",
+			expectedInput: input{
+				files:        []string{"a.png"},
+				maxImageSize: 1,
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request unsupported image type",
+			expectedInput: input{
+				files: []string{"a.txt"},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := []response.MenuImage{}
+
+				mockMenu.
+					On("MenuImageAdd", mock.Anything, mock.Anything).
+					Return(miResponse, constant.ErrInvalidImageType)
+			},
+		},
+		{
+			name: "#5 menu not found",
+			expectedInput: input{
+				files: []string{"a.png"},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := []response.MenuImage{}
+
+				mockMenu.
+					On("MenuImageAdd", mock.Anything, mock.Anything).
+					Return(miResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			body := new(bytes.Buffer)
+			writer := multipart.NewWriter(body)
+			for _, f := range testCase.expectedInput.files {
+				part, err := writer.CreateFormFile("images", f)
+				assert.NoError(t, err)
+				_, err = part.Write([]byte("image content"))
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, writer.Close())
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/menu/:menu_id/images", body)
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/images")
+			c.SetParamNames("menu_id")
+			c.SetParamValues("abc")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase:  mockMenu,
+				maxImageSize: testCase.expectedInput.maxImageSize,
+			}
+
+			err = handler.MenuImageAdd(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuImageList(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get menu images",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := []response.MenuImage{}
+
+				mockMenu.
+					On("MenuImageList", mock.Anything, mock.Anything).
+					Return(miResponse, nil)
+			},
+		},
+		{
+			name:           "#2 internal server error menu images",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := []response.MenuImage{}
+
+				mockMenu.
+					On("MenuImageList", mock.Anything, mock.Anything).
+					Return(miResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id/images", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/images")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuImageList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuImageOrder(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success reorder menu images",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"image_ids": []string{"b", "a"},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := []response.MenuImage{}
+
+				mockMenu.
+					On("MenuImageOrder", mock.Anything, mock.Anything).
+					Return(miResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without image ids",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable reorder menu images",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"image_ids": "a",
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request incomplete order",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"image_ids": []string{"a"},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				miResponse := []response.MenuImage{}
+
+				mockMenu.
+					On("MenuImageOrder", mock.Anything, mock.Anything).
+					Return(miResponse, constant.ErrInvalidImageOrder)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/menu/:menu_id/images/order",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/images/order")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuImageOrder(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuImageDelete(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success delete menu image",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuImageDelete", mock.Anything, mock.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:           "#2 menu image not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuImageDelete", mock.Anything, mock.Anything).
+					Return(constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.DELETE, "/v1/menu/:menu_id/images/:image_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/images/:image_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuImageDelete(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
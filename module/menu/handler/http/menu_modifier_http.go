@@ -0,0 +1,260 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// ModifierGroupAdd godoc
+// @Summary Add Modifier Group
+// @Description Add group of priced add-on options such as lauk tambahan, min_select greater than zero makes the group required
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param request body request.ModifierGroup true "Request Body"
+// @Success 201 {object} response.SwaggerModifierGroup
+// @Failure 400 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/modifiers [post]
+// ModifierGroupAdd handles HTTP request for adding modifier group
+func (h *MenuHandler) ModifierGroupAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := request.ModifierGroup{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	group, err := h.menuUsecase.ModifierGroupAdd(ctx, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success add modifier group", group)
+}
+
+// ModifierGroupList godoc
+// @Summary Modifier Groups
+// @Description Modifier groups with their options
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param warteg_id query string false "Warteg Id"
+// @Success 200 {object} response.SwaggerModifierGroups
+// @Failure 500 {object} response.Base
+// @Router /v1/modifiers [get]
+// ModifierGroupList handles HTTP request for modifier groups
+func (h *MenuHandler) ModifierGroupList(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.QueryParam("warteg_id")
+
+	groups, err := h.menuUsecase.ModifierGroupList(ctx, wartegId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, groups)
+}
+
+// ModifierGroupDetail godoc
+// @Summary Modifier Group Detail
+// @Description Modifier group with its options
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param group_id path string true "Group Id"
+// @Success 200 {object} response.SwaggerModifierGroup
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/modifiers/{group_id} [get]
+// ModifierGroupDetail handles HTTP request for modifier group detail
+func (h *MenuHandler) ModifierGroupDetail(c echo.Context) error {
+	ctx := c.Request().Context()
+	groupId := c.Param("group_id")
+
+	group, err := h.menuUsecase.ModifierGroupDetail(ctx, groupId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, group)
+}
+
+// ModifierGroupUpdate godoc
+// @Summary Update Modifier Group
+// @Description Update modifier group and replace its options, send option_id to keep an existing option
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param group_id path string true "Group Id"
+// @Param request body request.ModifierGroup true "Request Body"
+// @Success 200 {object} response.SwaggerModifierGroup
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/modifiers/{group_id} [put]
+// ModifierGroupUpdate handles HTTP request for updating modifier group
+func (h *MenuHandler) ModifierGroupUpdate(c echo.Context) error {
+	ctx := c.Request().Context()
+	groupId := c.Param("group_id")
+	req := request.ModifierGroup{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	group, err := h.menuUsecase.ModifierGroupUpdate(ctx, groupId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success update modifier group", group)
+}
+
+// ModifierGroupDelete godoc
+// @Summary Delete Modifier Group
+// @Description Delete modifier group, it is removed from every linked menu
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param group_id path string true "Group Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/modifiers/{group_id} [delete]
+// ModifierGroupDelete handles HTTP request for deleting modifier group
+func (h *MenuHandler) ModifierGroupDelete(c echo.Context) error {
+	ctx := c.Request().Context()
+	groupId := c.Param("group_id")
+
+	err := h.menuUsecase.ModifierGroupDelete(ctx, groupId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success delete modifier group", map[string]interface{}{})
+}
+
+// MenuModifierList godoc
+// @Summary Menu Modifier Groups
+// @Description Modifier groups linked to menu in display order
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerModifierGroups
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/modifiers [get]
+// MenuModifierList handles HTTP request for modifier groups of menu
+func (h *MenuHandler) MenuModifierList(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	groups, err := h.menuUsecase.MenuModifierList(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, groups)
+}
+
+// MenuModifierSet godoc
+// @Summary Link Menu Modifier Groups
+// @Description Replace modifier groups linked to menu, groups must belong to the warteg of the menu
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuModifier true "Request Body"
+// @Success 200 {object} response.SwaggerModifierGroups
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/modifiers [put]
+// MenuModifierSet handles HTTP request for linking modifier groups to menu
+func (h *MenuHandler) MenuModifierSet(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuModifier{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	groups, err := h.menuUsecase.MenuModifierSet(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success link menu modifier groups", groups)
+}
+
+// MenuModifierValidate godoc
+// @Summary Validate Modifier Selection
+// @Description Check selected options against min, max and required rules of every modifier group of menu and sum their price
+// @Tags Menu Modifier
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuModifierSelection true "Request Body"
+// @Success 200 {object} response.SwaggerMenuModifierSelection
+// @Failure 400 {object} response.SwaggerMenuModifierSelection
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/modifiers/validate [post]
+// MenuModifierValidate handles HTTP request for validating modifier selection
+func (h *MenuHandler) MenuModifierValidate(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuModifierSelection{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	ms, err := h.menuUsecase.MenuModifierValidate(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, ms)
+	}
+
+	return utils.SuccessResponse(c, "Modifier selection is valid", ms)
+}
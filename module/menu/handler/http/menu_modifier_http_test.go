@@ -0,0 +1,801 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestModifierGroupAdd(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success add modifier group",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"group_name": "Lauk tambahan",
+					"min_select": 0,
+					"max_select": 2,
+					"options":    []map[string]interface{}{{"option_name": "Telur dadar", "option_price": 4000}, {"option_name": "Tempe", "option_price": 2000}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupAdd", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without options",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"group_name": "Lauk tambahan",
+					"min_select": 0,
+					"max_select": 2,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request max select lower than min select",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"group_name": "Sambal",
+					"min_select": 2,
+					"max_select": 1,
+					"options":    []map[string]interface{}{{"option_name": "Telur dadar", "option_price": 4000}, {"option_name": "Tempe", "option_price": 2000}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 unprocessable add modifier group",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"group_name": "Sambal",
+					"max_select": "a",
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 bad request max select more than options",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"group_name": "Sambal",
+					"max_select": 3,
+					"options":    []map[string]interface{}{{"option_name": "Telur dadar", "option_price": 4000}, {"option_name": "Tempe", "option_price": 2000}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupAdd", mock.Anything, mock.Anything).
+					Return(mgResponse, constant.ErrInvalidModifierGroup)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/modifiers",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/modifiers")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.ModifierGroupAdd(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestModifierGroupList(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get modifier groups",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupList", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name:           "#2 internal server error modifier groups",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupList", mock.Anything, mock.Anything).
+					Return(mgResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/modifiers", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/modifiers")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.ModifierGroupList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestModifierGroupDetail(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get modifier group",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupDetail", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name:           "#2 modifier group not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupDetail", mock.Anything, mock.Anything).
+					Return(mgResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/modifiers/:group_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/modifiers/:group_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.ModifierGroupDetail(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestModifierGroupUpdate(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success update modifier group",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"group_name": "Lauk tambahan",
+					"min_select": 0,
+					"max_select": 2,
+					"options":    []map[string]interface{}{{"option_name": "Telur dadar", "option_price": 4000}, {"option_name": "Tempe", "option_price": 2000}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupUpdate", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without group name",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"max_select": 1,
+					"options":    []map[string]interface{}{{"option_name": "Telur dadar", "option_price": 4000}, {"option_name": "Tempe", "option_price": 2000}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 modifier group not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":  "w1",
+					"group_name": "Lauk tambahan",
+					"min_select": 0,
+					"max_select": 2,
+					"options":    []map[string]interface{}{{"option_name": "Telur dadar", "option_price": 4000}, {"option_name": "Tempe", "option_price": 2000}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := response.ModifierGroup{}
+
+				mockMenu.
+					On("ModifierGroupUpdate", mock.Anything, mock.Anything).
+					Return(mgResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/modifiers/:group_id",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/modifiers/:group_id")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.ModifierGroupUpdate(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestModifierGroupDelete(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success delete modifier group",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("ModifierGroupDelete", mock.Anything, mock.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:           "#2 modifier group not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("ModifierGroupDelete", mock.Anything, mock.Anything).
+					Return(constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.DELETE, "/v1/modifiers/:group_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/modifiers/:group_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.ModifierGroupDelete(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuModifierList(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get menu modifier groups",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.ModifierGroup{}
+
+				mockMenu.
+					On("MenuModifierList", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name:           "#2 menu not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.ModifierGroup{}
+
+				mockMenu.
+					On("MenuModifierList", mock.Anything, mock.Anything).
+					Return(mgResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id/modifiers", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/modifiers")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuModifierList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuModifierSet(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success link menu modifier groups",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"group_ids": []string{"g1", "g2"},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.ModifierGroup{}
+
+				mockMenu.
+					On("MenuModifierSet", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without group ids",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request group of other warteg",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"group_ids": []string{"g3"},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.ModifierGroup{}
+
+				mockMenu.
+					On("MenuModifierSet", mock.Anything, mock.Anything).
+					Return(mgResponse, constant.ErrInvalidModifierLink)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/menu/:menu_id/modifiers",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/modifiers")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuModifierSet(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuModifierValidate(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success validate modifier selection",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"selections": []map[string]interface{}{{"group_id": "g1", "option_ids": []string{"o1"}}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuModifierSelection{}
+
+				mockMenu.
+					On("MenuModifierValidate", mock.Anything, mock.Anything).
+					Return(msResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request selection without group id",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"selections": []map[string]interface{}{{"option_ids": []string{"o1"}}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request selection breaks group rules",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"selections": []map[string]interface{}{{"group_id": "g1", "option_ids": []string{"o1"}}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuModifierSelection{}
+
+				mockMenu.
+					On("MenuModifierValidate", mock.Anything, mock.Anything).
+					Return(msResponse, constant.ErrInvalidModifierSelection)
+			},
+		},
+		{
+			name: "#4 menu not found",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuModifierSelection{}
+
+				mockMenu.
+					On("MenuModifierValidate", mock.Anything, mock.Anything).
+					Return(msResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/menu/:menu_id/modifiers/validate",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/modifiers/validate")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuModifierValidate(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"fmt"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuPriceChangeList godoc
+// @Summary Menu Price Changes
+// @Description Price changes of menu updates beyond the approval threshold, oldest first
+// @Tags Menu Price
+// @Accept  json
+// @Produce  json
+// @Param status query string false "pending, approved or rejected, default pending"
+// @Param menu_id query string false "menu id"
+// @Param warteg_id query string false "warteg id"
+// @Success 200 {object} response.SwaggerMenuPriceChanges
+// @Failure 400 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/price-changes [get]
+// MenuPriceChangeList handles HTTP request for price changes
+func (h *MenuHandler) MenuPriceChangeList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter := request.MenuPriceChangeFilter{
+		Status:   c.QueryParam("status"),
+		MenuId:   c.QueryParam("menu_id"),
+		WartegId: c.QueryParam("warteg_id"),
+	}
+
+	switch filter.Status {
+	case "", constant.PriceChangePending, constant.PriceChangeApproved, constant.PriceChangeRejected:
+	default:
+		return utils.ErrorBadRequest(c, fmt.Errorf("status must be pending, approved or rejected"), map[string]interface{}{})
+	}
+
+	list, err := h.menuUsecase.MenuPriceChangeList(ctx, filter)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, list)
+}
+
+// MenuPriceChangeApprove godoc
+// @Summary Approve Menu Price Change
+// @Description Approve a pending price change and set it as menu price, the reviewer is the actor of the request and must be another actor than the one who requested the change
+// @Tags Menu Price
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param change_id path string true "Change Id"
+// @Param X-Actor-Id header string true "Actor Id"
+// @Param request body request.MenuPriceChangeReview false "Request Body"
+// @Success 200 {object} response.SwaggerMenuPriceChange
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 409 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/price-changes/{change_id}/approve [post]
+// MenuPriceChangeApprove handles HTTP request for approving menu price change
+func (h *MenuHandler) MenuPriceChangeApprove(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	changeId := c.Param("change_id")
+	req := request.MenuPriceChangeReview{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	pc, err := h.menuUsecase.MenuPriceChangeApprove(ctx, menuId, changeId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success approve menu price change", pc)
+}
+
+// MenuPriceChangeReject godoc
+// @Summary Reject Menu Price Change
+// @Description Reject a pending price change keeping the current menu price, the reviewer is the actor of the request and must be another actor than the one who requested the change
+// @Tags Menu Price
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param change_id path string true "Change Id"
+// @Param X-Actor-Id header string true "Actor Id"
+// @Param request body request.MenuPriceChangeReview false "Request Body"
+// @Success 200 {object} response.SwaggerMenuPriceChange
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 409 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/price-changes/{change_id}/reject [post]
+// MenuPriceChangeReject handles HTTP request for rejecting menu price change
+func (h *MenuHandler) MenuPriceChangeReject(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	changeId := c.Param("change_id")
+	req := request.MenuPriceChangeReview{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	pc, err := h.menuUsecase.MenuPriceChangeReject(ctx, menuId, changeId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success reject menu price change", pc)
+}
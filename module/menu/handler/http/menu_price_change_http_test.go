@@ -0,0 +1,405 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuPriceChangeList(t *testing.T) {
+	type input struct {
+		query string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get pending price changes",
+			expectedInput:  input{},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := []response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeList", mock.Anything, mock.Anything).
+					Return(pcResponse, nil)
+			},
+		},
+		{
+			name: "#2 success get rejected price changes of warteg",
+			expectedInput: input{
+				query: "status=rejected&warteg_id=abc",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := []response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeList", mock.Anything, mock.Anything).
+					Return(pcResponse, nil)
+			},
+		},
+		{
+			name: "#3 bad request unknown status",
+			expectedInput: input{
+				query: "status=applied",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 internal server error price changes",
+			expectedInput: input{
+				query: "menu_id=abc",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := []response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeList", mock.Anything, mock.Anything).
+					Return(pcResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/price-changes?"+testCase.expectedInput.query, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/price-changes")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuPriceChangeList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuPriceChangeApprove(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success approve menu price change",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"note": "sesuai harga bahan baku",
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeApprove", mock.Anything, mock.Anything).
+					Return(pcResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request note too long",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"note": strings.Repeat("a", 256),
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable approve menu price change",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"note": 10,
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 conflict reviewed by its requester",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusConflict},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeApprove", mock.Anything, mock.Anything).
+					Return(pcResponse, constant.ErrPriceChangeReviewer)
+			},
+		},
+		{
+			name: "#5 price change not found",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeApprove", mock.Anything, mock.Anything).
+					Return(pcResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/menu/:menu_id/price-changes/:change_id/approve",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/price-changes/:change_id/approve")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuPriceChangeApprove(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuPriceChangeReject(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success reject menu price change",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"note": "sesuai harga bahan baku",
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeReject", mock.Anything, mock.Anything).
+					Return(pcResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request note too long",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"note": strings.Repeat("a", 256),
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable reject menu price change",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"note": 10,
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 conflict reviewed by its requester",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusConflict},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeReject", mock.Anything, mock.Anything).
+					Return(pcResponse, constant.ErrPriceChangeReviewer)
+			},
+		},
+		{
+			name: "#5 price change not found",
+			expectedInput: input{
+				req: map[string]interface{}{},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pcResponse := response.MenuPriceChange{}
+
+				mockMenu.
+					On("MenuPriceChangeReject", mock.Anything, mock.Anything).
+					Return(pcResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/menu/:menu_id/price-changes/:change_id/reject",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/price-changes/:change_id/reject")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuPriceChangeReject(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuPrices godoc
+// @Summary Menu Prices
+// @Description Current price of menu, pending scheduled prices, due ones waiting for approval of their price change and price history newest first
+// @Tags Menu Price
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerMenuPrices
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/prices [get]
+// MenuPrices handles HTTP request for menu prices
+func (h *MenuHandler) MenuPrices(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	mp, err := h.menuUsecase.MenuPrices(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, mp)
+}
+
+// MenuPriceScheduleAdd godoc
+// @Summary Schedule Menu Price
+// @Description Schedule a new menu price effective at a future time, it is applied by the price scheduler and recorded in the price history as changed by the actor who scheduled it. A price beyond the approval threshold of the price at that time becomes a price change waiting for approval instead, the schedule stays requested until the change is approved or rejected
+// @Tags Menu Price
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Param request body request.MenuPriceSchedule true "Request Body"
+// @Success 201 {object} response.SwaggerMenuPriceSchedule
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/prices [post]
+// MenuPriceScheduleAdd handles HTTP request for scheduling menu price
+func (h *MenuHandler) MenuPriceScheduleAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuPriceSchedule{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	ps, err := h.menuUsecase.MenuPriceScheduleAdd(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success schedule menu price", ps)
+}
+
+// MenuPriceScheduleCancel godoc
+// @Summary Cancel Scheduled Menu Price
+// @Description Cancel a scheduled menu price that is not applied yet
+// @Tags Menu Price
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param schedule_id path string true "Schedule Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/prices/{schedule_id} [delete]
+// MenuPriceScheduleCancel handles HTTP request for cancelling scheduled menu price
+func (h *MenuHandler) MenuPriceScheduleCancel(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	scheduleId := c.Param("schedule_id")
+
+	err := h.menuUsecase.MenuPriceScheduleCancel(ctx, menuId, scheduleId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success cancel scheduled menu price", map[string]interface{}{})
+}
@@ -0,0 +1,300 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuPrices(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get menu prices",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mpResponse := response.MenuPrices{}
+
+				mockMenu.
+					On("MenuPrices", mock.Anything, mock.Anything).
+					Return(mpResponse, nil)
+			},
+		},
+		{
+			name:           "#2 menu not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mpResponse := response.MenuPrices{}
+
+				mockMenu.
+					On("MenuPrices", mock.Anything, mock.Anything).
+					Return(mpResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id/prices", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/prices")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuPrices(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuPriceScheduleAdd(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success schedule menu price",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_price":     15000,
+					"effective_date": "2030-01-01T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				psResponse := response.MenuPriceSchedule{}
+
+				mockMenu.
+					On("MenuPriceScheduleAdd", mock.Anything, mock.Anything).
+					Return(psResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without effective date",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_price": 15000,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable schedule menu price",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_price":     "mahal",
+					"effective_date": "2030-01-01T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request effective date in the past",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_price":     15000,
+					"effective_date": "2020-01-01T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				psResponse := response.MenuPriceSchedule{}
+
+				mockMenu.
+					On("MenuPriceScheduleAdd", mock.Anything, mock.Anything).
+					Return(psResponse, constant.ErrInvalidPriceSchedule)
+			},
+		},
+		{
+			name: "#5 menu not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"menu_price":     15000,
+					"effective_date": "2030-01-01T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				psResponse := response.MenuPriceSchedule{}
+
+				mockMenu.
+					On("MenuPriceScheduleAdd", mock.Anything, mock.Anything).
+					Return(psResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/menu/:menu_id/prices",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/prices")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuPriceScheduleAdd(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuPriceScheduleCancel(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success cancel scheduled menu price",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuPriceScheduleCancel", mock.Anything, mock.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:           "#2 scheduled menu price not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("MenuPriceScheduleCancel", mock.Anything, mock.Anything).
+					Return(constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.DELETE, "/v1/menu/:menu_id/prices/:schedule_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/prices/:schedule_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuPriceScheduleCancel(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
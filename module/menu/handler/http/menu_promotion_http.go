@@ -0,0 +1,156 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// PromotionAdd godoc
+// @Summary Add Promotion
+// @Description Add percentage, fixed or buy_x_get_y promotion for a menu, menu type or warteg, daily_start and daily_end in HH:MM limit it to part of the day and days_of_week (0 is sunday) to some weekdays, both in the timezone of the warteg opening hours of each menu
+// @Tags Menu Promotion
+// @Accept  json
+// @Produce  json
+// @Param request body request.Promotion true "Request Body"
+// @Success 201 {object} response.SwaggerPromotion
+// @Failure 400 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/promotions [post]
+// PromotionAdd handles HTTP request for adding promotion
+func (h *MenuHandler) PromotionAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := request.Promotion{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	promo, err := h.menuUsecase.PromotionAdd(ctx, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success add promotion", promo)
+}
+
+// PromotionList godoc
+// @Summary Promotions
+// @Description Promotions including scheduled and ended ones
+// @Tags Menu Promotion
+// @Accept  json
+// @Produce  json
+// @Param warteg_id query string false "Warteg Id"
+// @Success 200 {object} response.SwaggerPromotions
+// @Failure 500 {object} response.Base
+// @Router /v1/promotions [get]
+// PromotionList handles HTTP request for promotions
+func (h *MenuHandler) PromotionList(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.QueryParam("warteg_id")
+
+	promos, err := h.menuUsecase.PromotionList(ctx, wartegId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, promos)
+}
+
+// PromotionDetail godoc
+// @Summary Promotion Detail
+// @Description Promotion with its scope and schedule
+// @Tags Menu Promotion
+// @Accept  json
+// @Produce  json
+// @Param promotion_id path string true "Promotion Id"
+// @Success 200 {object} response.SwaggerPromotion
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/promotions/{promotion_id} [get]
+// PromotionDetail handles HTTP request for promotion detail
+func (h *MenuHandler) PromotionDetail(c echo.Context) error {
+	ctx := c.Request().Context()
+	promotionId := c.Param("promotion_id")
+
+	promo, err := h.menuUsecase.PromotionDetail(ctx, promotionId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, promo)
+}
+
+// PromotionUpdate godoc
+// @Summary Update Promotion
+// @Description Update promotion value, scope or schedule
+// @Tags Menu Promotion
+// @Accept  json
+// @Produce  json
+// @Param promotion_id path string true "Promotion Id"
+// @Param request body request.Promotion true "Request Body"
+// @Success 200 {object} response.SwaggerPromotion
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/promotions/{promotion_id} [put]
+// PromotionUpdate handles HTTP request for updating promotion
+func (h *MenuHandler) PromotionUpdate(c echo.Context) error {
+	ctx := c.Request().Context()
+	promotionId := c.Param("promotion_id")
+	req := request.Promotion{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	promo, err := h.menuUsecase.PromotionUpdate(ctx, promotionId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success update promotion", promo)
+}
+
+// PromotionDelete godoc
+// @Summary Delete Promotion
+// @Description Delete promotion, menus it covered go back to their own price
+// @Tags Menu Promotion
+// @Accept  json
+// @Produce  json
+// @Param promotion_id path string true "Promotion Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/promotions/{promotion_id} [delete]
+// PromotionDelete handles HTTP request for deleting promotion
+func (h *MenuHandler) PromotionDelete(c echo.Context) error {
+	ctx := c.Request().Context()
+	promotionId := c.Param("promotion_id")
+
+	err := h.menuUsecase.PromotionDelete(ctx, promotionId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success delete promotion", map[string]interface{}{})
+}
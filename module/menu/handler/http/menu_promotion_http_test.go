@@ -0,0 +1,491 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPromotionAdd(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success add promotion",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"promotion_name": "Promo Makan Siang",
+					"promotion_type": "percentage",
+					"value":          10,
+					"scope_type":     "warteg",
+					"scope_id":       "w1",
+					"start_date":     "2026-10-01T00:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := response.Promotion{}
+
+				mockMenu.
+					On("PromotionAdd", mock.Anything, mock.Anything).
+					Return(pResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request without scope",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"promotion_name": "Promo Makan Siang",
+					"promotion_type": "percentage",
+					"value":          10,
+					"scope_type":     "warteg",
+					"start_date":     "2026-10-01T00:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 unprocessable add promotion",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"promotion_name": "Promo Makan Siang",
+					"promotion_type": "percentage",
+					"value":          10,
+					"scope_type":     "warteg",
+					"scope_id":       "w1",
+					"start_date":     "yesterday",
+				},
+			},
+			expectedOutput: output{nil, http.StatusUnprocessableEntity},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request invalid promotion value",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"promotion_name": "Promo Makan Siang",
+					"promotion_type": "percentage",
+					"value":          150,
+					"scope_type":     "warteg",
+					"scope_id":       "w1",
+					"start_date":     "2026-10-01T00:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := response.Promotion{}
+
+				mockMenu.
+					On("PromotionAdd", mock.Anything, mock.Anything).
+					Return(pResponse, constant.ErrInvalidPromotion)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/promotions",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/promotions")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.PromotionAdd(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestPromotionList(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get promotions",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := []response.Promotion{}
+
+				mockMenu.
+					On("PromotionList", mock.Anything, mock.Anything).
+					Return(pResponse, nil)
+			},
+		},
+		{
+			name:           "#2 internal server error promotions",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := []response.Promotion{}
+
+				mockMenu.
+					On("PromotionList", mock.Anything, mock.Anything).
+					Return(pResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/promotions", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/promotions")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.PromotionList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestPromotionDetail(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get promotion detail",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := response.Promotion{}
+
+				mockMenu.
+					On("PromotionDetail", mock.Anything, mock.Anything).
+					Return(pResponse, nil)
+			},
+		},
+		{
+			name:           "#2 promotion not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := response.Promotion{}
+
+				mockMenu.
+					On("PromotionDetail", mock.Anything, mock.Anything).
+					Return(pResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/promotions/:promotion_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/promotions/:promotion_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.PromotionDetail(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestPromotionUpdate(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success update promotion",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"promotion_name": "Promo Makan Siang",
+					"promotion_type": "percentage",
+					"value":          10,
+					"scope_type":     "warteg",
+					"scope_id":       "w1",
+					"start_date":     "2026-10-01T00:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := response.Promotion{}
+
+				mockMenu.
+					On("PromotionUpdate", mock.Anything, mock.Anything).
+					Return(pResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request invalid promotion type",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"promotion_name": "Promo Makan Siang",
+					"promotion_type": "free",
+					"value":          10,
+					"scope_type":     "warteg",
+					"scope_id":       "w1",
+					"start_date":     "2026-10-01T00:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 promotion not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"promotion_name": "Promo Makan Siang",
+					"promotion_type": "percentage",
+					"value":          10,
+					"scope_type":     "warteg",
+					"scope_id":       "w1",
+					"start_date":     "2026-10-01T00:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				pResponse := response.Promotion{}
+
+				mockMenu.
+					On("PromotionUpdate", mock.Anything, mock.Anything).
+					Return(pResponse, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/promotions/:promotion_id",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/promotions/:promotion_id")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.PromotionUpdate(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestPromotionDelete(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success delete promotion",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("PromotionDelete", mock.Anything, mock.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:           "#2 promotion not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("PromotionDelete", mock.Anything, mock.Anything).
+					Return(constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.DELETE, "/v1/promotions/:promotion_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/promotions/:promotion_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.PromotionDelete(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
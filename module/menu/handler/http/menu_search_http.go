@@ -0,0 +1,123 @@
+package http
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuSearch godoc
+// @Summary  Menu Search
+// @Description Full text menu search tolerant to typos, abbreviations such as grg for goreng, word order and configured synonyms, ranked by relevance. Highlights hold html escaped menu name, menu type name and menu detail with matched words wrapped in em tags
+// @Tags Menu
+// @Accept  json
+// @Produce  json
+// @Param q query string true "search text"
+// @Param warteg_id query string false "warteg id"
+// @Param menu_type_id query string false "menu type id"
+// @Param available_only query boolean false "hide sold out menus"
+// @Param limit query int false "Max results, default 20, at most 50"
+// @Success 200 {object} response.SwaggerMenuSearch
+// @Failure 400 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menus/search [get]
+// MenuSearch handles HTTP request for menu search
+func (h *MenuHandler) MenuSearch(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req, err := menuSearchRequest(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	list, err := h.menuUsecase.MenuSearch(ctx, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, list)
+}
+
+func menuSearchRequest(c echo.Context) (req request.MenuSearch, err error) {
+	queryValues := c.Request().URL.Query()
+	req = request.MenuSearch{
+		Query:      queryValues.Get("q"),
+		WartegId:   queryValues.Get("warteg_id"),
+		MenuTypeId: queryValues.Get("menu_type_id"),
+	}
+
+	if req.Query == "" {
+		return req, fmt.Errorf("q is mandatory")
+	}
+
+	if v := queryValues.Get("available_only"); v != "" {
+		req.AvailableOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			return req, fmt.Errorf("available_only must be true or false")
+		}
+	}
+
+	if v := queryValues.Get("limit"); v != "" {
+		req.Limit, err = strconv.Atoi(v)
+		if err != nil || req.Limit < 0 {
+			return req, fmt.Errorf("limit must be a positive number")
+		}
+	}
+
+	return req, nil
+}
+
+// MenuSuggest godoc
+// @Summary  Menu Suggest
+// @Description Search as you type completions of menu names and menu type names starting with the typed text, having a word starting with it or containing it, in that order and then by number of menus. Suggestions are given from two characters on, highlight holds html escaped text with the typed part wrapped in em tags
+// @Tags Menu
+// @Accept  json
+// @Produce  json
+// @Param q query string true "typed text"
+// @Param warteg_id query string false "warteg id"
+// @Param limit query int false "Max suggestions, default 10, at most 20"
+// @Success 200 {object} response.SwaggerMenuSuggest
+// @Failure 400 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menus/suggest [get]
+// MenuSuggest handles HTTP request for menu suggestions
+func (h *MenuHandler) MenuSuggest(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	req, err := menuSuggestRequest(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	list, err := h.menuUsecase.MenuSuggest(ctx, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, list)
+}
+
+func menuSuggestRequest(c echo.Context) (req request.MenuSuggest, err error) {
+	queryValues := c.Request().URL.Query()
+	req = request.MenuSuggest{
+		Query:    queryValues.Get("q"),
+		WartegId: queryValues.Get("warteg_id"),
+	}
+
+	if _, ok := queryValues["q"]; !ok {
+		return req, fmt.Errorf("q is mandatory")
+	}
+
+	if v := queryValues.Get("limit"); v != "" {
+		req.Limit, err = strconv.Atoi(v)
+		if err != nil || req.Limit < 0 {
+			return req, fmt.Errorf("limit must be a positive number")
+		}
+	}
+
+	return req, nil
+}
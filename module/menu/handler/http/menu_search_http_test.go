@@ -0,0 +1,280 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuSearch(t *testing.T) {
+	type input struct {
+		query string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success search menu",
+			expectedInput: input{
+				query: "q=ayam+grg&warteg_id=abc&available_only=true",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := []response.MenuSearch{}
+
+				mockMenu.
+					On("MenuSearch", mock.Anything, mock.Anything).
+					Return(msResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request missing query",
+			expectedInput: input{
+				query: "warteg_id=abc",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request invalid available only",
+			expectedInput: input{
+				query: "q=teh+es&available_only=maybe",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request invalid limit",
+			expectedInput: input{
+				query: "q=teh+es&limit=-1",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 bad request query without words",
+			expectedInput: input{
+				query: "q=%21%21",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := []response.MenuSearch{}
+
+				mockMenu.
+					On("MenuSearch", mock.Anything, mock.Anything).
+					Return(msResponse, constant.ErrInvalidSearchQuery)
+			},
+		},
+		{
+			name: "#6 internal server error search menu",
+			expectedInput: input{
+				query: "q=nasgor",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := []response.MenuSearch{}
+
+				mockMenu.
+					On("MenuSearch", mock.Anything, mock.Anything).
+					Return(msResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menus/search?"+testCase.expectedInput.query, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menus/search")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuSearch(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuSuggest(t *testing.T) {
+	type input struct {
+		query string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success suggest menu",
+			expectedInput: input{
+				query: "q=ay&warteg_id=abc",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.MenuSuggest{}
+
+				mockMenu.
+					On("MenuSuggest", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name: "#2 success suggest empty text",
+			expectedInput: input{
+				query: "q=",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.MenuSuggest{}
+
+				mockMenu.
+					On("MenuSuggest", mock.Anything, mock.Anything).
+					Return(mgResponse, nil)
+			},
+		},
+		{
+			name: "#3 bad request missing text",
+			expectedInput: input{
+				query: "warteg_id=abc",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request invalid limit",
+			expectedInput: input{
+				query: "q=nasi&limit=ten",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 internal server error suggest menu",
+			expectedInput: input{
+				query: "q=teh",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mgResponse := []response.MenuSuggest{}
+
+				mockMenu.
+					On("MenuSuggest", mock.Anything, mock.Anything).
+					Return(mgResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menus/suggest?"+testCase.expectedInput.query, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menus/suggest")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuSuggest(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuStatusSet godoc
+// @Summary Set Menu Status
+// @Description Move menu between draft, published and archived, customers only see published menus. A draft with publish_at in the future is published by the publish scheduler at that time, as made by the actor who set it
+// @Tags Menu
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param X-Actor-Id header string false "Actor Id"
+// @Param request body request.MenuStatus true "Request Body"
+// @Success 200 {object} response.SwaggerMenuStatus
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/status [put]
+// MenuStatusSet handles HTTP request for setting menu status
+func (h *MenuHandler) MenuStatusSet(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuStatus{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	status, err := h.menuUsecase.MenuStatusSet(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success set menu status", status)
+}
+
+// MenuStaffList godoc
+// @Summary  Staff Menu List
+// @Description Menu list for staff with drafts and archived menus, menus outside their serving windows are included
+// @Tags Staff
+// @Accept  json
+// @Produce  json
+// @Param X-Actor-Id header string true "Actor Id, staff routes refuse anonymous requests"
+// @Param status query string false "comma separated statuses among draft, published and archived, every status when empty"
+// @Param warteg_id query string false  "warteg id"
+// @Param menu_type_id query string false "menu type id"
+// @Param menu_name query string false "menu name"
+// @Param available_only query boolean false "hide sold out menus"
+// @Param lat query number false "latitude, lists menus of wartegs near lat and lng, nearest first with distance in meters"
+// @Param lng query number false "longitude"
+// @Param radius query number false "radius in meters around lat and lng, default 1000, at most 50000"
+// @Param open_only query boolean false "hide menus of wartegs closed now, wartegs without opening hours are taken as open"
+// @Param at query string false "RFC3339 time to preview the list at instead of now, applies to open_only and promotions"
+// @Success 200 {object} response.SwaggerMenuList
+// @Failure 400 {object} response.Base
+// @Failure 401 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/staff/menus [get]
+// MenuStaffList handles HTTP request for staff menu list
+func (h *MenuHandler) MenuStaffList(c echo.Context) error {
+	ctx := c.Request().Context()
+
+	filter, err := menuListFilter(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	filter.Statuses, err = menuStatuses(c.QueryParam("status"))
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	menu, err := h.menuUsecase.MenuStaffList(ctx, filter)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, menu)
+}
+
+// MenuPreview godoc
+// @Summary  Staff Menu Preview
+// @Description Menu detail of any status as customers would see it once published
+// @Tags Staff
+// @Accept  json
+// @Produce  json
+// @Param X-Actor-Id header string true "Actor Id, staff routes refuse anonymous requests"
+// @Param menu_id path string true "Menu Id"
+// @Param at query string false "RFC3339 time to preview the menu at instead of now, applies to serving windows and promotions"
+// @Success 200 {object} response.SwaggerMenuDetail
+// @Failure 400 {object} response.Base
+// @Failure 401 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/staff/menu/{menu_id} [get]
+// MenuPreview handles HTTP request for staff menu preview
+func (h *MenuHandler) MenuPreview(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	at, err := previewTime(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+	if at == nil {
+		now := time.Now()
+		at = &now
+	}
+
+	md, err := h.menuUsecase.MenuPreview(ctx, menuId, *at)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, md)
+}
+
+// menuStatuses reads comma separated statuses, nil when empty
+func menuStatuses(v string) ([]string, error) {
+	if v == "" {
+		return nil, nil
+	}
+
+	statuses := []string{}
+	for _, s := range strings.Split(v, ",") {
+		s = strings.TrimSpace(s)
+		switch s {
+		case constant.MenuStatusDraft, constant.MenuStatusPublished, constant.MenuStatusArchived:
+			statuses = append(statuses, s)
+		default:
+			return nil, fmt.Errorf("status must be draft, published or archived")
+		}
+	}
+	return statuses, nil
+}
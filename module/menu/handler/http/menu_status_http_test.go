@@ -0,0 +1,465 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuStatusSet(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success schedule draft publishing",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"status":     "draft",
+					"publish_at": "2027-03-15T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStatus{}
+
+				mockMenu.
+					On("MenuStatusSet", mock.Anything, mock.Anything).
+					Return(msResponse, nil)
+			},
+		},
+		{
+			name: "#2 success archive menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"status": "archived",
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStatus{}
+
+				mockMenu.
+					On("MenuStatusSet", mock.Anything, mock.Anything).
+					Return(msResponse, nil)
+			},
+		},
+		{
+			name: "#3 bad request unknown status",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"status": "hidden",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request publish time of archived menu",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"status":     "archived",
+					"publish_at": "2027-03-15T06:00:00+07:00",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStatus{}
+
+				mockMenu.
+					On("MenuStatusSet", mock.Anything, mock.Anything).
+					Return(msResponse, constant.ErrInvalidMenuStatus)
+			},
+		},
+		{
+			name: "#5 menu not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"status": "published",
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStatus{}
+
+				mockMenu.
+					On("MenuStatusSet", mock.Anything, mock.Anything).
+					Return(msResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name: "#6 internal server error set menu status",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"status": "draft",
+				},
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStatus{}
+
+				mockMenu.
+					On("MenuStatusSet", mock.Anything, mock.Anything).
+					Return(msResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/menu/:menu_id/status",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/status")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuStatusSet(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuStaffList(t *testing.T) {
+	type input struct {
+		query string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get staff menu list",
+			expectedInput:  input{},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{}
+
+				mockMenu.
+					On("MenuStaffList", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#2 success get drafts of warteg",
+			expectedInput: input{
+				query: "status=draft,archived&warteg_id=abc",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{}
+
+				mockMenu.
+					On("MenuStaffList", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#3 bad request unknown status",
+			expectedInput: input{
+				query: "status=draft,hidden",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request invalid preview time",
+			expectedInput: input{
+				query: "status=draft&at=2027-03-15",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 internal server error staff menu list",
+			expectedInput: input{
+				query: "status=published",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{}
+
+				mockMenu.
+					On("MenuStaffList", mock.Anything, mock.Anything).
+					Return(mnResponse, errorMenu)
+			},
+		},
+		{
+			name:           "#6 unauthorized anonymous actor",
+			expectedInput:  input{},
+			expectedOutput: output{nil, http.StatusUnauthorized},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := []response.MenuList{}
+
+				mockMenu.
+					On("MenuStaffList", mock.Anything, mock.Anything).
+					Return(mnResponse, constant.ErrActorRequired)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/staff/menus?"+testCase.expectedInput.query, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/staff/menus")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuStaffList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuPreview(t *testing.T) {
+	type input struct {
+		query string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success preview draft",
+			expectedInput:  input{},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{Status: "draft"}
+
+				mockMenu.
+					On("MenuPreview", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#2 success preview at publish time",
+			expectedInput: input{
+				query: "at=2027-03-15T06:00:00Z",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{Status: "draft"}
+
+				mockMenu.
+					On("MenuPreview", mock.Anything, mock.Anything).
+					Return(mnResponse, nil)
+			},
+		},
+		{
+			name: "#3 bad request invalid preview time",
+			expectedInput: input{
+				query: "at=1700000000",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name:           "#4 menu not found",
+			expectedInput:  input{},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+
+				mockMenu.
+					On("MenuPreview", mock.Anything, mock.Anything).
+					Return(mnResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#5 internal server error preview",
+			expectedInput:  input{},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+
+				mockMenu.
+					On("MenuPreview", mock.Anything, mock.Anything).
+					Return(mnResponse, errorMenu)
+			},
+		},
+		{
+			name:           "#6 unauthorized anonymous actor",
+			expectedInput:  input{},
+			expectedOutput: output{nil, http.StatusUnauthorized},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mnResponse := response.MenuDetail{}
+
+				mockMenu.
+					On("MenuPreview", mock.Anything, mock.Anything).
+					Return(mnResponse, constant.ErrActorRequired)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/staff/menu/:menu_id?"+testCase.expectedInput.query, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/staff/menu/:menu_id")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuPreview(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	log "go.uber.org/zap"
+	"golang.org/x/net/websocket"
+)
+
+// menuStreamWriter sends stream events over one transport
+type menuStreamWriter interface {
+	send(e response.MenuStreamEvent) error
+}
+
+// MenuStream godoc
+// @Summary Live Menu Changes
+// @Description Push menu changes of warteg as Server-Sent Events, or as WebSocket json messages when the request asks for a websocket upgrade. The first event is ready, then menu.created, menu.updated and menu.deleted events follow with the current menu, heartbeat is sent when idle. Send the last event id as Last-Event-ID header or last_event_id query to resume without missing changes
+// @Tags Menu
+// @Produce  text/event-stream
+// @Param id path string true "Warteg Id"
+// @Param Last-Event-ID header string false "Last Event Id"
+// @Param last_event_id query string false "Last Event Id, for clients that can not set headers"
+// @Success 200 {object} response.MenuStreamEvent
+// @Failure 400 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/wartegs/{id}/menus/stream [get]
+// MenuStream handles HTTP request for live menu changes
+func (h *MenuHandler) MenuStream(c echo.Context) error {
+	ctx, cancel := context.WithCancel(c.Request().Context())
+	defer cancel()
+
+	wartegId := c.Param("id")
+	lastEventId := c.Request().Header.Get("Last-Event-ID")
+	if lastEventId == "" {
+		lastEventId = c.QueryParam("last_event_id")
+	}
+
+	// subscribe before the first read so a change made in between is not missed
+	notify := h.menuUsecase.MenuStreamSubscribe(ctx, wartegId)
+
+	ms, err := h.menuUsecase.MenuStream(ctx, wartegId, lastEventId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	if strings.EqualFold(c.Request().Header.Get(echo.HeaderUpgrade), "websocket") {
+		websocket.Server{Handler: func(ws *websocket.Conn) {
+			// the connection is hijacked, a read error is the only sign the client left
+			go func() {
+				var discard string
+				for websocket.Message.Receive(ws, &discard) == nil {
+				}
+				cancel()
+			}()
+
+			h.streamMenus(ctx, &menuWebSocket{ws}, wartegId, notify, ms)
+		}}.ServeHTTP(c.Response(), c.Request())
+		return nil
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	h.streamMenus(ctx, &menuEventSource{res}, wartegId, notify, ms)
+	return nil
+}
+
+// streamMenus sends ready and every change until the client leaves, the change log is read again on every
+// notification and heartbeat, and once more shortly after a notification that found no change
+func (h *MenuHandler) streamMenus(ctx context.Context, w menuStreamWriter, wartegId string, notify <-chan struct{}, ms response.MenuStream) {
+	heartbeat := time.NewTicker(constant.MenuStreamHeartbeatSeconds * time.Second)
+	defer heartbeat.Stop()
+
+	recheck := time.NewTimer(0)
+	if !recheck.Stop() {
+		<-recheck.C
+	}
+	defer recheck.Stop()
+
+	rechecking := false
+	err := w.send(response.MenuStreamEvent{EventId: ms.LastEventId, EventType: constant.MenuStreamReady})
+
+	for err == nil {
+		for _, e := range ms.Events {
+			err = w.send(e)
+			if err != nil {
+				break
+			}
+		}
+
+		notified := false
+		if err == nil && !ms.HasMore {
+			select {
+			case <-ctx.Done():
+				return
+			case _, ok := <-notify:
+				if !ok {
+					return
+				}
+				notified = true
+			case <-recheck.C:
+				rechecking = false
+			case <-heartbeat.C:
+				err = w.send(response.MenuStreamEvent{EventType: constant.MenuStreamHeartbeat})
+			}
+		}
+
+		if err == nil {
+			ms, err = h.menuUsecase.MenuStream(ctx, wartegId, ms.LastEventId)
+		}
+
+		if err == nil && notified && len(ms.Events) == 0 && !rechecking {
+			recheck.Reset(constant.MenuStreamRecheckSeconds * time.Second)
+			rechecking = true
+		}
+	}
+
+	if ctx.Err() == nil {
+		log.S().Warn("menu stream of warteg ", wartegId, " closed : ", err)
+	}
+}
+
+// menuEventSource writes Server-Sent Events, heartbeat is a comment so EventSource clients ignore it
+type menuEventSource struct {
+	res *echo.Response
+}
+
+func (s *menuEventSource) send(e response.MenuStreamEvent) error {
+	var err error
+	if e.EventType == constant.MenuStreamHeartbeat {
+		_, err = fmt.Fprint(s.res, ": heartbeat\n\n")
+	} else {
+		var data []byte
+		data, err = json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(s.res, "id: %s\nevent: %s\ndata: %s\n\n", e.EventId, e.EventType, data)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.res.Flush()
+	return nil
+}
+
+// menuWebSocket writes every event as a json text message
+type menuWebSocket struct {
+	ws *websocket.Conn
+}
+
+func (s *menuWebSocket) send(e response.MenuStreamEvent) error {
+	s.ws.SetWriteDeadline(time.Now().Add(constant.MenuStreamHeartbeatSeconds * time.Second))
+	return websocket.JSON.Send(s.ws, e)
+}
@@ -0,0 +1,241 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/net/websocket"
+)
+
+// closedNotify ends the stream after the first batch of events
+func closedNotify() <-chan struct{} {
+	notify := make(chan struct{})
+	close(notify)
+	return notify
+}
+
+func TestMenuStream(t *testing.T) {
+	type input struct {
+		lastEventId string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+		body       string
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success stream menu changes",
+			expectedInput: input{
+				lastEventId: "41",
+			},
+			expectedOutput: output{nil, http.StatusOK, "id: 42\nevent: menu.updated\n"},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStream{
+					Events: []response.MenuStreamEvent{
+						{EventId: "42", EventType: "menu.updated", MenuId: "abc", Menu: &response.MenuDetail{MenuId: "abc"}},
+					},
+					LastEventId: "42",
+				}
+
+				mockMenu.
+					On("MenuStreamSubscribe", mock.Anything, mock.Anything).
+					Return(closedNotify())
+				mockMenu.
+					On("MenuStream", mock.Anything, mock.Anything).
+					Return(msResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request invalid last event id",
+			expectedInput: input{
+				lastEventId: "yesterday",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest, ""},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStream{}
+
+				mockMenu.
+					On("MenuStreamSubscribe", mock.Anything, mock.Anything).
+					Return(closedNotify())
+				mockMenu.
+					On("MenuStream", mock.Anything, mock.Anything).
+					Return(msResponse, constant.ErrInvalidSyncToken)
+			},
+		},
+		{
+			name: "#3 internal server error stream menu changes",
+			expectedInput: input{
+				lastEventId: "",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError, ""},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				msResponse := response.MenuStream{}
+
+				mockMenu.
+					On("MenuStreamSubscribe", mock.Anything, mock.Anything).
+					Return(closedNotify())
+				mockMenu.
+					On("MenuStream", mock.Anything, mock.Anything).
+					Return(msResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/wartegs/abc/menus/stream", nil)
+
+			assert.NoError(t, err)
+			req.Header.Set("Last-Event-ID", testCase.expectedInput.lastEventId)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/wartegs/:id/menus/stream")
+			c.SetParamNames("id")
+			c.SetParamValues("abc")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuStream(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+			assert.Contains(t, rec.Body.String(), testCase.expectedOutput.body)
+
+		})
+	}
+}
+
+func TestMenuStreamWebSocket(t *testing.T) {
+	mockMenu := new(mocks.Usecase)
+
+	msResponse := response.MenuStream{
+		Events: []response.MenuStreamEvent{
+			{EventId: "42", EventType: "menu.deleted", MenuId: "abc"},
+		},
+		LastEventId: "42",
+	}
+
+	mockMenu.
+		On("MenuStreamSubscribe", mock.Anything, mock.Anything).
+		Return(closedNotify())
+	mockMenu.
+		On("MenuStream", mock.Anything, mock.Anything).
+		Return(msResponse, nil)
+
+	handler := MenuHandler{
+		menuUsecase: mockMenu,
+	}
+
+	e := echo.New()
+	e.GET("/v1/wartegs/:id/menus/stream", handler.MenuStream)
+
+	server := httptest.NewServer(e)
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/v1/wartegs/abc/menus/stream?last_event_id=41"
+	ws, err := websocket.Dial(url, "", server.URL)
+	assert.NoError(t, err)
+	defer ws.Close()
+
+	events := []response.MenuStreamEvent{}
+	for {
+		var event response.MenuStreamEvent
+		if websocket.JSON.Receive(ws, &event) != nil {
+			break
+		}
+		events = append(events, event)
+	}
+
+	assert.Equal(t, 2, len(events))
+	assert.Equal(t, constant.MenuStreamReady, events[0].EventType)
+	assert.Equal(t, "42", events[1].EventId)
+}
+
+func TestMenuStreamRecheck(t *testing.T) {
+	mockMenu := new(mocks.Usecase)
+
+	// one notification is waiting, its read finds nothing and the change shows up on the recheck
+	notify := make(chan struct{}, 1)
+	notify <- struct{}{}
+
+	empty := response.MenuStream{LastEventId: "41"}
+	msResponse := response.MenuStream{
+		Events: []response.MenuStreamEvent{
+			{EventId: "42", EventType: "menu.updated", MenuId: "abc", Menu: &response.MenuDetail{MenuId: "abc"}},
+		},
+		LastEventId: "42",
+	}
+
+	mockMenu.
+		On("MenuStreamSubscribe", mock.Anything, mock.Anything).
+		Return((<-chan struct{})(notify))
+	mockMenu.
+		On("MenuStream", mock.Anything, mock.Anything).
+		Return(empty, nil).
+		Twice()
+	mockMenu.
+		On("MenuStream", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { close(notify) }).
+		Return(msResponse, nil).
+		Once()
+
+	handler := MenuHandler{
+		menuUsecase: mockMenu,
+	}
+
+	e := echo.New()
+	req, err := http.NewRequest(echo.GET, "/v1/wartegs/abc/menus/stream", nil)
+	assert.NoError(t, err)
+	req.Header.Set("Last-Event-ID", "41")
+
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/v1/wartegs/:id/menus/stream")
+	c.SetParamNames("id")
+	c.SetParamValues("abc")
+
+	err = handler.MenuStream(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "id: 42\nevent: menu.updated\n")
+	assert.NotContains(t, rec.Body.String(), ": heartbeat")
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuVariantAdd godoc
+// @Summary Add Menu Variant
+// @Description Add portion size or serving variant, price_type delta adds price to menu price and absolute replaces it
+// @Tags Menu Variant
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuVariant true "Request Body"
+// @Success 201 {object} response.SwaggerMenuVariant
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/variants [post]
+// MenuVariantAdd handles HTTP request for adding menu variant
+func (h *MenuHandler) MenuVariantAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuVariant{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	variant, err := h.menuUsecase.MenuVariantAdd(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success add menu variant", variant)
+}
+
+// MenuVariantList godoc
+// @Summary Menu Variants
+// @Description Variants of menu with their resolved price, default variant first
+// @Tags Menu Variant
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerMenuVariants
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/variants [get]
+// MenuVariantList handles HTTP request for menu variants
+func (h *MenuHandler) MenuVariantList(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	variants, err := h.menuUsecase.MenuVariantList(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, variants)
+}
+
+// MenuVariantUpdate godoc
+// @Summary Update Menu Variant
+// @Description Update menu variant
+// @Tags Menu Variant
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param variant_id path string true "Variant Id"
+// @Param request body request.MenuVariant true "Request Body"
+// @Success 200 {object} response.SwaggerMenuVariant
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/variants/{variant_id} [put]
+// MenuVariantUpdate handles HTTP request for updating menu variant
+func (h *MenuHandler) MenuVariantUpdate(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	variantId := c.Param("variant_id")
+	req := request.MenuVariant{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	variant, err := h.menuUsecase.MenuVariantUpdate(ctx, menuId, variantId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success update menu variant", variant)
+}
+
+// MenuVariantDelete godoc
+// @Summary Delete Menu Variant
+// @Description Delete menu variant
+// @Tags Menu Variant
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param variant_id path string true "Variant Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/variants/{variant_id} [delete]
+// MenuVariantDelete handles HTTP request for deleting menu variant
+func (h *MenuHandler) MenuVariantDelete(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	variantId := c.Param("variant_id")
+
+	err := h.menuUsecase.MenuVariantDelete(ctx, menuId, variantId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success delete menu variant", map[string]interface{}{})
+}
@@ -0,0 +1,134 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// WartegLocationSet godoc
+// @Summary Set Warteg Location
+// @Description Set coordinates of warteg, menus of wartegs with coordinates can be listed near a customer with lat, lng and radius
+// @Tags Warteg
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Warteg Id"
+// @Param request body request.WartegLocation true "Request Body"
+// @Success 200 {object} response.SwaggerWartegLocation
+// @Failure 400 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/wartegs/{id}/location [put]
+// WartegLocationSet handles HTTP request for setting warteg location
+func (h *MenuHandler) WartegLocationSet(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.Param("id")
+	req := request.WartegLocation{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	location, err := h.menuUsecase.WartegLocationSet(ctx, wartegId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success set warteg location", location)
+}
+
+// WartegLocation godoc
+// @Summary Warteg Location
+// @Description Coordinates of warteg
+// @Tags Warteg
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Warteg Id"
+// @Success 200 {object} response.SwaggerWartegLocation
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/wartegs/{id}/location [get]
+// WartegLocation handles HTTP request for warteg location
+func (h *MenuHandler) WartegLocation(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.Param("id")
+
+	location, err := h.menuUsecase.WartegLocation(ctx, wartegId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, location)
+}
+
+// WartegHoursSet godoc
+// @Summary Set Warteg Opening Hours
+// @Description Replace timezone, weekly opening hours and closure dates of warteg. Weekday 0 is Sunday, open and close are HH:MM local time, a close at or before open ends the next day and 24:00 ends the day. Nothing opens on a closure date
+// @Tags Warteg
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Warteg Id"
+// @Param request body request.WartegHours true "Request Body"
+// @Success 200 {object} response.SwaggerWartegHours
+// @Failure 400 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/wartegs/{id}/hours [put]
+// WartegHoursSet handles HTTP request for setting warteg opening hours
+func (h *MenuHandler) WartegHoursSet(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.Param("id")
+	req := request.WartegHours{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	hours, err := h.menuUsecase.WartegHoursSet(ctx, wartegId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success set warteg opening hours", hours)
+}
+
+// WartegHours godoc
+// @Summary Warteg Opening Hours
+// @Description Timezone, weekly opening hours and closure dates of warteg, whether it is open now and, while closed, its next opening time in its timezone
+// @Tags Warteg
+// @Accept  json
+// @Produce  json
+// @Param id path string true "Warteg Id"
+// @Success 200 {object} response.SwaggerWartegHours
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/wartegs/{id}/hours [get]
+// WartegHours handles HTTP request for warteg opening hours
+func (h *MenuHandler) WartegHours(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.Param("id")
+
+	hours, err := h.menuUsecase.WartegHours(ctx, wartegId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, hours)
+}
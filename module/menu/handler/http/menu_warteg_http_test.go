@@ -0,0 +1,477 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWartegLocationSet(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success set warteg location",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"latitude":  -6.2088,
+					"longitude": 106.8456,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wlResponse := response.WartegLocation{}
+
+				mockMenu.
+					On("WartegLocationSet", mock.Anything, mock.Anything).
+					Return(wlResponse, nil)
+			},
+		},
+		{
+			name: "#2 success set warteg location on equator",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"latitude":  0,
+					"longitude": 109.3425,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wlResponse := response.WartegLocation{}
+
+				mockMenu.
+					On("WartegLocationSet", mock.Anything, mock.Anything).
+					Return(wlResponse, nil)
+			},
+		},
+		{
+			name: "#3 bad request missing longitude",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"latitude": -6.2088,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request latitude out of range",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"latitude":  -96.2,
+					"longitude": 106.8456,
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#5 internal server error set warteg location",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"latitude":  -6.2088,
+					"longitude": 106.8456,
+				},
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wlResponse := response.WartegLocation{}
+
+				mockMenu.
+					On("WartegLocationSet", mock.Anything, mock.Anything).
+					Return(wlResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/wartegs/:id/location",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/wartegs/:id/location")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WartegLocationSet(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWartegLocation(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get warteg location",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				wlResponse := response.WartegLocation{}
+
+				mockMenu.
+					On("WartegLocation", mock.Anything, mock.Anything).
+					Return(wlResponse, nil)
+			},
+		},
+		{
+			name:           "#2 warteg location not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				wlResponse := response.WartegLocation{}
+
+				mockMenu.
+					On("WartegLocation", mock.Anything, mock.Anything).
+					Return(wlResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error warteg location",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				wlResponse := response.WartegLocation{}
+
+				mockMenu.
+					On("WartegLocation", mock.Anything, mock.Anything).
+					Return(wlResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/wartegs/:id/location", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/wartegs/:id/location")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WartegLocation(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWartegHoursSet(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success set warteg opening hours",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"timezone": "Asia/Makassar",
+					"hours":    []map[string]interface{}{{"weekday": 1, "open": "07:00", "close": "22:00"}, {"weekday": 5, "open": "22:00", "close": "02:00"}},
+					"closures": []map[string]interface{}{{"closure_date": "2027-03-10", "note": "Lebaran"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.WartegHours{}
+
+				mockMenu.
+					On("WartegHoursSet", mock.Anything, mock.Anything).
+					Return(whResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request missing timezone",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"hours": []map[string]interface{}{{"weekday": 1, "open": "07:00", "close": "22:00"}, {"weekday": 5, "open": "22:00", "close": "02:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request invalid weekday",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"timezone": "Asia/Jakarta",
+					"hours":    []map[string]interface{}{{"weekday": 7, "open": "07:00", "close": "22:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request invalid opening hours",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"timezone": "Asia/Jayapura",
+					"hours":    []map[string]interface{}{{"weekday": 1, "open": "7 am", "close": "22:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.WartegHours{}
+
+				mockMenu.
+					On("WartegHoursSet", mock.Anything, mock.Anything).
+					Return(whResponse, constant.ErrInvalidWartegHours)
+			},
+		},
+		{
+			name: "#5 internal server error set warteg opening hours",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"timezone": "Asia/Jakarta",
+					"hours":    []map[string]interface{}{{"weekday": 1, "open": "07:00", "close": "22:00"}, {"weekday": 5, "open": "22:00", "close": "02:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.WartegHours{}
+
+				mockMenu.
+					On("WartegHoursSet", mock.Anything, mock.Anything).
+					Return(whResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/wartegs/:id/hours",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/wartegs/:id/hours")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WartegHoursSet(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWartegHours(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get warteg opening hours",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.WartegHours{}
+
+				mockMenu.
+					On("WartegHours", mock.Anything, mock.Anything).
+					Return(whResponse, nil)
+			},
+		},
+		{
+			name:           "#2 warteg opening hours not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.WartegHours{}
+
+				mockMenu.
+					On("WartegHours", mock.Anything, mock.Anything).
+					Return(whResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error warteg opening hours",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.WartegHours{}
+
+				mockMenu.
+					On("WartegHours", mock.Anything, mock.Anything).
+					Return(whResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/wartegs/:id/hours", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/wartegs/:id/hours")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WartegHours(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,252 @@
+package http
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// WebhookAdd godoc
+// @Summary Add Webhook
+// @Description Subscribe an endpoint to menu events of a warteg, empty event_types means every event. Deliveries are signed with X-Webhook-Signature sha256=hex(hmac_sha256(secret, X-Webhook-Timestamp + "." + body)), the secret is generated when not given and shown only in this response
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param request body request.Webhook true "Request Body"
+// @Success 201 {object} response.SwaggerWebhook
+// @Failure 400 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/webhooks [post]
+// WebhookAdd handles HTTP request for adding webhook
+func (h *MenuHandler) WebhookAdd(c echo.Context) error {
+	ctx := c.Request().Context()
+	req := request.Webhook{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	webhook, err := h.menuUsecase.WebhookAdd(ctx, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.CreatedResponse(c, "Success add webhook", webhook)
+}
+
+// WebhookList godoc
+// @Summary Webhooks
+// @Description Webhook subscriptions, secrets are not shown
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param warteg_id query string false "Warteg Id"
+// @Success 200 {object} response.SwaggerWebhooks
+// @Failure 500 {object} response.Base
+// @Router /v1/webhooks [get]
+// WebhookList handles HTTP request for webhooks
+func (h *MenuHandler) WebhookList(c echo.Context) error {
+	ctx := c.Request().Context()
+	wartegId := c.QueryParam("warteg_id")
+
+	webhooks, err := h.menuUsecase.WebhookList(ctx, wartegId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, webhooks)
+}
+
+// WebhookDetail godoc
+// @Summary Webhook Detail
+// @Description Webhook subscription, the secret is not shown
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param webhook_id path string true "Webhook Id"
+// @Success 200 {object} response.SwaggerWebhook
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/webhooks/{webhook_id} [get]
+// WebhookDetail handles HTTP request for webhook detail
+func (h *MenuHandler) WebhookDetail(c echo.Context) error {
+	ctx := c.Request().Context()
+	webhookId := c.Param("webhook_id")
+
+	webhook, err := h.menuUsecase.WebhookDetail(ctx, webhookId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, webhook)
+}
+
+// WebhookUpdate godoc
+// @Summary Update Webhook
+// @Description Update endpoint, event types or state of webhook, empty secret keeps the current one. Deliveries of an inactive webhook wait until it is active again
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param webhook_id path string true "Webhook Id"
+// @Param request body request.Webhook true "Request Body"
+// @Success 200 {object} response.SwaggerWebhook
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/webhooks/{webhook_id} [put]
+// WebhookUpdate handles HTTP request for updating webhook
+func (h *MenuHandler) WebhookUpdate(c echo.Context) error {
+	ctx := c.Request().Context()
+	webhookId := c.Param("webhook_id")
+	req := request.Webhook{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	webhook, err := h.menuUsecase.WebhookUpdate(ctx, webhookId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success update webhook", webhook)
+}
+
+// WebhookDelete godoc
+// @Summary Delete Webhook
+// @Description Delete webhook with its delivery logs
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param webhook_id path string true "Webhook Id"
+// @Success 200 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/webhooks/{webhook_id} [delete]
+// WebhookDelete handles HTTP request for deleting webhook
+func (h *MenuHandler) WebhookDelete(c echo.Context) error {
+	ctx := c.Request().Context()
+	webhookId := c.Param("webhook_id")
+
+	err := h.menuUsecase.WebhookDelete(ctx, webhookId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success delete webhook", map[string]interface{}{})
+}
+
+// WebhookDeliveryList godoc
+// @Summary Webhook Deliveries
+// @Description Delivery logs of webhook newest first with attempts, last response code and error. Pass delivery_id of the last entry as before_id to get older deliveries
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param webhook_id path string true "Webhook Id"
+// @Param status query string false "pending, success or failed"
+// @Param before_id query int false "Delivery Id"
+// @Param limit query int false "Max entries, default 50, at most 200"
+// @Success 200 {object} response.SwaggerWebhookDeliveries
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/webhooks/{webhook_id}/deliveries [get]
+// WebhookDeliveryList handles HTTP request for webhook delivery logs
+func (h *MenuHandler) WebhookDeliveryList(c echo.Context) error {
+	ctx := c.Request().Context()
+	webhookId := c.Param("webhook_id")
+
+	filter, err := webhookDeliveryFilter(c)
+	if err != nil {
+		return utils.ErrorBadRequest(c, err, map[string]interface{}{})
+	}
+
+	deliveries, err := h.menuUsecase.WebhookDeliveryList(ctx, webhookId, filter)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, deliveries)
+}
+
+// WebhookRedeliver godoc
+// @Summary Redeliver Webhook
+// @Description Send a delivery again right away whatever its status, the response shows the outcome of the attempt
+// @Tags Webhook
+// @Accept  json
+// @Produce  json
+// @Param webhook_id path string true "Webhook Id"
+// @Param delivery_id path int true "Delivery Id"
+// @Success 200 {object} response.SwaggerWebhookDelivery
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/webhooks/{webhook_id}/deliveries/{delivery_id}/redeliver [post]
+// WebhookRedeliver handles HTTP request for redelivering webhook
+func (h *MenuHandler) WebhookRedeliver(c echo.Context) error {
+	ctx := c.Request().Context()
+	webhookId := c.Param("webhook_id")
+
+	deliveryId, err := strconv.ParseInt(c.Param("delivery_id"), 10, 64)
+	if err != nil {
+		return utils.ErrorBadRequest(c, fmt.Errorf("delivery_id must be a number"), map[string]interface{}{})
+	}
+
+	delivery, err := h.menuUsecase.WebhookRedeliver(ctx, webhookId, deliveryId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Webhook redelivered", delivery)
+}
+
+func webhookDeliveryFilter(c echo.Context) (filter request.WebhookDeliveryFilter, err error) {
+	queryValues := c.Request().URL.Query()
+	filter = request.WebhookDeliveryFilter{
+		Status: queryValues.Get("status"),
+	}
+
+	switch filter.Status {
+	case "", constant.WebhookDeliveryPending, constant.WebhookDeliverySuccess, constant.WebhookDeliveryFailed:
+	default:
+		return filter, fmt.Errorf("status must be pending, success or failed")
+	}
+
+	if v := queryValues.Get("before_id"); v != "" {
+		filter.BeforeId, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || filter.BeforeId < 0 {
+			return filter, fmt.Errorf("before_id must be a positive number")
+		}
+	}
+
+	if v := queryValues.Get("limit"); v != "" {
+		filter.Limit, err = strconv.Atoi(v)
+		if err != nil || filter.Limit < 0 {
+			return filter, fmt.Errorf("limit must be a positive number")
+		}
+	}
+
+	return filter, nil
+}
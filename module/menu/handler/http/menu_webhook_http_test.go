@@ -0,0 +1,765 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWebhookAdd(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success add webhook",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":   "abc",
+					"url":         "https://partner.example.com/hooks/menu",
+					"event_types": []string{"menu.updated"},
+				},
+			},
+			expectedOutput: output{nil, http.StatusCreated},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookAdd", mock.Anything, mock.Anything).
+					Return(whResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request missing url",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id": "abc",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request unknown event type",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id":   "abc",
+					"url":         "https://partner.example.com/hooks/menu",
+					"event_types": []string{"menu.renamed"},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request short secret",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id": "abc",
+					"url":       "https://partner.example.com/hooks/menu",
+					"secret":    "short",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookAdd", mock.Anything, mock.Anything).
+					Return(whResponse, constant.ErrInvalidWebhook)
+			},
+		},
+		{
+			name: "#5 internal server error add webhook",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id": "abc",
+					"url":       "https://partner.example.com/hooks/menu",
+				},
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookAdd", mock.Anything, mock.Anything).
+					Return(whResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/webhooks",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/webhooks")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WebhookAdd(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWebhookList(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get webhooks",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := []response.Webhook{}
+
+				mockMenu.
+					On("WebhookList", mock.Anything, mock.Anything).
+					Return(whResponse, nil)
+			},
+		},
+		{
+			name:           "#2 internal server error webhooks",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := []response.Webhook{}
+
+				mockMenu.
+					On("WebhookList", mock.Anything, mock.Anything).
+					Return(whResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/webhooks?warteg_id=abc", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/webhooks?warteg_id=abc")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WebhookList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWebhookDetail(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get webhook",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookDetail", mock.Anything, mock.Anything).
+					Return(whResponse, nil)
+			},
+		},
+		{
+			name:           "#2 webhook not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookDetail", mock.Anything, mock.Anything).
+					Return(whResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error webhook",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookDetail", mock.Anything, mock.Anything).
+					Return(whResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/webhooks/:webhook_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/webhooks/:webhook_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WebhookDetail(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWebhookUpdate(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success update webhook",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id": "abc",
+					"url":       "https://partner.example.com/hooks/menu",
+					"is_active": false,
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookUpdate", mock.Anything, mock.Anything).
+					Return(whResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request invalid url",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id": "abc",
+					"url":       "not a url",
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 webhook not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id": "abc",
+					"url":       "https://partner.example.com/hooks/menu",
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookUpdate", mock.Anything, mock.Anything).
+					Return(whResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name: "#4 internal server error update webhook",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"warteg_id": "abc",
+					"url":       "https://partner.example.com/hooks/menu",
+				},
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				whResponse := response.Webhook{}
+
+				mockMenu.
+					On("WebhookUpdate", mock.Anything, mock.Anything).
+					Return(whResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/webhooks/:webhook_id",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/webhooks/:webhook_id")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WebhookUpdate(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWebhookDelete(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success delete webhook",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("WebhookDelete", mock.Anything, mock.Anything).
+					Return(nil)
+			},
+		},
+		{
+			name:           "#2 webhook not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("WebhookDelete", mock.Anything, mock.Anything).
+					Return(constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error delete webhook",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mockMenu.
+					On("WebhookDelete", mock.Anything, mock.Anything).
+					Return(errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.DELETE, "/v1/webhooks/:webhook_id", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/webhooks/:webhook_id")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WebhookDelete(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWebhookDeliveryList(t *testing.T) {
+	type input struct {
+		query string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success get webhook deliveries",
+			expectedInput: input{
+				query: "status=failed&limit=20",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wdResponse := []response.WebhookDelivery{}
+
+				mockMenu.
+					On("WebhookDeliveryList", mock.Anything, mock.Anything).
+					Return(wdResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request unknown status",
+			expectedInput: input{
+				query: "status=lost",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 webhook not found",
+			expectedInput: input{
+				query: "",
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wdResponse := []response.WebhookDelivery{}
+
+				mockMenu.
+					On("WebhookDeliveryList", mock.Anything, mock.Anything).
+					Return(wdResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name: "#4 internal server error webhook deliveries",
+			expectedInput: input{
+				query: "before_id=10",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wdResponse := []response.WebhookDelivery{}
+
+				mockMenu.
+					On("WebhookDeliveryList", mock.Anything, mock.Anything).
+					Return(wdResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/webhooks/abc/deliveries?"+testCase.expectedInput.query, nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/webhooks/:webhook_id/deliveries")
+			c.SetParamNames("webhook_id")
+			c.SetParamValues("abc")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WebhookDeliveryList(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestWebhookRedeliver(t *testing.T) {
+	type input struct {
+		deliveryId string
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success redeliver webhook",
+			expectedInput: input{
+				deliveryId: "12",
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wdResponse := response.WebhookDelivery{}
+
+				mockMenu.
+					On("WebhookRedeliver", mock.Anything, mock.Anything).
+					Return(wdResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request invalid delivery id",
+			expectedInput: input{
+				deliveryId: "latest",
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 delivery not found",
+			expectedInput: input{
+				deliveryId: "13",
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wdResponse := response.WebhookDelivery{}
+
+				mockMenu.
+					On("WebhookRedeliver", mock.Anything, mock.Anything).
+					Return(wdResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name: "#4 internal server error redeliver webhook",
+			expectedInput: input{
+				deliveryId: "12",
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				wdResponse := response.WebhookDelivery{}
+
+				mockMenu.
+					On("WebhookRedeliver", mock.Anything, mock.Anything).
+					Return(wdResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.POST, "/v1/webhooks/abc/deliveries/"+testCase.expectedInput.deliveryId+"/redeliver", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/webhooks/:webhook_id/deliveries/:delivery_id/redeliver")
+			c.SetParamNames("webhook_id", "delivery_id")
+			c.SetParamValues("abc", testCase.expectedInput.deliveryId)
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.WebhookRedeliver(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package http
+
+import (
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/labstack/echo/v4"
+)
+
+// MenuWindowSet godoc
+// @Summary Set Menu Serving Windows
+// @Description Replace serving windows of menu, a menu with windows is left out of menu list and marked off schedule in menu detail outside all of them. Weekday 0 is Sunday, start and end are HH:MM in the timezone of the warteg opening hours, an end at or before start ends the next day. An empty list serves the menu whenever the warteg is
+// @Tags Menu
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Param request body request.MenuWindows true "Request Body"
+// @Success 200 {object} response.SwaggerMenuWindows
+// @Failure 400 {object} response.Base
+// @Failure 404 {object} response.Base
+// @Failure 422 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/windows [put]
+// MenuWindowSet handles HTTP request for setting menu serving windows
+func (h *MenuHandler) MenuWindowSet(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+	req := request.MenuWindows{}
+
+	//parsing
+	err := utils.ParsingParameter(c, &req)
+	if err != nil {
+		return utils.ErrorParsing(c, err, map[string]interface{}{})
+	}
+
+	//validate
+	err = utils.ValidateParameter(c, &req)
+	if err != nil {
+		return utils.ErrorValidate(c, err, map[string]interface{}{})
+	}
+
+	windows, err := h.menuUsecase.MenuWindowSet(ctx, menuId, req)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, "Success set menu serving windows", windows)
+}
+
+// MenuWindows godoc
+// @Summary Menu Serving Windows
+// @Description Serving windows of menu with the timezone they are evaluated in
+// @Tags Menu
+// @Accept  json
+// @Produce  json
+// @Param menu_id path string true "Menu Id"
+// @Success 200 {object} response.SwaggerMenuWindows
+// @Failure 404 {object} response.Base
+// @Failure 500 {object} response.Base
+// @Router /v1/menu/{menu_id}/windows [get]
+// MenuWindows handles HTTP request for menu serving windows
+func (h *MenuHandler) MenuWindows(c echo.Context) error {
+	ctx := c.Request().Context()
+	menuId := c.Param("menu_id")
+
+	windows, err := h.menuUsecase.MenuWindows(ctx, menuId)
+	if err != nil {
+		return utils.ErrorResponse(c, err, map[string]interface{}{})
+	}
+
+	return utils.SuccessResponse(c, constant.SuccessGetData, windows)
+}
@@ -0,0 +1,261 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMenuWindowSet(t *testing.T) {
+	type input struct {
+		req map[string]interface{}
+	}
+
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name: "#1 success set menu serving windows",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"windows": []map[string]interface{}{{"weekdays": []int{1, 2, 3, 4, 5}, "start": "06:00", "end": "10:00"}, {"weekdays": []int{6}, "start": "22:00", "end": "02:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mwResponse := response.MenuWindows{}
+
+				mockMenu.
+					On("MenuWindowSet", mock.Anything, mock.Anything).
+					Return(mwResponse, nil)
+			},
+		},
+		{
+			name: "#2 bad request missing weekdays",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"windows": []map[string]interface{}{{"start": "06:00", "end": "10:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#3 bad request invalid weekday",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"windows": []map[string]interface{}{{"weekdays": []int{7}, "start": "06:00", "end": "10:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+			},
+		},
+		{
+			name: "#4 bad request invalid serving window",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"windows": []map[string]interface{}{{"weekdays": []int{1}, "start": "6 am", "end": "10:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusBadRequest},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mwResponse := response.MenuWindows{}
+
+				mockMenu.
+					On("MenuWindowSet", mock.Anything, mock.Anything).
+					Return(mwResponse, constant.ErrInvalidMenuWindow)
+			},
+		},
+		{
+			name: "#5 menu not found",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"windows": []map[string]interface{}{},
+				},
+			},
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mwResponse := response.MenuWindows{}
+
+				mockMenu.
+					On("MenuWindowSet", mock.Anything, mock.Anything).
+					Return(mwResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name: "#6 internal server error set menu serving windows",
+			expectedInput: input{
+				req: map[string]interface{}{
+					"windows": []map[string]interface{}{{"weekdays": []int{0, 6}, "start": "11:00", "end": "14:00"}},
+				},
+			},
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				payload input,
+				mockMenu *mocks.Usecase,
+			) {
+				mwResponse := response.MenuWindows{}
+
+				mockMenu.
+					On("MenuWindowSet", mock.Anything, mock.Anything).
+					Return(mwResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			j, err := json.Marshal(testCase.expectedInput.req)
+			assert.NoError(t, err)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.PUT, "/v1/menu/:menu_id/windows",
+				strings.NewReader(string(j)))
+
+			assert.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/windows")
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuWindowSet(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
+
+func TestMenuWindows(t *testing.T) {
+	type output struct {
+		err        error
+		statusCode int
+	}
+
+	cases := []struct {
+		name           string
+		expectedOutput output
+		configureMock  func(
+			mockMenu *mocks.Usecase,
+		)
+	}{
+		{
+			name:           "#1 success get menu serving windows",
+			expectedOutput: output{nil, http.StatusOK},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mwResponse := response.MenuWindows{}
+
+				mockMenu.
+					On("MenuWindows", mock.Anything, mock.Anything).
+					Return(mwResponse, nil)
+			},
+		},
+		{
+			name:           "#2 menu not found",
+			expectedOutput: output{nil, http.StatusNotFound},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mwResponse := response.MenuWindows{}
+
+				mockMenu.
+					On("MenuWindows", mock.Anything, mock.Anything).
+					Return(mwResponse, constant.ErrNotFound)
+			},
+		},
+		{
+			name:           "#3 internal server error menu serving windows",
+			expectedOutput: output{nil, http.StatusInternalServerError},
+			configureMock: func(
+				mockMenu *mocks.Usecase,
+			) {
+				mwResponse := response.MenuWindows{}
+
+				mockMenu.
+					On("MenuWindows", mock.Anything, mock.Anything).
+					Return(mwResponse, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockMenu := new(mocks.Usecase)
+
+			e := echo.New()
+
+			req, err := http.NewRequest(echo.GET, "/v1/menu/:menu_id/windows", nil)
+
+			assert.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/v1/menu/:menu_id/windows")
+
+			testCase.configureMock(
+				mockMenu,
+			)
+
+			handler := MenuHandler{
+				menuUsecase: mockMenu,
+			}
+
+			err = handler.MenuWindows(c)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			assert.Equal(t, testCase.expectedOutput.statusCode, rec.Code)
+
+		})
+	}
+}
@@ -2,6 +2,7 @@ package menu
 
 import (
 	"context"
+	"time"
 
 	"github.com/cpartogi/foodmenu/schema/request"
 	"github.com/cpartogi/foodmenu/schema/response"
@@ -13,6 +14,112 @@ type Repository interface {
 	MenuAdd(ctx context.Context, addm request.Menu) (mn response.MenuAdd, err error)
 	MenuDelete(ctx context.Context, menu_id string) (md response.MenuDelete, err error)
 	MenuUpdate(ctx context.Context, menu_id string, upm request.MenuUpdate) (mu response.MenuUpdate, err error)
-	MenuList(ctx context.Context, warteg_id, menu_type_id, menu_name string) (list []response.MenuList, err error)
+	MenuList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error)
 	MenuDetail(ctx context.Context, menu_id string) (mnd response.MenuDetail, err error)
+	MenuDetails(ctx context.Context, menu_ids []string) (list []response.MenuDetail, err error)
+	MenuScan(ctx context.Context, fn func(response.MenuDetail) error) (err error)
+	MenuChangeList(ctx context.Context, since int64, warteg_id string, limit int) (list []response.MenuChange, err error)
+	MenuChangeLatest(ctx context.Context) (change_id int64, err error)
+	MenuChangeModified(ctx context.Context, warteg_id string) (modified time.Time, err error)
+	MenuImport(ctx context.Context, menus []request.Menu) (added []response.MenuAdd, err error)
+	MenuExport(ctx context.Context, filter request.MenuList, fn func(response.MenuExport) error) (err error)
+	MenuImageAdd(ctx context.Context, img request.MenuImage) (err error)
+	MenuImageList(ctx context.Context, menu_id string) (list []response.MenuImage, err error)
+	MenuImageDetail(ctx context.Context, menu_id, image_id string) (img response.MenuImage, err error)
+	MenuImageDelete(ctx context.Context, menu_id, image_id string) (err error)
+	MenuImageOrder(ctx context.Context, menu_id string, image_ids []string) (err error)
+	MenuAvailability(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error)
+	MenuSoldOut(ctx context.Context, menu_id, business_date string) (err error)
+	MenuRestock(ctx context.Context, menu_id, business_date string, rs request.MenuRestock) (err error)
+	MenuConsume(ctx context.Context, menu_id, business_date string, quantity int) (err error)
+	MenuAvailabilityReset(ctx context.Context, business_date, timezone string) (reset int64, err error)
+	MenuVariantAdd(ctx context.Context, mv request.MenuVariant) (err error)
+	MenuVariantUpdate(ctx context.Context, mv request.MenuVariant) (err error)
+	MenuVariantList(ctx context.Context, menu_id string) (list []response.MenuVariant, err error)
+	MenuVariantsByMenu(ctx context.Context, menu_ids []string) (list []response.MenuVariant, err error)
+	MenuVariantDetail(ctx context.Context, menu_id, variant_id string) (mv response.MenuVariant, err error)
+	MenuVariantDelete(ctx context.Context, menu_id, variant_id string) (err error)
+	ModifierGroupAdd(ctx context.Context, mg request.ModifierGroup) (err error)
+	ModifierGroupUpdate(ctx context.Context, mg request.ModifierGroup) (err error)
+	ModifierGroupList(ctx context.Context, warteg_id string) (list []response.ModifierGroup, err error)
+	ModifierGroupDetail(ctx context.Context, group_id string) (mg response.ModifierGroup, err error)
+	ModifierGroupDelete(ctx context.Context, group_id string) (err error)
+	MenuModifierSet(ctx context.Context, menu_id string, group_ids []string) (err error)
+	MenuModifierList(ctx context.Context, menu_id string) (list []response.ModifierGroup, err error)
+	MenuBundleItemList(ctx context.Context, menu_id string) (list []response.MenuBundleItem, err error)
+	MenuBundleUsage(ctx context.Context, menu_id string) (count int, err error)
+	MenuBundleSet(ctx context.Context, menu_id string, items []request.MenuBundleItem) (err error)
+	PromotionAdd(ctx context.Context, p request.Promotion) (err error)
+	PromotionUpdate(ctx context.Context, p request.Promotion) (err error)
+	PromotionDelete(ctx context.Context, promotion_id string) (err error)
+	PromotionDetail(ctx context.Context, promotion_id string) (p response.Promotion, err error)
+	PromotionList(ctx context.Context, warteg_id string) (list []response.Promotion, err error)
+	PromotionCurrent(ctx context.Context, since time.Time) (list []response.Promotion, err error)
+	PromotionBoundary(ctx context.Context, p response.Promotion, boundary time.Time) (touched bool, err error)
+	MenuPriceLock(ctx context.Context, menu_id string) (price int, err error)
+	MenuPriceHistoryList(ctx context.Context, menu_id string) (list []response.MenuPriceHistory, err error)
+	MenuPriceScheduleAdd(ctx context.Context, schedule_id, menu_id string, ps request.MenuPriceSchedule) (err error)
+	MenuPriceScheduleDetail(ctx context.Context, schedule_id string) (ps response.MenuPriceSchedule, err error)
+	MenuPriceScheduleList(ctx context.Context, menu_id string) (list []response.MenuPriceSchedule, err error)
+	MenuPriceScheduleStatus(ctx context.Context, menu_id, schedule_id, status string) (err error)
+	MenuPriceScheduleRequest(ctx context.Context, menu_id, schedule_id, change_id string) (err error)
+	MenuPriceScheduleByChange(ctx context.Context, change_id string) (ps response.MenuPriceSchedule, err error)
+	MenuPriceScheduleDue(ctx context.Context, now time.Time, limit int) (list []response.MenuPriceSchedule, err error)
+	MenuPriceScheduleApply(ctx context.Context, ps response.MenuPriceSchedule) (err error)
+	MenuPriceChangeRequest(ctx context.Context, change_id, menu_id string, upm request.MenuUpdate) (mu response.MenuUpdate, err error)
+	MenuPriceChangePending(ctx context.Context, menu_id string) (count int, err error)
+	MenuPriceChangeDetail(ctx context.Context, change_id string) (pc response.MenuPriceChange, err error)
+	MenuPriceChangeList(ctx context.Context, filter request.MenuPriceChangeFilter) (list []response.MenuPriceChange, err error)
+	MenuPriceChangeApprove(ctx context.Context, pc response.MenuPriceChange, note string) (err error)
+	MenuPriceChangeReject(ctx context.Context, change_id, note string) (err error)
+	BrandAdd(ctx context.Context, req request.Brand) (err error)
+	BrandList(ctx context.Context) (list []response.Brand, err error)
+	BrandDetail(ctx context.Context, brand_id string) (b response.Brand, err error)
+	BrandLock(ctx context.Context, brand_id string) (err error)
+	BrandMenuAdd(ctx context.Context, req request.BrandMenu) (err error)
+	BrandMenuUpdate(ctx context.Context, req request.BrandMenu) (err error)
+	BrandMenuDelete(ctx context.Context, brand_id, template_id string) (archived []string, err error)
+	BrandMenuDetail(ctx context.Context, brand_id, template_id string) (bm response.BrandMenu, err error)
+	BrandMenuList(ctx context.Context, brand_id string) (list []response.BrandMenu, err error)
+	BrandWartegSet(ctx context.Context, req request.BrandWarteg) (err error)
+	BrandWartegDelete(ctx context.Context, warteg_id string) (err error)
+	BrandWarteg(ctx context.Context, warteg_id string) (bw response.BrandWarteg, err error)
+	BrandWartegList(ctx context.Context, brand_id string) (list []response.BrandWarteg, err error)
+	BrandBranchMenus(ctx context.Context, brand_id, template_id, warteg_id string) (list []response.BrandBranchMenu, err error)
+	MenuTemplateSet(ctx context.Context, template_id, warteg_id, menu_id string, synced_price int) (err error)
+	MenuTemplateRequest(ctx context.Context, menu_id, change_id string) (err error)
+	MenuTemplateReview(ctx context.Context, change_id, status string, price int) (err error)
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) (err error)
+	Lock(ctx context.Context, entity, id string) (err error)
+	AuditAdd(ctx context.Context, a request.AuditLog) (err error)
+	AuditList(ctx context.Context, filter request.AuditFilter) (list []response.AuditLog, err error)
+	OutboxClaim(ctx context.Context, lease_until time.Time, limit int) (list []response.OutboxEvent, err error)
+	OutboxPublished(ctx context.Context, outbox_id int64) (err error)
+	OutboxFailed(ctx context.Context, outbox_id int64, next_attempt time.Time, last_error string) (err error)
+	OutboxDeadLetter(ctx context.Context, outbox_id int64, last_error string) (err error)
+	OutboxPurge(ctx context.Context, before time.Time) (count int64, err error)
+	WebhookAdd(ctx context.Context, w request.Webhook) (err error)
+	WebhookUpdate(ctx context.Context, w request.Webhook) (err error)
+	WebhookDelete(ctx context.Context, webhook_id string) (err error)
+	WebhookDetail(ctx context.Context, webhook_id string) (w response.Webhook, err error)
+	WebhookList(ctx context.Context, warteg_id string) (list []response.Webhook, err error)
+	WebhookDeliveryEnqueue(ctx context.Context, o response.OutboxEvent) (err error)
+	WebhookDeliveryClaim(ctx context.Context, now, lease_until time.Time, limit int) (list []response.WebhookDelivery, err error)
+	WebhookDeliveryList(ctx context.Context, webhook_id string, filter request.WebhookDeliveryFilter) (list []response.WebhookDelivery, err error)
+	WebhookDeliveryDetail(ctx context.Context, webhook_id string, delivery_id int64) (d response.WebhookDelivery, err error)
+	WebhookDeliveryResult(ctx context.Context, delivery_id int64, status string, next_attempt time.Time, response_code int, last_error string) (err error)
+	WartegLocationSet(ctx context.Context, req request.WartegLocation) (err error)
+	WartegLocation(ctx context.Context, warteg_id string) (wl response.WartegLocation, err error)
+	WartegHoursSet(ctx context.Context, req request.WartegHours) (err error)
+	WartegHours(ctx context.Context, warteg_id string) (wh response.WartegHours, err error)
+	WartegHoursList(ctx context.Context, filter request.MenuList) (list []response.WartegHours, err error)
+	WartegTimezones(ctx context.Context) (zones map[string]string, err error)
+	MenuTimezone(ctx context.Context, menu_id string) (timezone string, err error)
+	MenuWindowSet(ctx context.Context, menu_id string, windows []request.MenuWindow) (err error)
+	MenuWindows(ctx context.Context, menu_id string) (mw response.MenuWindows, err error)
+	MenuWindowsList(ctx context.Context, filter request.MenuList) (list []response.MenuWindows, err error)
+	MenuStatusSet(ctx context.Context, ms request.MenuStatus) (err error)
+	MenuStatus(ctx context.Context, menu_id string) (ms response.MenuStatus, err error)
+	MenuPublishDue(ctx context.Context, now time.Time, limit int) (list []response.MenuStatus, err error)
+	MenuPublish(ctx context.Context, menu_id string, now time.Time) (err error)
 }
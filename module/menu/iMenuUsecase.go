@@ -2,6 +2,7 @@ package menu
 
 import (
 	"context"
+	"time"
 
 	"github.com/cpartogi/foodmenu/schema/request"
 	"github.com/cpartogi/foodmenu/schema/response"
@@ -13,6 +14,83 @@ type Usecase interface {
 	MenuAdd(ctx context.Context, addm request.Menu) (mn response.MenuAdd, err error)
 	MenuDelete(ctx context.Context, menu_id string) (md response.MenuDelete, err error)
 	MenuUpdate(ctx context.Context, menu_id string, upm request.MenuUpdate) (mu response.MenuUpdate, err error)
-	MenuList(ctx context.Context, warteg_id, menu_type_id, menu_name string) (list []response.MenuList, err error)
+	MenuList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error)
+	MenuListModified(ctx context.Context, filter request.MenuList) (modified time.Time, err error)
 	MenuDetail(ctx context.Context, menu_id string) (mnd response.MenuDetail, err error)
+	MenuDetailAt(ctx context.Context, menu_id string, at time.Time) (mnd response.MenuDetail, err error)
+	MenuDetails(ctx context.Context, menu_ids []string) (list []response.MenuDetail, err error)
+	MenuSearch(ctx context.Context, req request.MenuSearch) (list []response.MenuSearch, err error)
+	MenuSearchSync(ctx context.Context) (err error)
+	MenuSuggest(ctx context.Context, req request.MenuSuggest) (list []response.MenuSuggest, err error)
+	MenuChanges(ctx context.Context, since, warteg_id string) (mc response.MenuChanges, err error)
+	MenuStream(ctx context.Context, warteg_id, last_event_id string) (ms response.MenuStream, err error)
+	MenuStreamSubscribe(ctx context.Context, warteg_id string) (notify <-chan struct{})
+	MenuImport(ctx context.Context, imp request.MenuImport) (mi response.MenuImport, err error)
+	MenuExport(ctx context.Context, filter request.MenuList, fn func(response.MenuExport) error) (err error)
+	MenuImageAdd(ctx context.Context, menu_id string, files []request.MenuImageFile) (list []response.MenuImage, err error)
+	MenuImageList(ctx context.Context, menu_id string) (list []response.MenuImage, err error)
+	MenuImageDelete(ctx context.Context, menu_id, image_id string) (err error)
+	MenuImageOrder(ctx context.Context, menu_id string, order request.MenuImageOrder) (list []response.MenuImage, err error)
+	MenuAvailability(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error)
+	MenuSoldOut(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error)
+	MenuRestock(ctx context.Context, menu_id string, rs request.MenuRestock) (ma response.MenuAvailability, err error)
+	MenuConsume(ctx context.Context, menu_id string, mc request.MenuConsume) (ma response.MenuAvailability, err error)
+	MenuAvailabilityReset(ctx context.Context) (err error)
+	MenuVariantAdd(ctx context.Context, menu_id string, mv request.MenuVariant) (variant response.MenuVariant, err error)
+	MenuVariantUpdate(ctx context.Context, menu_id, variant_id string, mv request.MenuVariant) (variant response.MenuVariant, err error)
+	MenuVariantList(ctx context.Context, menu_id string) (list []response.MenuVariant, err error)
+	MenuVariantDelete(ctx context.Context, menu_id, variant_id string) (err error)
+	ModifierGroupAdd(ctx context.Context, mg request.ModifierGroup) (group response.ModifierGroup, err error)
+	ModifierGroupUpdate(ctx context.Context, group_id string, mg request.ModifierGroup) (group response.ModifierGroup, err error)
+	ModifierGroupList(ctx context.Context, warteg_id string) (list []response.ModifierGroup, err error)
+	ModifierGroupDetail(ctx context.Context, group_id string) (group response.ModifierGroup, err error)
+	ModifierGroupDelete(ctx context.Context, group_id string) (err error)
+	MenuModifierSet(ctx context.Context, menu_id string, mm request.MenuModifier) (list []response.ModifierGroup, err error)
+	MenuModifierList(ctx context.Context, menu_id string) (list []response.ModifierGroup, err error)
+	MenuModifierValidate(ctx context.Context, menu_id string, sel request.MenuModifierSelection) (ms response.MenuModifierSelection, err error)
+	MenuBundle(ctx context.Context, menu_id string) (mb response.MenuBundle, err error)
+	MenuBundleSet(ctx context.Context, menu_id string, req request.MenuBundle) (mb response.MenuBundle, err error)
+	PromotionAdd(ctx context.Context, p request.Promotion) (promo response.Promotion, err error)
+	PromotionUpdate(ctx context.Context, promotion_id string, p request.Promotion) (promo response.Promotion, err error)
+	PromotionDelete(ctx context.Context, promotion_id string) (err error)
+	PromotionDetail(ctx context.Context, promotion_id string) (promo response.Promotion, err error)
+	PromotionList(ctx context.Context, warteg_id string) (list []response.Promotion, err error)
+	PromotionBoundaryRun(ctx context.Context) (err error)
+	MenuPrices(ctx context.Context, menu_id string) (mp response.MenuPrices, err error)
+	MenuPriceScheduleAdd(ctx context.Context, menu_id string, req request.MenuPriceSchedule) (ps response.MenuPriceSchedule, err error)
+	MenuPriceScheduleCancel(ctx context.Context, menu_id, schedule_id string) (err error)
+	MenuPriceScheduleRun(ctx context.Context) (err error)
+	MenuPriceChangeList(ctx context.Context, filter request.MenuPriceChangeFilter) (list []response.MenuPriceChange, err error)
+	MenuPriceChangeApprove(ctx context.Context, menu_id, change_id string, req request.MenuPriceChangeReview) (pc response.MenuPriceChange, err error)
+	MenuPriceChangeReject(ctx context.Context, menu_id, change_id string, req request.MenuPriceChangeReview) (pc response.MenuPriceChange, err error)
+	BrandAdd(ctx context.Context, req request.Brand) (b response.Brand, err error)
+	BrandList(ctx context.Context) (list []response.Brand, err error)
+	BrandMenuAdd(ctx context.Context, brand_id string, req request.BrandMenu) (bm response.BrandMenu, err error)
+	BrandMenuList(ctx context.Context, brand_id string) (list []response.BrandMenu, err error)
+	BrandMenuUpdate(ctx context.Context, brand_id, template_id string, req request.BrandMenu) (bm response.BrandMenu, err error)
+	BrandMenuDelete(ctx context.Context, brand_id, template_id string) (err error)
+	BrandWartegSet(ctx context.Context, warteg_id string, req request.BrandWarteg) (bw response.BrandWarteg, err error)
+	BrandWartegDelete(ctx context.Context, warteg_id string) (err error)
+	BrandPropagate(ctx context.Context, brand_id string) (bp response.BrandPropagation, err error)
+	BrandDivergence(ctx context.Context, brand_id string) (bd response.BrandDivergence, err error)
+	AuditList(ctx context.Context, filter request.AuditFilter) (list []response.AuditLog, err error)
+	MenuEventRelay(ctx context.Context) (err error)
+	WebhookAdd(ctx context.Context, req request.Webhook) (w response.Webhook, err error)
+	WebhookUpdate(ctx context.Context, webhook_id string, req request.Webhook) (w response.Webhook, err error)
+	WebhookDelete(ctx context.Context, webhook_id string) (err error)
+	WebhookDetail(ctx context.Context, webhook_id string) (w response.Webhook, err error)
+	WebhookList(ctx context.Context, warteg_id string) (list []response.Webhook, err error)
+	WebhookDeliveryList(ctx context.Context, webhook_id string, filter request.WebhookDeliveryFilter) (list []response.WebhookDelivery, err error)
+	WebhookRedeliver(ctx context.Context, webhook_id string, delivery_id int64) (d response.WebhookDelivery, err error)
+	WebhookDeliveryRun(ctx context.Context) (err error)
+	WartegLocationSet(ctx context.Context, warteg_id string, req request.WartegLocation) (wl response.WartegLocation, err error)
+	WartegLocation(ctx context.Context, warteg_id string) (wl response.WartegLocation, err error)
+	WartegHoursSet(ctx context.Context, warteg_id string, req request.WartegHours) (wh response.WartegHours, err error)
+	WartegHours(ctx context.Context, warteg_id string) (wh response.WartegHours, err error)
+	MenuWindowSet(ctx context.Context, menu_id string, req request.MenuWindows) (mw response.MenuWindows, err error)
+	MenuWindows(ctx context.Context, menu_id string) (mw response.MenuWindows, err error)
+	MenuStatusSet(ctx context.Context, menu_id string, req request.MenuStatus) (ms response.MenuStatus, err error)
+	MenuStaffList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error)
+	MenuPreview(ctx context.Context, menu_id string, at time.Time) (mnd response.MenuDetail, err error)
+	MenuPublishRun(ctx context.Context) (err error)
 }
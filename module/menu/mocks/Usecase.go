@@ -9,6 +9,8 @@ import (
 
 	request "github.com/cpartogi/foodmenu/schema/request"
 	response "github.com/cpartogi/foodmenu/schema/response"
+
+	time "time"
 )
 
 // Usecase is an autogenerated mock type for the Usecase type
@@ -96,12 +98,12 @@ func (_m *Usecase) MenuUpdate(ctx context.Context, menu_id string, upm request.M
 	return r0, r1
 }
 
-func (_m *Usecase) MenuList(ctx context.Context, warteg_id, menu_type_id, menu_name string) (list []response.MenuList, err error) {
+func (_m *Usecase) MenuList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error) {
 	ret := _m.Called(ctx)
 
 	var r0 []response.MenuList
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []response.MenuList); ok {
-		r0 = rf(ctx, warteg_id, menu_type_id, menu_name)
+	if rf, ok := ret.Get(0).(func(context.Context, request.MenuList) []response.MenuList); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		r0 = ret.Get(0).([]response.MenuList)
 	}
@@ -116,6 +118,26 @@ func (_m *Usecase) MenuList(ctx context.Context, warteg_id, menu_type_id, menu_n
 	return r0, r1
 }
 
+func (_m *Usecase) MenuListModified(ctx context.Context, filter request.MenuList) (modified time.Time, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(context.Context, request.MenuList) time.Time); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 func (_m *Usecase) MenuDetail(ctx context.Context, menu_id string) (mnd response.MenuDetail, err error) {
 	ret := _m.Called(ctx)
 
@@ -135,3 +157,1412 @@ func (_m *Usecase) MenuDetail(ctx context.Context, menu_id string) (mnd response
 
 	return r0, r1
 }
+
+func (_m *Usecase) MenuDetails(ctx context.Context, menu_ids []string) (list []response.MenuDetail, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuDetail
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []response.MenuDetail); ok {
+		r0 = rf(ctx, menu_ids)
+	} else {
+		r0 = ret.Get(0).([]response.MenuDetail)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuSearch(ctx context.Context, req request.MenuSearch) (list []response.MenuSearch, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuSearch
+	if rf, ok := ret.Get(0).(func(context.Context, request.MenuSearch) []response.MenuSearch); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).([]response.MenuSearch)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuSearchSync(ctx context.Context) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuSuggest(ctx context.Context, req request.MenuSuggest) ([]response.MenuSuggest, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuSuggest
+	if rf, ok := ret.Get(0).(func(context.Context, request.MenuSuggest) []response.MenuSuggest); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).([]response.MenuSuggest)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuChanges(ctx context.Context, since, warteg_id string) (mc response.MenuChanges, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuChanges
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) response.MenuChanges); ok {
+		r0 = rf(ctx, since, warteg_id)
+	} else {
+		r0 = ret.Get(0).(response.MenuChanges)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuImport(ctx context.Context, imp request.MenuImport) (mi response.MenuImport, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuImport
+	if rf, ok := ret.Get(0).(func(context.Context, request.MenuImport) response.MenuImport); ok {
+		r0 = rf(ctx, imp)
+	} else {
+		r0 = ret.Get(0).(response.MenuImport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuExport(ctx context.Context, filter request.MenuList, fn func(response.MenuExport) error) (err error) {
+	ret := _m.Called(ctx)
+
+	if rows, ok := ret.Get(0).([]response.MenuExport); ok {
+		for _, row := range rows {
+			if err = fn(row); err != nil {
+				return
+			}
+		}
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(1)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuImageAdd(ctx context.Context, menu_id string, files []request.MenuImageFile) (list []response.MenuImage, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuImage
+	if rf, ok := ret.Get(0).(func(context.Context, string, []request.MenuImageFile) []response.MenuImage); ok {
+		r0 = rf(ctx, menu_id, files)
+	} else {
+		r0 = ret.Get(0).([]response.MenuImage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuImageList(ctx context.Context, menu_id string) (list []response.MenuImage, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuImage
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.MenuImage); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).([]response.MenuImage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuImageDelete(ctx context.Context, menu_id, image_id string) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuImageOrder(ctx context.Context, menu_id string, order request.MenuImageOrder) (list []response.MenuImage, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuImage
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuImageOrder) []response.MenuImage); ok {
+		r0 = rf(ctx, menu_id, order)
+	} else {
+		r0 = ret.Get(0).([]response.MenuImage)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuAvailability(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuAvailability
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.MenuAvailability); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).(response.MenuAvailability)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuSoldOut(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuAvailability
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.MenuAvailability); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).(response.MenuAvailability)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuRestock(ctx context.Context, menu_id string, rs request.MenuRestock) (ma response.MenuAvailability, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuAvailability
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuRestock) response.MenuAvailability); ok {
+		r0 = rf(ctx, menu_id, rs)
+	} else {
+		r0 = ret.Get(0).(response.MenuAvailability)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuConsume(ctx context.Context, menu_id string, mc request.MenuConsume) (ma response.MenuAvailability, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuAvailability
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuConsume) response.MenuAvailability); ok {
+		r0 = rf(ctx, menu_id, mc)
+	} else {
+		r0 = ret.Get(0).(response.MenuAvailability)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuAvailabilityReset(ctx context.Context) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuVariantAdd(ctx context.Context, menu_id string, mv request.MenuVariant) (variant response.MenuVariant, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuVariant
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuVariant) response.MenuVariant); ok {
+		r0 = rf(ctx, menu_id, mv)
+	} else {
+		r0 = ret.Get(0).(response.MenuVariant)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuVariantUpdate(ctx context.Context, menu_id, variant_id string, mv request.MenuVariant) (variant response.MenuVariant, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuVariant
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, request.MenuVariant) response.MenuVariant); ok {
+		r0 = rf(ctx, menu_id, variant_id, mv)
+	} else {
+		r0 = ret.Get(0).(response.MenuVariant)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuVariantList(ctx context.Context, menu_id string) (list []response.MenuVariant, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuVariant
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.MenuVariant); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).([]response.MenuVariant)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuVariantDelete(ctx context.Context, menu_id, variant_id string) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) ModifierGroupAdd(ctx context.Context, mg request.ModifierGroup) (group response.ModifierGroup, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.ModifierGroup
+	if rf, ok := ret.Get(0).(func(context.Context, request.ModifierGroup) response.ModifierGroup); ok {
+		r0 = rf(ctx, mg)
+	} else {
+		r0 = ret.Get(0).(response.ModifierGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) ModifierGroupUpdate(ctx context.Context, group_id string, mg request.ModifierGroup) (group response.ModifierGroup, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.ModifierGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.ModifierGroup) response.ModifierGroup); ok {
+		r0 = rf(ctx, group_id, mg)
+	} else {
+		r0 = ret.Get(0).(response.ModifierGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) ModifierGroupList(ctx context.Context, warteg_id string) (list []response.ModifierGroup, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.ModifierGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.ModifierGroup); ok {
+		r0 = rf(ctx, warteg_id)
+	} else {
+		r0 = ret.Get(0).([]response.ModifierGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) ModifierGroupDetail(ctx context.Context, group_id string) (group response.ModifierGroup, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.ModifierGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.ModifierGroup); ok {
+		r0 = rf(ctx, group_id)
+	} else {
+		r0 = ret.Get(0).(response.ModifierGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) ModifierGroupDelete(ctx context.Context, group_id string) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuModifierSet(ctx context.Context, menu_id string, mm request.MenuModifier) (list []response.ModifierGroup, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.ModifierGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuModifier) []response.ModifierGroup); ok {
+		r0 = rf(ctx, menu_id, mm)
+	} else {
+		r0 = ret.Get(0).([]response.ModifierGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuModifierList(ctx context.Context, menu_id string) (list []response.ModifierGroup, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.ModifierGroup
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.ModifierGroup); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).([]response.ModifierGroup)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuModifierValidate(ctx context.Context, menu_id string, sel request.MenuModifierSelection) (ms response.MenuModifierSelection, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuModifierSelection
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuModifierSelection) response.MenuModifierSelection); ok {
+		r0 = rf(ctx, menu_id, sel)
+	} else {
+		r0 = ret.Get(0).(response.MenuModifierSelection)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuBundle(ctx context.Context, menu_id string) (mb response.MenuBundle, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuBundle
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.MenuBundle); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).(response.MenuBundle)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuBundleSet(ctx context.Context, menu_id string, req request.MenuBundle) (mb response.MenuBundle, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuBundle
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuBundle) response.MenuBundle); ok {
+		r0 = rf(ctx, menu_id, req)
+	} else {
+		r0 = ret.Get(0).(response.MenuBundle)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) PromotionAdd(ctx context.Context, p request.Promotion) (promo response.Promotion, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Promotion
+	if rf, ok := ret.Get(0).(func(context.Context, request.Promotion) response.Promotion); ok {
+		r0 = rf(ctx, p)
+	} else {
+		r0 = ret.Get(0).(response.Promotion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) PromotionUpdate(ctx context.Context, promotion_id string, p request.Promotion) (promo response.Promotion, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Promotion
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.Promotion) response.Promotion); ok {
+		r0 = rf(ctx, promotion_id, p)
+	} else {
+		r0 = ret.Get(0).(response.Promotion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) PromotionList(ctx context.Context, warteg_id string) (list []response.Promotion, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.Promotion
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.Promotion); ok {
+		r0 = rf(ctx, warteg_id)
+	} else {
+		r0 = ret.Get(0).([]response.Promotion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) PromotionDetail(ctx context.Context, promotion_id string) (promo response.Promotion, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Promotion
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.Promotion); ok {
+		r0 = rf(ctx, promotion_id)
+	} else {
+		r0 = ret.Get(0).(response.Promotion)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) PromotionDelete(ctx context.Context, promotion_id string) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuPrices(ctx context.Context, menu_id string) (mp response.MenuPrices, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuPrices
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.MenuPrices); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).(response.MenuPrices)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuPriceScheduleAdd(ctx context.Context, menu_id string, req request.MenuPriceSchedule) (ps response.MenuPriceSchedule, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuPriceSchedule
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuPriceSchedule) response.MenuPriceSchedule); ok {
+		r0 = rf(ctx, menu_id, req)
+	} else {
+		r0 = ret.Get(0).(response.MenuPriceSchedule)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuPriceScheduleCancel(ctx context.Context, menu_id, schedule_id string) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuPriceScheduleRun(ctx context.Context) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) AuditList(ctx context.Context, filter request.AuditFilter) (list []response.AuditLog, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.AuditLog
+	if rf, ok := ret.Get(0).(func(context.Context, request.AuditFilter) []response.AuditLog); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).([]response.AuditLog)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuEventRelay(ctx context.Context) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) WebhookAdd(ctx context.Context, req request.Webhook) (w response.Webhook, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, request.Webhook) response.Webhook); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(response.Webhook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WebhookUpdate(ctx context.Context, webhook_id string, req request.Webhook) (w response.Webhook, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.Webhook) response.Webhook); ok {
+		r0 = rf(ctx, webhook_id, req)
+	} else {
+		r0 = ret.Get(0).(response.Webhook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WebhookDelete(ctx context.Context, webhook_id string) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) WebhookDetail(ctx context.Context, webhook_id string) (w response.Webhook, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.Webhook); ok {
+		r0 = rf(ctx, webhook_id)
+	} else {
+		r0 = ret.Get(0).(response.Webhook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WebhookList(ctx context.Context, warteg_id string) (list []response.Webhook, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.Webhook
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.Webhook); ok {
+		r0 = rf(ctx, warteg_id)
+	} else {
+		r0 = ret.Get(0).([]response.Webhook)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WebhookDeliveryList(ctx context.Context, webhook_id string, filter request.WebhookDeliveryFilter) (list []response.WebhookDelivery, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.WebhookDelivery
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.WebhookDeliveryFilter) []response.WebhookDelivery); ok {
+		r0 = rf(ctx, webhook_id, filter)
+	} else {
+		r0 = ret.Get(0).([]response.WebhookDelivery)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WebhookRedeliver(ctx context.Context, webhook_id string, delivery_id int64) (d response.WebhookDelivery, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.WebhookDelivery
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) response.WebhookDelivery); ok {
+		r0 = rf(ctx, webhook_id, delivery_id)
+	} else {
+		r0 = ret.Get(0).(response.WebhookDelivery)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WebhookDeliveryRun(ctx context.Context) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuStream(ctx context.Context, warteg_id, last_event_id string) (ms response.MenuStream, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuStream
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) response.MenuStream); ok {
+		r0 = rf(ctx, warteg_id, last_event_id)
+	} else {
+		r0 = ret.Get(0).(response.MenuStream)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuStreamSubscribe(ctx context.Context, warteg_id string) (notify <-chan struct{}) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func(context.Context, string) <-chan struct{}); ok {
+		r0 = rf(ctx, warteg_id)
+	} else {
+		r0 = ret.Get(0).(<-chan struct{})
+	}
+
+	return r0
+}
+
+func (_m *Usecase) WartegLocationSet(ctx context.Context, warteg_id string, req request.WartegLocation) (response.WartegLocation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.WartegLocation
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.WartegLocation) response.WartegLocation); ok {
+		r0 = rf(ctx, warteg_id, req)
+	} else {
+		r0 = ret.Get(0).(response.WartegLocation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WartegLocation(ctx context.Context, warteg_id string) (response.WartegLocation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.WartegLocation
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.WartegLocation); ok {
+		r0 = rf(ctx, warteg_id)
+	} else {
+		r0 = ret.Get(0).(response.WartegLocation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WartegHoursSet(ctx context.Context, warteg_id string, req request.WartegHours) (response.WartegHours, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.WartegHours
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.WartegHours) response.WartegHours); ok {
+		r0 = rf(ctx, warteg_id, req)
+	} else {
+		r0 = ret.Get(0).(response.WartegHours)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) WartegHours(ctx context.Context, warteg_id string) (response.WartegHours, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.WartegHours
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.WartegHours); ok {
+		r0 = rf(ctx, warteg_id)
+	} else {
+		r0 = ret.Get(0).(response.WartegHours)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuWindowSet(ctx context.Context, menu_id string, req request.MenuWindows) (response.MenuWindows, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuWindows
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuWindows) response.MenuWindows); ok {
+		r0 = rf(ctx, menu_id, req)
+	} else {
+		r0 = ret.Get(0).(response.MenuWindows)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuWindows(ctx context.Context, menu_id string) (response.MenuWindows, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuWindows
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.MenuWindows); ok {
+		r0 = rf(ctx, menu_id)
+	} else {
+		r0 = ret.Get(0).(response.MenuWindows)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuDetailAt(ctx context.Context, menu_id string, at time.Time) (response.MenuDetail, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuDetail
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) response.MenuDetail); ok {
+		r0 = rf(ctx, menu_id, at)
+	} else {
+		r0 = ret.Get(0).(response.MenuDetail)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuStatusSet(ctx context.Context, menu_id string, req request.MenuStatus) (ms response.MenuStatus, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.MenuStatus) response.MenuStatus); ok {
+		r0 = rf(ctx, menu_id, req)
+	} else {
+		r0 = ret.Get(0).(response.MenuStatus)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuStaffList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuList
+	if rf, ok := ret.Get(0).(func(context.Context, request.MenuList) []response.MenuList); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).([]response.MenuList)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuPreview(ctx context.Context, menu_id string, at time.Time) (mnd response.MenuDetail, err error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuDetail
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) response.MenuDetail); ok {
+		r0 = rf(ctx, menu_id, at)
+	} else {
+		r0 = ret.Get(0).(response.MenuDetail)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuPublishRun(ctx context.Context) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) MenuPriceChangeList(ctx context.Context, filter request.MenuPriceChangeFilter) ([]response.MenuPriceChange, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.MenuPriceChange
+	if rf, ok := ret.Get(0).(func(context.Context, request.MenuPriceChangeFilter) []response.MenuPriceChange); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		r0 = ret.Get(0).([]response.MenuPriceChange)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuPriceChangeApprove(ctx context.Context, menu_id string, change_id string, req request.MenuPriceChangeReview) (response.MenuPriceChange, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuPriceChange
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, request.MenuPriceChangeReview) response.MenuPriceChange); ok {
+		r0 = rf(ctx, menu_id, change_id, req)
+	} else {
+		r0 = ret.Get(0).(response.MenuPriceChange)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) MenuPriceChangeReject(ctx context.Context, menu_id string, change_id string, req request.MenuPriceChangeReview) (response.MenuPriceChange, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.MenuPriceChange
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, request.MenuPriceChangeReview) response.MenuPriceChange); ok {
+		r0 = rf(ctx, menu_id, change_id, req)
+	} else {
+		r0 = ret.Get(0).(response.MenuPriceChange)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandAdd(ctx context.Context, req request.Brand) (response.Brand, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.Brand
+	if rf, ok := ret.Get(0).(func(context.Context, request.Brand) response.Brand); ok {
+		r0 = rf(ctx, req)
+	} else {
+		r0 = ret.Get(0).(response.Brand)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandList(ctx context.Context) ([]response.Brand, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.Brand
+	if rf, ok := ret.Get(0).(func(context.Context) []response.Brand); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).([]response.Brand)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandMenuAdd(ctx context.Context, brand_id string, req request.BrandMenu) (response.BrandMenu, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.BrandMenu
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.BrandMenu) response.BrandMenu); ok {
+		r0 = rf(ctx, brand_id, req)
+	} else {
+		r0 = ret.Get(0).(response.BrandMenu)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandMenuList(ctx context.Context, brand_id string) ([]response.BrandMenu, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []response.BrandMenu
+	if rf, ok := ret.Get(0).(func(context.Context, string) []response.BrandMenu); ok {
+		r0 = rf(ctx, brand_id)
+	} else {
+		r0 = ret.Get(0).([]response.BrandMenu)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandMenuUpdate(ctx context.Context, brand_id string, template_id string, req request.BrandMenu) (response.BrandMenu, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.BrandMenu
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, request.BrandMenu) response.BrandMenu); ok {
+		r0 = rf(ctx, brand_id, template_id, req)
+	} else {
+		r0 = ret.Get(0).(response.BrandMenu)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandMenuDelete(ctx context.Context, brand_id string, template_id string) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) BrandWartegSet(ctx context.Context, warteg_id string, req request.BrandWarteg) (response.BrandWarteg, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.BrandWarteg
+	if rf, ok := ret.Get(0).(func(context.Context, string, request.BrandWarteg) response.BrandWarteg); ok {
+		r0 = rf(ctx, warteg_id, req)
+	} else {
+		r0 = ret.Get(0).(response.BrandWarteg)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandWartegDelete(ctx context.Context, warteg_id string) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+func (_m *Usecase) BrandPropagate(ctx context.Context, brand_id string) (response.BrandPropagation, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.BrandPropagation
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.BrandPropagation); ok {
+		r0 = rf(ctx, brand_id)
+	} else {
+		r0 = ret.Get(0).(response.BrandPropagation)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) BrandDivergence(ctx context.Context, brand_id string) (response.BrandDivergence, error) {
+	ret := _m.Called(ctx)
+
+	var r0 response.BrandDivergence
+	if rf, ok := ret.Get(0).(func(context.Context, string) response.BrandDivergence); ok {
+		r0 = rf(ctx, brand_id)
+	} else {
+		r0 = ret.Get(0).(response.BrandDivergence)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+func (_m *Usecase) PromotionBoundaryRun(ctx context.Context) (err error) {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,193 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const addAuditLog = `-- name: AddAuditLog :exec
+INSERT INTO tb_audit_log (
+	entity_type,
+	entity_id,
+	menu_id,
+	warteg_id,
+	action,
+	actor,
+	request_id,
+	source_ip,
+	before_data,
+	after_data
+) VALUES (?, ?, ?, IFNULL(?, (SELECT m.warteg_id FROM tb_menu m WHERE m.menu_id = ?)), ?, ?, ?, ?, ?, ?)
+`
+
+// AuditAdd appends an audit entry made by the actor of ctx, snapshots are stored as json, warteg of the menu is
+// used when the entry has no warteg
+func (q *Queries) AuditAdd(ctx context.Context, a request.AuditLog) error {
+	before, err := auditSnapshot(a.Before)
+	if err != nil {
+		return err
+	}
+
+	after, err := auditSnapshot(a.After)
+	if err != nil {
+		return err
+	}
+
+	requestId, ip := actor.Request(ctx)
+
+	_, err = q.db.ExecContext(ctx, addAuditLog,
+		a.EntityType,
+		a.EntityId,
+		nullString(a.MenuId),
+		nullString(a.WartegId),
+		a.MenuId,
+		a.Action,
+		actor.FromContext(ctx),
+		requestId,
+		ip,
+		before,
+		after,
+	)
+	return err
+}
+
+// lockEntity locks the row an audited entity belongs to, entities of a menu lock the menu. Wartegs may have no row
+// yet, they are not found without an error
+var lockEntity = map[string]string{
+	constant.AuditEntityMenu:           `SELECT menu_id FROM tb_menu WHERE menu_id = ? FOR UPDATE`,
+	constant.AuditEntityModifierGroup:  `SELECT group_id FROM tb_modifier_group WHERE group_id = ? FOR UPDATE`,
+	constant.AuditEntityPromotion:      `SELECT promotion_id FROM tb_promotion WHERE promotion_id = ? FOR UPDATE`,
+	constant.AuditEntityBrand:          `SELECT brand_id FROM tb_brand WHERE brand_id = ? FOR UPDATE`,
+	constant.AuditEntityBrandWarteg:    `SELECT warteg_id FROM tb_brand_warteg WHERE warteg_id = ? FOR UPDATE`,
+	constant.AuditEntityWebhook:        `SELECT webhook_id FROM tb_webhook WHERE webhook_id = ? FOR UPDATE`,
+	constant.AuditEntityWartegLocation: `SELECT warteg_id FROM tb_warteg WHERE warteg_id = ? FOR UPDATE`,
+	constant.AuditEntityWartegHours:    `SELECT warteg_id FROM tb_warteg_schedule WHERE warteg_id = ? FOR UPDATE`,
+}
+
+// Lock locks the row of an audited entity until the transaction of ctx ends, so the snapshot read before a change
+// is not changed by another writer meanwhile
+func (q *Queries) Lock(ctx context.Context, entity, id string) error {
+	query, ok := lockEntity[entity]
+	if !ok {
+		return fmt.Errorf("no lock for entity %s", entity)
+	}
+
+	var locked string
+	err := q.db.QueryRowContext(ctx, query, id).Scan(&locked)
+	if err == sql.ErrNoRows {
+		if entity == constant.AuditEntityWartegLocation || entity == constant.AuditEntityWartegHours || entity == constant.AuditEntityBrandWarteg {
+			return nil
+		}
+		return constant.ErrNotFound
+	}
+
+	return err
+}
+
+const auditLogColumns = `audit_id, entity_type, entity_id, IFNULL(menu_id, ''), IFNULL(warteg_id, ''), action, actor, request_id, source_ip,
+before_data, after_data, created_date`
+
+// AuditList returns audit entries matching the filter newest first, before_id pages to older entries
+func (q *Queries) AuditList(ctx context.Context, filter request.AuditFilter) (list []response.AuditLog, err error) {
+	where := `WHERE 1=1`
+	args := []interface{}{}
+
+	if filter.MenuId != "" {
+		where += ` AND menu_id = ?`
+		args = append(args, filter.MenuId)
+	}
+	if filter.WartegId != "" {
+		where += ` AND warteg_id = ?`
+		args = append(args, filter.WartegId)
+	}
+	if filter.Actor != "" {
+		where += ` AND actor = ?`
+		args = append(args, filter.Actor)
+	}
+	if filter.EntityType != "" {
+		where += ` AND entity_type = ?`
+		args = append(args, filter.EntityType)
+	}
+	if filter.From != nil {
+		where += ` AND created_date >= ?`
+		args = append(args, *filter.From)
+	}
+	if filter.To != nil {
+		where += ` AND created_date < ?`
+		args = append(args, *filter.To)
+	}
+	if filter.BeforeId > 0 {
+		where += ` AND audit_id < ?`
+		args = append(args, filter.BeforeId)
+	}
+	args = append(args, filter.Limit)
+
+	listAudit := fmt.Sprintf("SELECT %s FROM tb_audit_log %s ORDER BY audit_id DESC LIMIT ?", auditLogColumns, where)
+
+	rows, err := q.db.QueryContext(ctx, listAudit, args...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.AuditLog{}
+	for rows.Next() {
+		var i response.AuditLog
+		var before, after sql.NullString
+
+		err = rows.Scan(
+			&i.AuditId,
+			&i.EntityType,
+			&i.EntityId,
+			&i.MenuId,
+			&i.WartegId,
+			&i.Action,
+			&i.Actor,
+			&i.RequestId,
+			&i.SourceIp,
+			&before,
+			&after,
+			&i.CreatedDate,
+		)
+		if err != nil {
+			return
+		}
+
+		if before.Valid {
+			i.Before = json.RawMessage(before.String)
+		}
+		if after.Valid {
+			i.After = json.RawMessage(after.String)
+		}
+
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+// auditSnapshot encodes snapshot as json, a missing snapshot is stored as NULL
+func auditSnapshot(v interface{}) (sql.NullString, error) {
+	if v == nil {
+		return sql.NullString{}, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil || string(b) == "null" {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
+
+func nullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
@@ -0,0 +1,441 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const addBrand = `-- name: AddBrand :exec
+INSERT INTO tb_brand (brand_id, brand_name) VALUES (?, ?)
+`
+
+func (q *Queries) BrandAdd(ctx context.Context, req request.Brand) error {
+	_, err := q.db.ExecContext(ctx, addBrand, req.BrandId, req.BrandName)
+	return err
+}
+
+const brandColumns = `a.brand_id, a.brand_name,
+(SELECT COUNT(*) FROM tb_brand_menu t WHERE t.brand_id = a.brand_id),
+(SELECT COUNT(*) FROM tb_brand_warteg w WHERE w.brand_id = a.brand_id), a.updated_date`
+
+const getBrands = `-- name: Brands :many
+SELECT ` + brandColumns + ` FROM tb_brand a ORDER BY a.brand_name, a.brand_id
+`
+
+// BrandList returns every brand with its number of templates and branches
+func (q *Queries) BrandList(ctx context.Context) (list []response.Brand, err error) {
+	rows, err := q.db.QueryContext(ctx, getBrands)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.Brand{}
+	for rows.Next() {
+		var i response.Brand
+		err = rows.Scan(&i.BrandId, &i.BrandName, &i.Templates, &i.Branches, &i.UpdatedDate)
+		if err != nil {
+			return
+		}
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const getBrand = `-- name: Brand :one
+SELECT ` + brandColumns + ` FROM tb_brand a WHERE a.brand_id = ?
+`
+
+func (q *Queries) BrandDetail(ctx context.Context, brand_id string) (b response.Brand, err error) {
+	err = q.db.QueryRowContext(ctx, getBrand, brand_id).Scan(&b.BrandId, &b.BrandName, &b.Templates, &b.Branches, &b.UpdatedDate)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return b, err
+}
+
+const lockBrand = `-- name: LockBrand :exec
+SELECT brand_id FROM tb_brand WHERE brand_id = ? FOR UPDATE
+`
+
+// BrandLock locks brand until the transaction ends so propagations of a brand never run side by side
+func (q *Queries) BrandLock(ctx context.Context, brand_id string) error {
+	err := q.db.QueryRowContext(ctx, lockBrand, brand_id).Scan(&brand_id)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return err
+}
+
+const addBrandMenu = `-- name: AddBrandMenu :exec
+INSERT INTO tb_brand_menu (template_id, brand_id, menu_type_id, menu_name, menu_detail, menu_picture, menu_price)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+func (q *Queries) BrandMenuAdd(ctx context.Context, req request.BrandMenu) error {
+	_, err := q.db.ExecContext(ctx, addBrandMenu,
+		req.TemplateId,
+		req.BrandId,
+		req.MenuTypeId,
+		req.MenuName,
+		req.MenuDetail,
+		req.MenuPicture,
+		req.MenuPrice,
+	)
+	return err
+}
+
+const updateBrandMenu = `-- name: UpdateBrandMenu :exec
+UPDATE tb_brand_menu SET menu_type_id=?, menu_name=?, menu_detail=?, menu_picture=?, menu_price=?,
+updated_date=CURRENT_TIMESTAMP(3) WHERE template_id = ? AND brand_id = ?
+`
+
+func (q *Queries) BrandMenuUpdate(ctx context.Context, req request.BrandMenu) error {
+	result, err := q.db.ExecContext(ctx, updateBrandMenu,
+		req.MenuTypeId,
+		req.MenuName,
+		req.MenuDetail,
+		req.MenuPicture,
+		req.MenuPrice,
+		req.TemplateId,
+		req.BrandId,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const deleteBrandMenu = `-- name: DeleteBrandMenu :exec
+DELETE FROM tb_brand_menu WHERE template_id = ? AND brand_id = ?
+`
+
+func (q *Queries) BrandMenuDelete(ctx context.Context, brand_id, template_id string) error {
+	result, err := q.db.ExecContext(ctx, deleteBrandMenu, template_id, brand_id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const brandMenuColumns = `template_id, brand_id, menu_type_id, menu_name, IFNULL(menu_detail, ''), IFNULL(menu_picture, ''),
+menu_price, updated_date`
+
+const getBrandMenu = `-- name: BrandMenu :one
+SELECT ` + brandMenuColumns + ` FROM tb_brand_menu WHERE template_id = ? AND brand_id = ?
+`
+
+func (q *Queries) BrandMenuDetail(ctx context.Context, brand_id, template_id string) (bm response.BrandMenu, err error) {
+	row := q.db.QueryRowContext(ctx, getBrandMenu, template_id, brand_id)
+	err = scanBrandMenu(row, &bm)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return bm, err
+}
+
+const getBrandMenus = `-- name: BrandMenus :many
+SELECT ` + brandMenuColumns + ` FROM tb_brand_menu WHERE brand_id = ? ORDER BY menu_type_id, menu_name, template_id
+`
+
+func (q *Queries) BrandMenuList(ctx context.Context, brand_id string) (list []response.BrandMenu, err error) {
+	rows, err := q.db.QueryContext(ctx, getBrandMenus, brand_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.BrandMenu{}
+	for rows.Next() {
+		var bm response.BrandMenu
+		err = scanBrandMenu(rows, &bm)
+		if err != nil {
+			return
+		}
+		list = append(list, bm)
+	}
+
+	return list, rows.Err()
+}
+
+const setBrandWarteg = `-- name: SetBrandWarteg :exec
+INSERT INTO tb_brand_warteg (warteg_id, brand_id) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE assigned_date=IF(brand_id=VALUES(brand_id), assigned_date, CURRENT_TIMESTAMP(3)),
+brand_id=VALUES(brand_id)
+`
+
+// BrandWartegSet assigns warteg to a brand, replacing its previous brand
+func (q *Queries) BrandWartegSet(ctx context.Context, req request.BrandWarteg) error {
+	_, err := q.db.ExecContext(ctx, setBrandWarteg, req.WartegId, req.BrandId)
+	return err
+}
+
+const deleteBrandWarteg = `-- name: DeleteBrandWarteg :exec
+DELETE FROM tb_brand_warteg WHERE warteg_id = ?
+`
+
+func (q *Queries) BrandWartegDelete(ctx context.Context, warteg_id string) error {
+	result, err := q.db.ExecContext(ctx, deleteBrandWarteg, warteg_id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const getBrandWarteg = `-- name: BrandWarteg :one
+SELECT warteg_id, brand_id, assigned_date FROM tb_brand_warteg WHERE warteg_id = ?
+`
+
+func (q *Queries) BrandWarteg(ctx context.Context, warteg_id string) (bw response.BrandWarteg, err error) {
+	err = q.db.QueryRowContext(ctx, getBrandWarteg, warteg_id).Scan(&bw.WartegId, &bw.BrandId, &bw.AssignedDate)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return bw, err
+}
+
+const getBrandWartegs = `-- name: BrandWartegs :many
+SELECT warteg_id, brand_id, assigned_date FROM tb_brand_warteg WHERE brand_id = ? ORDER BY warteg_id
+`
+
+// BrandWartegList returns branch wartegs of a brand
+func (q *Queries) BrandWartegList(ctx context.Context, brand_id string) (list []response.BrandWarteg, err error) {
+	rows, err := q.db.QueryContext(ctx, getBrandWartegs, brand_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.BrandWarteg{}
+	for rows.Next() {
+		var bw response.BrandWarteg
+		err = rows.Scan(&bw.WartegId, &bw.BrandId, &bw.AssignedDate)
+		if err != nil {
+			return
+		}
+		list = append(list, bw)
+	}
+
+	return list, rows.Err()
+}
+
+const getBrandBranchMenus = `-- name: BrandBranchMenus :many
+SELECT w.warteg_id, t.template_id, t.brand_id, t.menu_type_id, t.menu_name, IFNULL(t.menu_detail, ''),
+IFNULL(t.menu_picture, ''), t.menu_price, t.updated_date, b.menu_id, b.menu_type_id, IFNULL(b.warteg_id, ''),
+b.menu_name, IFNULL(b.menu_detail, ''), IFNULL(b.menu_picture, ''), b.menu_price, IFNULL(l.synced_price, 0),
+` + menuStatus + `
+FROM tb_brand_warteg w JOIN tb_brand_menu t ON t.brand_id = w.brand_id
+LEFT JOIN tb_menu_template l ON l.template_id = t.template_id AND l.warteg_id = w.warteg_id
+LEFT JOIN tb_menu b ON b.menu_id = l.menu_id
+` + menuStatusJoin + `
+WHERE w.brand_id = ? AND (? = '' OR t.template_id = ?) AND (? = '' OR w.warteg_id = ?)
+ORDER BY w.warteg_id, t.menu_type_id, t.menu_name, t.template_id
+`
+
+// BrandBranchMenus returns every template of brand at every branch with the branch menu created from it, optionally
+// of a template or a branch only
+func (q *Queries) BrandBranchMenus(ctx context.Context, brand_id, template_id, warteg_id string) (list []response.BrandBranchMenu, err error) {
+	rows, err := q.db.QueryContext(ctx, getBrandBranchMenus, brand_id, template_id, template_id, warteg_id, warteg_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.BrandBranchMenu{}
+	for rows.Next() {
+		var i response.BrandBranchMenu
+		var menuId sql.NullString
+		var menuTypeId, menuPrice sql.NullInt64
+		var menu response.MenuUpdate
+		var menuName sql.NullString
+
+		err = rows.Scan(
+			&i.WartegId,
+			&i.Template.TemplateId,
+			&i.Template.BrandId,
+			&i.Template.MenuTypeId,
+			&i.Template.MenuName,
+			&i.Template.MenuDetail,
+			&i.Template.MenuPicture,
+			&i.Template.MenuPrice,
+			&i.Template.UpdatedDate,
+			&menuId,
+			&menuTypeId,
+			&menu.WartegId,
+			&menuName,
+			&menu.MenuDetail,
+			&menu.MenuPicture,
+			&menuPrice,
+			&i.SyncedPrice,
+			&i.Status,
+		)
+		if err != nil {
+			return
+		}
+
+		if menuId.Valid {
+			menu.MenuId = menuId.String
+			menu.MenuTypeId = int(menuTypeId.Int64)
+			menu.MenuName = menuName.String
+			menu.MenuPrice = int(menuPrice.Int64)
+			i.Menu = &menu
+		}
+
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const setMenuTemplate = `-- name: SetMenuTemplate :exec
+INSERT INTO tb_menu_template (template_id, warteg_id, menu_id, synced_price) VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE menu_id=VALUES(menu_id), synced_price=VALUES(synced_price), synced_date=CURRENT_TIMESTAMP(3)
+`
+
+// MenuTemplateSet links branch menu to the template it is created from with the template price propagated to it
+func (q *Queries) MenuTemplateSet(ctx context.Context, template_id, warteg_id, menu_id string, synced_price int) error {
+	_, err := q.db.ExecContext(ctx, setMenuTemplate, template_id, warteg_id, menu_id, synced_price)
+	return err
+}
+
+const requestMenuTemplate = `-- name: RequestMenuTemplate :exec
+UPDATE tb_menu_template SET change_id = ? WHERE menu_id = ?
+`
+
+// MenuTemplateRequest records that the template price propagated to branch menu waits for approval of price change
+// change_id, the synced price is kept until the change is reviewed
+func (q *Queries) MenuTemplateRequest(ctx context.Context, menu_id, change_id string) error {
+	_, err := q.db.ExecContext(ctx, requestMenuTemplate, change_id, menu_id)
+	return err
+}
+
+const reviewMenuTemplate = `-- name: ReviewMenuTemplate :exec
+UPDATE tb_menu_template SET synced_price = IF(?, ?, synced_price), synced_date = IF(?, CURRENT_TIMESTAMP(3), synced_date),
+change_id = NULL WHERE change_id = ?
+`
+
+// MenuTemplateReview takes the price of an approved price change change_id as synced price of the branch menu that
+// waits for it, a rejected change keeps the synced price so the next propagation requests the template price again.
+// A change not requested by a propagation is left as it is
+func (q *Queries) MenuTemplateReview(ctx context.Context, change_id, status string, price int) error {
+	approved := status == constant.PriceChangeApproved
+	_, err := q.db.ExecContext(ctx, reviewMenuTemplate, approved, price, approved, change_id)
+	return err
+}
+
+const getMenuTemplateMenus = `-- name: MenuTemplateMenus :many
+SELECT l.menu_id FROM tb_menu_template l JOIN tb_menu b ON b.menu_id = l.menu_id WHERE l.template_id = ?
+ORDER BY l.warteg_id
+`
+
+// MenuTemplateMenus returns branch menus created from a template
+func (q *Queries) MenuTemplateMenus(ctx context.Context, template_id string) (menu_ids []string, err error) {
+	rows, err := q.db.QueryContext(ctx, getMenuTemplateMenus, template_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	menu_ids = []string{}
+	for rows.Next() {
+		var menuId string
+		err = rows.Scan(&menuId)
+		if err != nil {
+			return
+		}
+		menu_ids = append(menu_ids, menuId)
+	}
+
+	return menu_ids, rows.Err()
+}
+
+const deleteMenuTemplateByTemplate = `-- name: DeleteMenuTemplateByTemplate :exec
+DELETE FROM tb_menu_template WHERE template_id = ?
+`
+
+func (q *Queries) MenuTemplateDeleteByTemplate(ctx context.Context, template_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuTemplateByTemplate, template_id)
+	return err
+}
+
+const deleteMenuTemplateByWarteg = `-- name: DeleteMenuTemplateByWarteg :exec
+DELETE FROM tb_menu_template WHERE warteg_id = ?
+AND template_id NOT IN (SELECT template_id FROM tb_brand_menu WHERE brand_id = ?)
+`
+
+// MenuTemplateDeleteByWarteg unlinks branch menus of warteg from templates of other brands than brand_id, every link
+// when brand_id is empty, the menus stay as menus of the warteg
+func (q *Queries) MenuTemplateDeleteByWarteg(ctx context.Context, warteg_id, brand_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuTemplateByWarteg, warteg_id, brand_id)
+	return err
+}
+
+const deleteMenuTemplateByMenu = `-- name: DeleteMenuTemplateByMenu :exec
+DELETE FROM tb_menu_template WHERE menu_id = ?
+`
+
+func (q *Queries) MenuTemplateDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuTemplateByMenu, menu_id)
+	return err
+}
+
+func scanBrandMenu(row scanner, bm *response.BrandMenu) error {
+	return row.Scan(
+		&bm.TemplateId,
+		&bm.BrandId,
+		&bm.MenuTypeId,
+		&bm.MenuName,
+		&bm.MenuDetail,
+		&bm.MenuPicture,
+		&bm.MenuPrice,
+		&bm.UpdatedDate,
+	)
+}
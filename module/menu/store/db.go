@@ -13,6 +13,38 @@ type DBTX interface {
 	QueryRowContext(context.Context, string, ...interface{}) *sql.Row
 }
 
+// txKey is context key of the transaction a repository call joins
+type txKey struct{}
+
+// txDB runs queries in the transaction of ctx when there is one, so reads and writes made through the store
+// within SQLStore.Transaction belong to that transaction
+type txDB struct {
+	db *sql.DB
+}
+
+func (t txDB) conn(ctx context.Context) DBTX {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return t.db
+}
+
+func (t txDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return t.conn(ctx).ExecContext(ctx, query, args...)
+}
+
+func (t txDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	return t.conn(ctx).PrepareContext(ctx, query)
+}
+
+func (t txDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return t.conn(ctx).QueryContext(ctx, query, args...)
+}
+
+func (t txDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return t.conn(ctx).QueryRowContext(ctx, query, args...)
+}
+
 // New will
 func New(db DBTX) *Queries {
 	return &Queries{db: db}
@@ -4,14 +4,18 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/geo"
 	"github.com/cpartogi/foodmenu/schema/request"
 	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
 )
 
 const getMenuType = `-- name: MenuType
-SELECT menu_type_id, menu_type_name FROM tb_menu_type ORDER BY menu_type_name
+SELECT menu_type_id, menu_type_name, updated_date FROM tb_menu_type ORDER BY menu_type_name
 `
 
 // Deposit balancelog is
@@ -28,6 +32,7 @@ func (q *Queries) MenuType(ctx context.Context) ([]response.MenuType, error) {
 		_ = rows.Scan(
 			&i.MenuTypeId,
 			&i.MenuTypeName,
+			&i.UpdatedDate,
 		)
 		y = append(y, i)
 		c++
@@ -51,7 +56,7 @@ INSERT INTO tb_menu (
     menu_picture,
 	menu_price
 ) VALUES (
-    uuid(),
+    ?,
     ?,
     ?,
     ?,
@@ -62,7 +67,10 @@ INSERT INTO tb_menu (
 `
 
 func (q *Queries) MenuAdd(ctx context.Context, addm request.Menu) (mn response.MenuAdd, err error) {
+	menuId := uuid.New().String()
+
 	result, err := q.db.ExecContext(ctx, addMenu,
+		menuId,
 		addm.MenuTypeId,
 		addm.WartegId,
 		addm.MenuName,
@@ -86,12 +94,17 @@ func (q *Queries) MenuAdd(ctx context.Context, addm request.Menu) (mn response.M
 	}
 
 	i := response.MenuAdd{
+		MenuId:      menuId,
 		MenuTypeId:  addm.MenuTypeId,
 		WartegId:    addm.WartegId,
 		MenuName:    addm.MenuName,
 		MenuDetail:  addm.MenuDetail,
 		MenuPicture: addm.MenuPicture,
 		MenuPrice:   addm.MenuPrice,
+		Status:      constant.MenuStatusPublished,
+	}
+	if addm.Status != "" {
+		i.Status, i.PublishAt = addm.Status, addm.PublishAt
 	}
 
 	return i, err
@@ -163,12 +176,94 @@ func (q *Queries) MenuUpdate(ctx context.Context, menu_id string, upm request.Me
 	return i, err
 }
 
-func (q *Queries) MenuList(ctx context.Context, warteg_id, menu_type_id, menu_name string) (list []response.MenuList, err error) {
-	qMenu := `SELECT b.menu_id, a.menu_type_name, b.warteg_id, b.menu_name, b.menu_price FROM tb_menu_type a, tb_menu b WHERE a.menu_type_id=b.menu_type_id AND b.warteg_id like '%%%s%%' AND b.menu_type_id like '%%%s%%' AND b.menu_name like '%%%s%%'  ORDER BY b.menu_name LIMIT 50`
+const menuListColumns = `b.menu_id, b.menu_type_id, a.menu_type_name, b.warteg_id, b.menu_name, b.menu_price,
+IFNULL(p.min_price, b.menu_price), IFNULL(p.max_price, b.menu_price),
+` + menuSoldOut + `, v.stock, ` + menuIsBundle + `, ` + menuStatus + `, ms.publish_at,
+GREATEST(b.updated_date, IFNULL(v.updated_date, b.updated_date))`
+
+// menuListPrices joins price range of menus that have variants, menus without variants use menu price
+const menuListPrices = `LEFT JOIN (
+	SELECT mv.menu_id, MIN(` + variantPrice + `) min_price, MAX(` + variantPrice + `) max_price
+	FROM tb_menu_variant mv JOIN tb_menu m ON m.menu_id=mv.menu_id GROUP BY mv.menu_id
+) p ON p.menu_id=b.menu_id`
+
+const menuListFrom = `FROM tb_menu_type a JOIN tb_menu b ON a.menu_type_id=b.menu_type_id
+LEFT JOIN tb_menu_availability v ON v.menu_id=b.menu_id
+` + menuStatusJoin
+
+// menuListNear joins warteg locations, menus of wartegs without coordinates are left out
+const menuListNear = `JOIN tb_warteg w ON w.warteg_id=b.warteg_id`
+
+// menuListDistance is distance in meters between the warteg and the point of the filter
+const menuListDistance = `CAST(ROUND(ST_Distance_Sphere(w.location, ` + geoFromText + `)) AS SIGNED)`
+
+func menuListNearby(filter request.MenuList) bool {
+	return filter.Lat != nil && filter.Lng != nil
+}
+
+// menuListSource builds from clause shared by menu list and export
+func menuListSource(filter request.MenuList) string {
+	if menuListNearby(filter) {
+		return menuListFrom + "\n" + menuListNear
+	}
+	return menuListFrom
+}
+
+// menuListWhere builds where clause shared by menu list and export
+func menuListWhere(filter request.MenuList) (string, []interface{}) {
+	where := `WHERE IFNULL(b.warteg_id, '') like ? AND b.menu_type_id like ? AND b.menu_name like ?`
+	args := []interface{}{"%" + filter.WartegId + "%", "%" + filter.MenuTypeId + "%", "%" + filter.MenuName + "%"}
+
+	statuses := filter.Statuses
+	if len(statuses) == 0 {
+		statuses = []string{constant.MenuStatusPublished}
+	}
+	where += ` AND ` + menuStatus + ` IN (` + placeholders(len(statuses)) + `)`
+	args = append(args, stringArgs(statuses)...)
+
+	if filter.AvailableOnly {
+		where += ` AND NOT ` + menuSoldOut
+	}
+
+	if len(filter.ClosedWartegIds) > 0 {
+		where += ` AND IFNULL(b.warteg_id, '') NOT IN (` + placeholders(len(filter.ClosedWartegIds)) + `)`
+		args = append(args, stringArgs(filter.ClosedWartegIds)...)
+	}
+
+	if len(filter.OffScheduleMenuIds) > 0 {
+		where += ` AND b.menu_id NOT IN (` + placeholders(len(filter.OffScheduleMenuIds)) + `)`
+		args = append(args, stringArgs(filter.OffScheduleMenuIds)...)
+	}
+
+	// the bounding box lets the spatial index find candidates, the distance check drops the corners
+	if menuListNearby(filter) {
+		point := geo.Point{Lat: *filter.Lat, Lng: *filter.Lng}
+		if box, ok := geo.BoundingBox(point, filter.Radius); ok {
+			where += ` AND MBRContains(` + geoFromText + `, w.location)`
+			args = append(args, box.WKT())
+		}
+		where += ` AND ` + menuListDistance + ` <= ?`
+		args = append(args, point.WKT(), filter.Radius)
+	}
+
+	return where, args
+}
 
-	listMenu := fmt.Sprintf(qMenu, warteg_id, menu_type_id, menu_name)
+func (q *Queries) MenuList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error) {
+	where, args := menuListWhere(filter)
+	distance, order := "NULL", "b.menu_name"
+	if menuListNearby(filter) {
+		distance, order = menuListDistance, "distance, b.menu_name"
+		args = append([]interface{}{geo.Point{Lat: *filter.Lat, Lng: *filter.Lng}.WKT()}, args...)
+	}
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = constant.MenuListLimit
+	}
+	args = append(args, limit, filter.Offset)
+	listMenu := fmt.Sprintf("SELECT %s, %s distance %s %s %s ORDER BY %s, b.menu_id LIMIT ? OFFSET ?", menuListColumns, distance, menuListSource(filter), menuListPrices, where, order)
 
-	rows, err := q.db.QueryContext(ctx, listMenu)
+	rows, err := q.db.QueryContext(ctx, listMenu, args...)
 
 	if err != nil {
 		return
@@ -177,17 +272,27 @@ func (q *Queries) MenuList(ctx context.Context, warteg_id, menu_type_id, menu_na
 	defer rows.Close()
 
 	var y []response.MenuList
-	var i response.MenuList
 
 	c := 0
 
 	for rows.Next() {
+		var i response.MenuList
 		_ = rows.Scan(
 			&i.MenuId,
+			&i.MenuTypeId,
 			&i.MenuTypeName,
 			&i.WartegId,
 			&i.MenuName,
 			&i.MenuPrice,
+			&i.MinPrice,
+			&i.MaxPrice,
+			&i.IsSoldOut,
+			&i.Stock,
+			&i.IsBundle,
+			&i.Status,
+			&i.PublishAt,
+			&i.UpdatedDate,
+			&i.Distance,
 		)
 		y = append(y, i)
 		c++
@@ -202,21 +307,34 @@ func (q *Queries) MenuList(ctx context.Context, warteg_id, menu_type_id, menu_na
 }
 
 const getMenuDetail = `-- name: MenuDetail :one
-SELECT b.menu_id, a.menu_type_name, b.warteg_id, b.menu_name, b.menu_detail, b.menu_picture, b.menu_price FROM tb_menu_type a, tb_menu b
-WHERE a.menu_type_id=b.menu_type_id AND b.menu_id = ?
+SELECT b.menu_id, b.menu_type_id, a.menu_type_name, b.warteg_id, b.menu_name, b.menu_detail, b.menu_picture, b.menu_price,
+` + menuSoldOut + `, v.stock, ` + menuIsBundle + `, ` + menuStatus + `, ms.publish_at,
+GREATEST(b.updated_date, IFNULL(v.updated_date, b.updated_date))
+FROM tb_menu_type a JOIN tb_menu b ON a.menu_type_id=b.menu_type_id
+LEFT JOIN tb_menu_availability v ON v.menu_id=b.menu_id
+` + menuStatusJoin + `
+WHERE b.menu_id = ?
 `
 
+// MenuDetail returns menu of any publishing status
 func (q *Queries) MenuDetail(ctx context.Context, menu_id string) (mnd response.MenuDetail, err error) {
 	row := q.db.QueryRowContext(ctx, getMenuDetail, menu_id)
 	var i response.MenuDetail
 	err = row.Scan(
 		&i.MenuId,
+		&i.MenuTypeId,
 		&i.MenuTypeName,
 		&i.WartegId,
 		&i.MenuName,
 		&i.MenuDetail,
 		&i.MenuPicture,
 		&i.MenuPrice,
+		&i.IsSoldOut,
+		&i.Stock,
+		&i.IsBundle,
+		&i.Status,
+		&i.PublishAt,
+		&i.UpdatedDate,
 	)
 
 	if err == sql.ErrNoRows {
@@ -225,3 +343,241 @@ func (q *Queries) MenuDetail(ctx context.Context, menu_id string) (mnd response.
 
 	return i, err
 }
+
+const getMenuDetails = `-- name: MenuDetails :many
+SELECT b.menu_id, b.menu_type_id, a.menu_type_name, b.warteg_id, b.menu_name, b.menu_detail, b.menu_picture, b.menu_price,
+` + menuSoldOut + `, v.stock, ` + menuIsBundle + `, GREATEST(b.updated_date, IFNULL(v.updated_date, b.updated_date))
+FROM tb_menu_type a JOIN tb_menu b ON a.menu_type_id=b.menu_type_id
+LEFT JOIN tb_menu_availability v ON v.menu_id=b.menu_id
+WHERE b.menu_id IN (%s) AND ` + menuPublishedB + `
+`
+
+// MenuDetails returns several published menus in one query, unknown and unpublished ids are left out
+func (q *Queries) MenuDetails(ctx context.Context, menu_ids []string) (list []response.MenuDetail, err error) {
+	list = []response.MenuDetail{}
+	if len(menu_ids) == 0 {
+		return
+	}
+
+	rows, err := q.db.QueryContext(ctx, fmt.Sprintf(getMenuDetails, placeholders(len(menu_ids))), stringArgs(menu_ids)...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var i response.MenuDetail
+		err = rows.Scan(
+			&i.MenuId,
+			&i.MenuTypeId,
+			&i.MenuTypeName,
+			&i.WartegId,
+			&i.MenuName,
+			&i.MenuDetail,
+			&i.MenuPicture,
+			&i.MenuPrice,
+			&i.IsSoldOut,
+			&i.Stock,
+			&i.IsBundle,
+			&i.UpdatedDate,
+		)
+		if err != nil {
+			return
+		}
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const scanMenus = `-- name: ScanMenus :many
+SELECT b.menu_id, b.menu_type_id, a.menu_type_name, b.warteg_id, b.menu_name, b.menu_detail
+FROM tb_menu_type a JOIN tb_menu b ON a.menu_type_id=b.menu_type_id
+WHERE ` + menuPublishedB + `
+`
+
+// MenuScan calls fn with every published menu, only columns describing the menu are read
+func (q *Queries) MenuScan(ctx context.Context, fn func(response.MenuDetail) error) (err error) {
+	rows, err := q.db.QueryContext(ctx, scanMenus)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var i response.MenuDetail
+		err = rows.Scan(
+			&i.MenuId,
+			&i.MenuTypeId,
+			&i.MenuTypeName,
+			&i.WartegId,
+			&i.MenuName,
+			&i.MenuDetail,
+		)
+		if err != nil {
+			return
+		}
+
+		err = fn(i)
+		if err != nil {
+			return
+		}
+	}
+
+	return rows.Err()
+}
+
+// placeholders returns n comma separated bind parameters for an IN clause
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
+
+func stringArgs(values []string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return args
+}
+
+const menuChangeSource = `FROM tb_menu b WHERE b.menu_id = ?`
+
+// MenuChangeAdd records a change log row and an outbox event from the current state of the menu
+func (q *Queries) MenuChangeAdd(ctx context.Context, menu_id, change_type string) error {
+	return q.menuChangesAdd(ctx, change_type, menuChangeSource, menu_id)
+}
+
+const getMenuChanges = `-- name: MenuChanges :many
+SELECT c.change_id, c.menu_id, IFNULL(c.warteg_id, ''), c.change_type, c.changed_date,
+b.menu_id, b.menu_type_id, a.menu_type_name, b.warteg_id, b.menu_name, b.menu_detail, b.menu_picture, b.menu_price, b.updated_date
+FROM tb_menu_change c
+LEFT JOIN tb_menu b ON b.menu_id = c.menu_id AND b.warteg_id <=> c.warteg_id AND ` + menuPublishedC + `
+LEFT JOIN tb_menu_type a ON a.menu_type_id = b.menu_type_id
+WHERE c.change_id > ? AND (? = '' OR c.warteg_id = ?)
+ORDER BY c.change_id LIMIT ?
+`
+
+const getLatestMenuChange = `-- name: LatestMenuChange :one
+SELECT IFNULL(MAX(c.change_id), 0) FROM tb_menu_change c
+`
+
+// MenuChangeLatest returns id of the last committed change log row, zero when nothing changed yet
+func (q *Queries) MenuChangeLatest(ctx context.Context) (change_id int64, err error) {
+	err = q.db.QueryRowContext(ctx, getLatestMenuChange).Scan(&change_id)
+	return
+}
+
+const getMenuChangeModified = `-- name: MenuChangeModified :one
+SELECT c.changed_date FROM tb_menu_change c WHERE (? = '' OR c.warteg_id = ?) ORDER BY c.change_id DESC LIMIT 1
+`
+
+// MenuChangeModified returns when the last change log row was recorded, optionally of a warteg, zero when nothing
+// changed yet. Deleted menus and menus moved to another warteg have their row as well
+func (q *Queries) MenuChangeModified(ctx context.Context, warteg_id string) (modified time.Time, err error) {
+	err = q.db.QueryRowContext(ctx, getMenuChangeModified, warteg_id, warteg_id).Scan(&modified)
+
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil
+	}
+
+	return modified, err
+}
+
+// MenuChangeList returns committed change log rows after the given change id joined with current menu state, menus not
+// published or since moved to another warteg are joined as deleted so customers of the row's warteg stop seeing them.
+// An empty warteg_id returns changes of every warteg
+func (q *Queries) MenuChangeList(ctx context.Context, since int64, warteg_id string, limit int) (list []response.MenuChange, err error) {
+	rows, err := q.db.QueryContext(ctx, getMenuChanges, since, warteg_id, warteg_id, limit)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var i response.MenuChange
+		var menuId, typeName, wartegId, name, detail, picture sql.NullString
+		var typeId, price sql.NullInt64
+		var updated sql.NullTime
+
+		err = rows.Scan(
+			&i.ChangeId,
+			&i.MenuId,
+			&i.WartegId,
+			&i.ChangeType,
+			&i.ChangedDate,
+			&menuId,
+			&typeId,
+			&typeName,
+			&wartegId,
+			&name,
+			&detail,
+			&picture,
+			&price,
+			&updated,
+		)
+		if err != nil {
+			return
+		}
+
+		if menuId.Valid {
+			i.Menu = &response.MenuDetail{
+				MenuId:       menuId.String,
+				MenuTypeId:   int(typeId.Int64),
+				MenuTypeName: typeName.String,
+				WartegId:     wartegId.String,
+				MenuName:     name.String,
+				MenuDetail:   detail.String,
+				MenuPicture:  picture.String,
+				MenuPrice:    int(price.Int64),
+				UpdatedDate:  updated.Time,
+			}
+		}
+
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const menuExportColumns = `b.menu_id, b.menu_type_id, a.menu_type_name, IFNULL(b.warteg_id, ''), b.menu_name, IFNULL(b.menu_detail, ''), IFNULL(b.menu_picture, ''), b.menu_price, b.updated_date`
+
+// MenuExport streams every menu matching the filters to fn without loading them all in memory
+func (q *Queries) MenuExport(ctx context.Context, filter request.MenuList, fn func(response.MenuExport) error) (err error) {
+	where, args := menuListWhere(filter)
+	exportMenu := fmt.Sprintf("SELECT %s %s %s ORDER BY b.menu_name", menuExportColumns, menuListSource(filter), where)
+
+	rows, err := q.db.QueryContext(ctx, exportMenu, args...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var i response.MenuExport
+		err = rows.Scan(
+			&i.MenuId,
+			&i.MenuTypeId,
+			&i.MenuTypeName,
+			&i.WartegId,
+			&i.MenuName,
+			&i.MenuDetail,
+			&i.MenuPicture,
+			&i.MenuPrice,
+			&i.UpdatedDate,
+		)
+		if err != nil {
+			return
+		}
+
+		err = fn(i)
+		if err != nil {
+			return
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,119 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const getMenuAvailability = `-- name: MenuAvailability :one
+SELECT b.menu_id, IFNULL(DATE_FORMAT(v.business_date, '%Y-%m-%d'), ''), IFNULL(v.is_sold_out, 0), v.stock, v.daily_stock,
+GREATEST(b.updated_date, IFNULL(v.updated_date, b.updated_date))
+FROM tb_menu b LEFT JOIN tb_menu_availability v ON v.menu_id=b.menu_id
+WHERE b.menu_id = ?
+`
+
+func (q *Queries) MenuAvailability(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error) {
+	row := q.db.QueryRowContext(ctx, getMenuAvailability, menu_id)
+	err = row.Scan(
+		&ma.MenuId,
+		&ma.BusinessDate,
+		&ma.IsSoldOut,
+		&ma.Stock,
+		&ma.DailyStock,
+		&ma.UpdatedDate,
+	)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return ma, err
+}
+
+const soldOutMenu = `-- name: SoldOutMenu :exec
+INSERT INTO tb_menu_availability (menu_id, business_date, is_sold_out, stock)
+VALUES (?, ?, 1, NULL)
+ON DUPLICATE KEY UPDATE business_date=VALUES(business_date), is_sold_out=1, stock=IF(stock IS NULL, NULL, 0), updated_date=CURRENT_TIMESTAMP(3)
+`
+
+func (q *Queries) MenuSoldOut(ctx context.Context, menu_id, business_date string) error {
+	_, err := q.db.ExecContext(ctx, soldOutMenu, menu_id, business_date)
+	return err
+}
+
+const restockMenu = `-- name: RestockMenu :exec
+INSERT INTO tb_menu_availability (menu_id, business_date, is_sold_out, stock, daily_stock)
+VALUES (?, ?, 0, ?, ?)
+ON DUPLICATE KEY UPDATE business_date=VALUES(business_date), is_sold_out=0, stock=VALUES(stock),
+daily_stock=IFNULL(VALUES(daily_stock), daily_stock), updated_date=CURRENT_TIMESTAMP(3)
+`
+
+func (q *Queries) MenuRestock(ctx context.Context, menu_id, business_date string, rs request.MenuRestock) error {
+	_, err := q.db.ExecContext(ctx, restockMenu, menu_id, business_date, rs.Stock, rs.DailyStock)
+	return err
+}
+
+// consumeMenu relies on mysql evaluating SET assignments from left to right, is_sold_out sees the decremented stock
+const consumeMenu = `-- name: ConsumeMenu :exec
+UPDATE tb_menu_availability SET stock = stock - ?, is_sold_out = (stock <= 0), updated_date=CURRENT_TIMESTAMP(3)
+WHERE menu_id = ? AND business_date = ? AND is_sold_out = 0 AND stock >= ?
+`
+
+func (q *Queries) MenuConsume(ctx context.Context, menu_id, business_date string, quantity int) error {
+	result, err := q.db.ExecContext(ctx, consumeMenu, quantity, menu_id, business_date, quantity)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrOutOfStock
+	}
+
+	return nil
+}
+
+// resetTimezone keeps menus b whose warteg has opening hours in the given timezone, an empty one keeps menus of
+// wartegs without opening hours
+const resetTimezone = `IFNULL((SELECT s.timezone FROM tb_warteg_schedule s WHERE s.warteg_id = b.warteg_id), '') = ?`
+
+const resetMenuChangeSource = `FROM tb_menu b JOIN tb_menu_availability v ON v.menu_id=b.menu_id
+WHERE v.business_date < ? AND (v.is_sold_out = 1 OR NOT (v.stock <=> v.daily_stock)) AND ` + resetTimezone
+
+const resetBundleChangeSource = `FROM tb_menu b JOIN tb_menu_bundle_item bi ON bi.bundle_menu_id=b.menu_id
+JOIN tb_menu_availability v ON v.menu_id=bi.component_menu_id
+WHERE v.business_date < ? AND (v.is_sold_out = 1 OR NOT (v.stock <=> v.daily_stock)) AND ` + resetTimezone
+
+const resetMenuAvailability = `-- name: ResetMenuAvailability :exec
+UPDATE tb_menu_availability v JOIN tb_menu b ON b.menu_id=v.menu_id
+SET v.business_date = ?, v.is_sold_out = 0, v.stock = v.daily_stock, v.updated_date=CURRENT_TIMESTAMP(3)
+WHERE v.business_date < ? AND ` + resetTimezone + `
+`
+
+// MenuAvailabilityReset starts business_date for menus of wartegs in timezone, empty for wartegs without opening hours
+func (q *Queries) MenuAvailabilityReset(ctx context.Context, business_date, timezone string) (int64, error) {
+	err := q.menuChangesAdd(ctx, constant.MenuUpdated, resetMenuChangeSource, business_date, timezone)
+	if err != nil {
+		return 0, err
+	}
+
+	err = q.menuChangesAdd(ctx, constant.MenuUpdated, resetBundleChangeSource, business_date, timezone)
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := q.db.ExecContext(ctx, resetMenuAvailability, business_date, business_date, timezone)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
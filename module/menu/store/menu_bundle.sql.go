@@ -0,0 +1,102 @@
+package store
+
+import (
+	"context"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// componentSoldOut is true when component cv can not fill quantity of bundle item bi
+const componentSoldOut = `(IFNULL(cv.is_sold_out, 0) = 1 OR IFNULL(cv.stock < bi.quantity, 0))`
+
+// menuSoldOut is sold out status of menu b, a bundle is sold out as soon as one of its components is
+const menuSoldOut = `(IFNULL(v.is_sold_out, 0) = 1 OR EXISTS (
+	SELECT 1 FROM tb_menu_bundle_item bi LEFT JOIN tb_menu_availability cv ON cv.menu_id=bi.component_menu_id
+	WHERE bi.bundle_menu_id=b.menu_id AND ` + componentSoldOut + `
+))`
+
+// menuIsBundle is true when menu b is composed of other menus
+const menuIsBundle = `EXISTS (SELECT 1 FROM tb_menu_bundle_item bi WHERE bi.bundle_menu_id=b.menu_id)`
+
+const getMenuBundleItems = `-- name: MenuBundleItems :many
+SELECT c.menu_id, c.menu_name, c.menu_price, bi.quantity, ` + componentSoldOut + `
+FROM tb_menu_bundle_item bi JOIN tb_menu c ON c.menu_id=bi.component_menu_id
+LEFT JOIN tb_menu_availability cv ON cv.menu_id=c.menu_id
+WHERE bi.bundle_menu_id = ? ORDER BY bi.position
+`
+
+func (q *Queries) MenuBundleItemList(ctx context.Context, menu_id string) (list []response.MenuBundleItem, err error) {
+	rows, err := q.db.QueryContext(ctx, getMenuBundleItems, menu_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.MenuBundleItem{}
+	for rows.Next() {
+		var i response.MenuBundleItem
+		err = rows.Scan(
+			&i.MenuId,
+			&i.MenuName,
+			&i.MenuPrice,
+			&i.Quantity,
+			&i.IsSoldOut,
+		)
+		if err != nil {
+			return
+		}
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const addMenuBundleItem = `-- name: AddMenuBundleItem :exec
+INSERT INTO tb_menu_bundle_item (bundle_menu_id, component_menu_id, quantity, position) VALUES (?, ?, ?, ?)
+`
+
+func (q *Queries) MenuBundleItemAdd(ctx context.Context, menu_id string, position int, item request.MenuBundleItem) error {
+	_, err := q.db.ExecContext(ctx, addMenuBundleItem, menu_id, item.MenuId, item.Quantity, position)
+	return err
+}
+
+const deleteMenuBundleItems = `-- name: DeleteMenuBundleItems :exec
+DELETE FROM tb_menu_bundle_item WHERE bundle_menu_id = ?
+`
+
+func (q *Queries) MenuBundleItemDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuBundleItems, menu_id)
+	return err
+}
+
+const countMenuBundleUsage = `-- name: CountMenuBundleUsage :one
+SELECT COUNT(*) FROM tb_menu_bundle_item WHERE component_menu_id = ?
+`
+
+// MenuBundleUsage returns number of bundles that contain the menu
+func (q *Queries) MenuBundleUsage(ctx context.Context, menu_id string) (count int, err error) {
+	err = q.db.QueryRowContext(ctx, countMenuBundleUsage, menu_id).Scan(&count)
+	return
+}
+
+const touchMenuBundles = `-- name: TouchMenuBundles :exec
+UPDATE tb_menu b JOIN tb_menu_bundle_item bi ON bi.bundle_menu_id=b.menu_id
+SET b.updated_date=CURRENT_TIMESTAMP(3) WHERE bi.component_menu_id = ?
+`
+
+const menuBundleChangeSource = `FROM tb_menu b JOIN tb_menu_bundle_item bi ON bi.bundle_menu_id=b.menu_id
+WHERE bi.component_menu_id = ?`
+
+// MenuBundleTouch bumps every bundle containing the component and records them in the change log,
+// price and sold out status of a bundle follow its components
+func (q *Queries) MenuBundleTouch(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, touchMenuBundles, menu_id)
+	if err != nil {
+		return err
+	}
+
+	return q.menuChangesAdd(ctx, constant.MenuUpdated, menuBundleChangeSource, menu_id)
+}
@@ -0,0 +1,146 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const addMenuImage = `-- name: AddMenuImage :exec
+INSERT INTO tb_menu_image (
+	image_id,
+	menu_id,
+	position,
+	original_key,
+	thumbnail_key,
+	webp_key,
+	thumbnail_webp_key,
+	content_type,
+	file_size,
+	width,
+	height
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+func (q *Queries) MenuImageAdd(ctx context.Context, img request.MenuImage) error {
+	_, err := q.db.ExecContext(ctx, addMenuImage,
+		img.ImageId,
+		img.MenuId,
+		img.Position,
+		img.OriginalKey,
+		img.ThumbnailKey,
+		img.WebpKey,
+		img.ThumbnailWebpKey,
+		img.ContentType,
+		img.FileSize,
+		img.Width,
+		img.Height,
+	)
+	return err
+}
+
+const menuImageColumns = `image_id, menu_id, position, original_key, thumbnail_key, webp_key, thumbnail_webp_key, content_type, file_size, width, height, created_date`
+
+const getMenuImages = `-- name: MenuImages :many
+SELECT ` + menuImageColumns + ` FROM tb_menu_image WHERE menu_id = ? ORDER BY position, created_date
+`
+
+func (q *Queries) MenuImageList(ctx context.Context, menu_id string) (list []response.MenuImage, err error) {
+	rows, err := q.db.QueryContext(ctx, getMenuImages, menu_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.MenuImage{}
+	for rows.Next() {
+		var i response.MenuImage
+		err = scanMenuImage(rows, &i)
+		if err != nil {
+			return
+		}
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const getMenuImage = `-- name: MenuImage :one
+SELECT ` + menuImageColumns + ` FROM tb_menu_image WHERE menu_id = ? AND image_id = ?
+`
+
+func (q *Queries) MenuImageDetail(ctx context.Context, menu_id, image_id string) (img response.MenuImage, err error) {
+	row := q.db.QueryRowContext(ctx, getMenuImage, menu_id, image_id)
+	err = scanMenuImage(row, &img)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return img, err
+}
+
+const deleteMenuImage = `-- name: DeleteMenuImage :exec
+DELETE FROM tb_menu_image WHERE menu_id = ? AND image_id = ?
+`
+
+func (q *Queries) MenuImageDelete(ctx context.Context, menu_id, image_id string) error {
+	result, err := q.db.ExecContext(ctx, deleteMenuImage, menu_id, image_id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const deleteMenuImages = `-- name: DeleteMenuImages :exec
+DELETE FROM tb_menu_image WHERE menu_id = ?
+`
+
+func (q *Queries) MenuImageDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuImages, menu_id)
+	return err
+}
+
+const updateMenuImagePosition = `-- name: UpdateMenuImagePosition :exec
+UPDATE tb_menu_image SET position = ? WHERE menu_id = ? AND image_id = ?
+`
+
+func (q *Queries) MenuImagePosition(ctx context.Context, menu_id, image_id string, position int) error {
+	_, err := q.db.ExecContext(ctx, updateMenuImagePosition, position, menu_id, image_id)
+	return err
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanMenuImage(row scanner, i *response.MenuImage) error {
+	return row.Scan(
+		&i.ImageId,
+		&i.MenuId,
+		&i.Position,
+		&i.OriginalKey,
+		&i.ThumbnailKey,
+		&i.WebpKey,
+		&i.ThumbnailWebpKey,
+		&i.ContentType,
+		&i.FileSize,
+		&i.Width,
+		&i.Height,
+		&i.CreatedDate,
+	)
+}
@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const addModifierGroup = `-- name: AddModifierGroup :exec
+INSERT INTO tb_modifier_group (
+	group_id,
+	warteg_id,
+	group_name,
+	min_select,
+	max_select,
+	is_required
+) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+func (q *Queries) ModifierGroupAdd(ctx context.Context, mg request.ModifierGroup) error {
+	_, err := q.db.ExecContext(ctx, addModifierGroup,
+		mg.GroupId,
+		mg.WartegId,
+		mg.GroupName,
+		mg.MinSelect,
+		mg.MaxSelect,
+		mg.IsRequired,
+	)
+	return err
+}
+
+const updateModifierGroup = `-- name: UpdateModifierGroup :exec
+UPDATE tb_modifier_group SET warteg_id=?, group_name=?, min_select=?, max_select=?, is_required=?, updated_date=CURRENT_TIMESTAMP(3)
+WHERE group_id = ?
+`
+
+func (q *Queries) ModifierGroupUpdate(ctx context.Context, mg request.ModifierGroup) error {
+	_, err := q.db.ExecContext(ctx, updateModifierGroup,
+		mg.WartegId,
+		mg.GroupName,
+		mg.MinSelect,
+		mg.MaxSelect,
+		mg.IsRequired,
+		mg.GroupId,
+	)
+	return err
+}
+
+const deleteModifierGroup = `-- name: DeleteModifierGroup :exec
+DELETE FROM tb_modifier_group WHERE group_id = ?
+`
+
+func (q *Queries) ModifierGroupDelete(ctx context.Context, group_id string) error {
+	result, err := q.db.ExecContext(ctx, deleteModifierGroup, group_id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const addModifierOption = `-- name: AddModifierOption :exec
+INSERT INTO tb_modifier_option (option_id, group_id, option_name, option_price, position) VALUES (?, ?, ?, ?, ?)
+`
+
+func (q *Queries) ModifierOptionAdd(ctx context.Context, group_id string, position int, mo request.ModifierOption) error {
+	_, err := q.db.ExecContext(ctx, addModifierOption, mo.OptionId, group_id, mo.OptionName, mo.OptionPrice, position)
+	return err
+}
+
+const deleteModifierOptions = `-- name: DeleteModifierOptions :exec
+DELETE FROM tb_modifier_option WHERE group_id = ?
+`
+
+func (q *Queries) ModifierOptionDeleteByGroup(ctx context.Context, group_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteModifierOptions, group_id)
+	return err
+}
+
+const modifierGroupColumns = `g.group_id, g.warteg_id, g.group_name, g.min_select, g.max_select, g.is_required, g.updated_date,
+o.option_id, o.option_name, o.option_price`
+
+const getModifierGroups = `-- name: ModifierGroups :many
+SELECT ` + modifierGroupColumns + `
+FROM tb_modifier_group g LEFT JOIN tb_modifier_option o ON o.group_id=g.group_id
+WHERE g.warteg_id like ? ORDER BY g.group_name, g.group_id, o.position
+`
+
+func (q *Queries) ModifierGroupList(ctx context.Context, warteg_id string) (list []response.ModifierGroup, err error) {
+	return q.modifierGroups(ctx, getModifierGroups, "%"+warteg_id+"%")
+}
+
+const getModifierGroup = `-- name: ModifierGroup :many
+SELECT ` + modifierGroupColumns + `
+FROM tb_modifier_group g LEFT JOIN tb_modifier_option o ON o.group_id=g.group_id
+WHERE g.group_id = ? ORDER BY o.position
+`
+
+func (q *Queries) ModifierGroupDetail(ctx context.Context, group_id string) (mg response.ModifierGroup, err error) {
+	list, err := q.modifierGroups(ctx, getModifierGroup, group_id)
+	if err != nil {
+		return
+	}
+
+	if len(list) == 0 {
+		return mg, constant.ErrNotFound
+	}
+
+	return list[0], nil
+}
+
+const getMenuModifierGroups = `-- name: MenuModifierGroups :many
+SELECT ` + modifierGroupColumns + `
+FROM tb_menu_modifier_group l JOIN tb_modifier_group g ON g.group_id=l.group_id
+LEFT JOIN tb_modifier_option o ON o.group_id=g.group_id
+WHERE l.menu_id = ? ORDER BY l.position, g.group_id, o.position
+`
+
+func (q *Queries) MenuModifierList(ctx context.Context, menu_id string) (list []response.ModifierGroup, err error) {
+	return q.modifierGroups(ctx, getMenuModifierGroups, menu_id)
+}
+
+// nullModifierOption holds option columns of a left join, they are null for groups without option
+type nullModifierOption struct {
+	OptionId    sql.NullString
+	OptionName  sql.NullString
+	OptionPrice sql.NullInt64
+}
+
+// modifierGroups runs a group query joined with options and folds the rows into groups, keeping row order
+func (q *Queries) modifierGroups(ctx context.Context, query string, args ...interface{}) (list []response.ModifierGroup, err error) {
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.ModifierGroup{}
+	for rows.Next() {
+		var g response.ModifierGroup
+		var o nullModifierOption
+
+		err = rows.Scan(
+			&g.GroupId,
+			&g.WartegId,
+			&g.GroupName,
+			&g.MinSelect,
+			&g.MaxSelect,
+			&g.IsRequired,
+			&g.UpdatedDate,
+			&o.OptionId,
+			&o.OptionName,
+			&o.OptionPrice,
+		)
+		if err != nil {
+			return
+		}
+
+		if len(list) == 0 || list[len(list)-1].GroupId != g.GroupId {
+			g.Options = []response.ModifierOption{}
+			list = append(list, g)
+		}
+
+		if o.OptionId.Valid {
+			last := &list[len(list)-1]
+			last.Options = append(last.Options, response.ModifierOption{
+				OptionId:    o.OptionId.String,
+				OptionName:  o.OptionName.String,
+				OptionPrice: int(o.OptionPrice.Int64),
+			})
+		}
+	}
+
+	return list, rows.Err()
+}
+
+const addMenuModifierGroup = `-- name: AddMenuModifierGroup :exec
+INSERT INTO tb_menu_modifier_group (menu_id, group_id, position) VALUES (?, ?, ?)
+`
+
+func (q *Queries) MenuModifierAdd(ctx context.Context, menu_id, group_id string, position int) error {
+	_, err := q.db.ExecContext(ctx, addMenuModifierGroup, menu_id, group_id, position)
+	return err
+}
+
+const deleteMenuModifierGroups = `-- name: DeleteMenuModifierGroups :exec
+DELETE FROM tb_menu_modifier_group WHERE menu_id = ?
+`
+
+func (q *Queries) MenuModifierDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuModifierGroups, menu_id)
+	return err
+}
+
+const deleteModifierGroupLinks = `-- name: DeleteModifierGroupLinks :exec
+DELETE FROM tb_menu_modifier_group WHERE group_id = ?
+`
+
+func (q *Queries) MenuModifierDeleteByGroup(ctx context.Context, group_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteModifierGroupLinks, group_id)
+	return err
+}
+
+const touchModifierGroupMenus = `-- name: TouchModifierGroupMenus :exec
+UPDATE tb_menu b JOIN tb_menu_modifier_group l ON l.menu_id=b.menu_id
+SET b.updated_date=CURRENT_TIMESTAMP(3) WHERE l.group_id = ?
+`
+
+const modifierGroupChangeSource = `FROM tb_menu b JOIN tb_menu_modifier_group l ON l.menu_id=b.menu_id
+WHERE l.group_id = ?`
+
+// ModifierGroupTouchMenus bumps every menu linked to the group and records them in the change log
+func (q *Queries) ModifierGroupTouchMenus(ctx context.Context, group_id string) error {
+	_, err := q.db.ExecContext(ctx, touchModifierGroupMenus, group_id)
+	if err != nil {
+		return err
+	}
+
+	return q.menuChangesAdd(ctx, constant.MenuUpdated, modifierGroupChangeSource, group_id)
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
+	"github.com/cpartogi/foodmenu/constant"
 	"github.com/cpartogi/foodmenu/module/menu"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
 )
 
 // SQLStore provides all functions to execute db queries and transactions.
@@ -18,12 +22,16 @@ type SQLStore struct {
 func NewStore(db *sql.DB) menu.Repository {
 	return &SQLStore{
 		db:      db,
-		Queries: New(db),
+		Queries: New(txDB{db}),
 	}
 }
 
-// execTX executes a function within a database transaction
+// execTX executes a function within a database transaction, it joins the transaction of ctx when there is one
 func (s *SQLStore) execTX(ctx context.Context, fn func(*Queries) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(New(tx))
+	}
+
 	tx, err := s.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -40,3 +48,821 @@ func (s *SQLStore) execTX(ctx context.Context, fn func(*Queries) error) error {
 
 	return tx.Commit()
 }
+
+// Transaction runs fn within one database transaction, every repository call made with the ctx given to fn joins it.
+// A transaction already in ctx is joined
+func (s *SQLStore) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	err = fn(context.WithValue(ctx, txKey{}, tx))
+	if err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return fmt.Errorf("tx err: %v, rb err: %v", err, rbErr)
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MenuAdd inserts menu and records it in the change log and price history within one transaction, a menu added as
+// draft gets its status in the same transaction so it is never shown to customers
+func (s *SQLStore) MenuAdd(ctx context.Context, addm request.Menu) (mn response.MenuAdd, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		var txErr error
+		mn, txErr = q.MenuAdd(ctx, addm)
+		if txErr != nil {
+			return txErr
+		}
+		if addm.Status != "" && addm.Status != constant.MenuStatusPublished {
+			txErr = q.MenuStatusSet(ctx, request.MenuStatus{MenuId: mn.MenuId, Status: addm.Status, PublishAt: addm.PublishAt})
+			if txErr != nil {
+				return txErr
+			}
+		}
+		txErr = q.MenuPriceHistoryAdd(ctx, mn.MenuId, nil, mn.MenuPrice, nil)
+		if txErr != nil {
+			return txErr
+		}
+		return q.MenuChangeAdd(ctx, mn.MenuId, constant.MenuCreated)
+	})
+
+	return mn, err
+}
+
+// MenuUpdate updates menu and records it with bundles containing it in the change log within one transaction,
+// a new price is also recorded in the price history. The price of a menu having a pending price change is kept
+// until the change is reviewed
+func (s *SQLStore) MenuUpdate(ctx context.Context, menu_id string, upm request.MenuUpdate) (mu response.MenuUpdate, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		oldPrice, txErr := q.MenuPriceLock(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		if oldPrice != upm.MenuPrice {
+			txErr = q.priceChangePending(ctx, menu_id)
+			if txErr != nil {
+				return txErr
+			}
+		}
+		mu, txErr = q.MenuUpdate(ctx, menu_id, upm)
+		if txErr != nil {
+			return txErr
+		}
+		if oldPrice != upm.MenuPrice {
+			txErr = q.MenuPriceHistoryAdd(ctx, menu_id, &oldPrice, upm.MenuPrice, nil)
+			if txErr != nil {
+				return txErr
+			}
+		}
+		txErr = q.MenuBundleTouch(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+
+	return mu, err
+}
+
+// MenuDelete records a tombstone in the change log and deletes menu with its images, variants, modifier links, bundle items,
+// serving windows, status, price changes and template link within one transaction, a menu that is still a component of a bundle is kept
+func (s *SQLStore) MenuDelete(ctx context.Context, menu_id string) (md response.MenuDelete, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		usage, txErr := q.MenuBundleUsage(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		if usage > 0 {
+			return constant.ErrMenuInBundle
+		}
+		txErr = q.MenuChangeAdd(ctx, menu_id, constant.MenuDeleted)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuImageDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuVariantDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuModifierDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuBundleItemDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuPriceScheduleDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuPriceHistoryDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuPriceChangeDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuTemplateDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuWindowDeleteByMenu(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuStatusDelete(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		md, txErr = q.MenuDelete(ctx, menu_id)
+		return txErr
+	})
+
+	return md, err
+}
+
+// MenuImport inserts all menus within one transaction, nothing is saved when one of them fails
+func (s *SQLStore) MenuImport(ctx context.Context, menus []request.Menu) (added []response.MenuAdd, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		added = make([]response.MenuAdd, 0, len(menus))
+		for _, m := range menus {
+			mn, txErr := q.MenuAdd(ctx, m)
+			if txErr != nil {
+				return txErr
+			}
+
+			txErr = q.MenuPriceHistoryAdd(ctx, mn.MenuId, nil, mn.MenuPrice, nil)
+			if txErr != nil {
+				return txErr
+			}
+
+			txErr = q.MenuChangeAdd(ctx, mn.MenuId, constant.MenuCreated)
+			if txErr != nil {
+				return txErr
+			}
+
+			added = append(added, mn)
+		}
+		return nil
+	})
+
+	return added, err
+}
+
+// MenuImageOrder updates gallery position of every image within one transaction
+func (s *SQLStore) MenuImageOrder(ctx context.Context, menu_id string, image_ids []string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		for position, imageId := range image_ids {
+			err := q.MenuImagePosition(ctx, menu_id, imageId, position)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MenuSoldOut marks menu sold out and records it with bundles containing it in the change log within one transaction
+func (s *SQLStore) MenuSoldOut(ctx context.Context, menu_id, business_date string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuSoldOut(ctx, menu_id, business_date)
+		if err != nil {
+			return err
+		}
+		err = q.MenuBundleTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
+
+// MenuRestock marks menu available again and records it with bundles containing it in the change log within one transaction
+func (s *SQLStore) MenuRestock(ctx context.Context, menu_id, business_date string, rs request.MenuRestock) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuRestock(ctx, menu_id, business_date, rs)
+		if err != nil {
+			return err
+		}
+		err = q.MenuBundleTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
+
+// MenuConsume takes portions from today's stock and records it with bundles containing it in the change log within one transaction
+func (s *SQLStore) MenuConsume(ctx context.Context, menu_id, business_date string, quantity int) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuConsume(ctx, menu_id, business_date, quantity)
+		if err != nil {
+			return err
+		}
+		err = q.MenuBundleTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
+
+// MenuAvailabilityReset restores availability of previous business days of menus of wartegs in timezone within one
+// transaction
+func (s *SQLStore) MenuAvailabilityReset(ctx context.Context, business_date, timezone string) (reset int64, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		var txErr error
+		reset, txErr = q.MenuAvailabilityReset(ctx, business_date, timezone)
+		return txErr
+	})
+
+	return reset, err
+}
+
+// MenuVariantAdd inserts variant, keeps a single default variant and records the menu update within one transaction
+func (s *SQLStore) MenuVariantAdd(ctx context.Context, mv request.MenuVariant) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuVariantAdd(ctx, mv)
+		if err != nil {
+			return err
+		}
+		return menuVariantChanged(ctx, q, mv)
+	})
+}
+
+// MenuVariantUpdate updates variant, keeps a single default variant and records the menu update within one transaction
+func (s *SQLStore) MenuVariantUpdate(ctx context.Context, mv request.MenuVariant) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuVariantUpdate(ctx, mv)
+		if err != nil {
+			return err
+		}
+		return menuVariantChanged(ctx, q, mv)
+	})
+}
+
+// MenuVariantDelete deletes variant and records the menu update within one transaction
+func (s *SQLStore) MenuVariantDelete(ctx context.Context, menu_id, variant_id string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuVariantDelete(ctx, menu_id, variant_id)
+		if err != nil {
+			return err
+		}
+		err = q.MenuTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
+
+func menuVariantChanged(ctx context.Context, q *Queries, mv request.MenuVariant) error {
+	if mv.IsDefault {
+		err := q.MenuVariantClearDefault(ctx, mv.MenuId, mv.VariantId)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := q.MenuTouch(ctx, mv.MenuId)
+	if err != nil {
+		return err
+	}
+
+	return q.MenuChangeAdd(ctx, mv.MenuId, constant.MenuUpdated)
+}
+
+// ModifierGroupAdd inserts modifier group with its options within one transaction
+func (s *SQLStore) ModifierGroupAdd(ctx context.Context, mg request.ModifierGroup) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.ModifierGroupAdd(ctx, mg)
+		if err != nil {
+			return err
+		}
+		return modifierOptionsAdd(ctx, q, mg)
+	})
+}
+
+// ModifierGroupUpdate updates modifier group, replaces its options and records every linked menu in the change log within one transaction
+func (s *SQLStore) ModifierGroupUpdate(ctx context.Context, mg request.ModifierGroup) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.ModifierGroupUpdate(ctx, mg)
+		if err != nil {
+			return err
+		}
+		err = q.ModifierOptionDeleteByGroup(ctx, mg.GroupId)
+		if err != nil {
+			return err
+		}
+		err = modifierOptionsAdd(ctx, q, mg)
+		if err != nil {
+			return err
+		}
+		return q.ModifierGroupTouchMenus(ctx, mg.GroupId)
+	})
+}
+
+// ModifierGroupDelete records every linked menu in the change log and deletes modifier group with its options and links within one transaction
+func (s *SQLStore) ModifierGroupDelete(ctx context.Context, group_id string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.ModifierGroupTouchMenus(ctx, group_id)
+		if err != nil {
+			return err
+		}
+		err = q.MenuModifierDeleteByGroup(ctx, group_id)
+		if err != nil {
+			return err
+		}
+		err = q.ModifierOptionDeleteByGroup(ctx, group_id)
+		if err != nil {
+			return err
+		}
+		return q.ModifierGroupDelete(ctx, group_id)
+	})
+}
+
+// MenuModifierSet replaces modifier groups linked to menu and records the menu update within one transaction
+func (s *SQLStore) MenuModifierSet(ctx context.Context, menu_id string, group_ids []string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuModifierDeleteByMenu(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		for position, groupId := range group_ids {
+			err = q.MenuModifierAdd(ctx, menu_id, groupId, position)
+			if err != nil {
+				return err
+			}
+		}
+		err = q.MenuTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
+
+func modifierOptionsAdd(ctx context.Context, q *Queries, mg request.ModifierGroup) error {
+	for position, mo := range mg.Options {
+		err := q.ModifierOptionAdd(ctx, mg.GroupId, position, mo)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MenuBundleSet replaces components of bundle and records the menu update within one transaction
+func (s *SQLStore) MenuBundleSet(ctx context.Context, menu_id string, items []request.MenuBundleItem) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuBundleItemDeleteByMenu(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		for position, item := range items {
+			err = q.MenuBundleItemAdd(ctx, menu_id, position, item)
+			if err != nil {
+				return err
+			}
+		}
+		err = q.MenuTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
+
+// PromotionAdd inserts promotion and records every menu it covers in the change log within one transaction
+func (s *SQLStore) PromotionAdd(ctx context.Context, p request.Promotion) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.PromotionAdd(ctx, p)
+		if err != nil {
+			return err
+		}
+		return promotionChanged(ctx, q, p.PromotionId)
+	})
+}
+
+// PromotionUpdate updates promotion and records menus of its old and new scope in the change log within one transaction
+func (s *SQLStore) PromotionUpdate(ctx context.Context, p request.Promotion) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := promotionChanged(ctx, q, p.PromotionId)
+		if err != nil {
+			return err
+		}
+		err = q.PromotionUpdate(ctx, p)
+		if err != nil {
+			return err
+		}
+		return promotionChanged(ctx, q, p.PromotionId)
+	})
+}
+
+// PromotionDelete records every menu covered by promotion in the change log and deletes it within one transaction
+func (s *SQLStore) PromotionDelete(ctx context.Context, promotion_id string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := promotionChanged(ctx, q, promotion_id)
+		if err != nil {
+			return err
+		}
+		return q.PromotionDelete(ctx, promotion_id)
+	})
+}
+
+// PromotionBoundary records menus covered by promotion in the change log once for a start or end of its run, the
+// effective price of those menus changed without a write. False when another instance recorded it already
+func (s *SQLStore) PromotionBoundary(ctx context.Context, p response.Promotion, boundary time.Time) (touched bool, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		var txErr error
+		touched, txErr = q.PromotionBoundarySet(ctx, p.PromotionId, boundary)
+		if txErr != nil || !touched {
+			return txErr
+		}
+		return q.PromotionTouchMenus(ctx, p)
+	})
+
+	return touched, err
+}
+
+func promotionChanged(ctx context.Context, q *Queries, promotion_id string) error {
+	p, err := q.PromotionDetail(ctx, promotion_id)
+	if err != nil {
+		return err
+	}
+	return q.PromotionTouchMenus(ctx, p)
+}
+
+// MenuPriceScheduleApply sets scheduled price as menu price, records it in the price history as a change made by the
+// actor of ctx and records the menu with bundles containing it in the change log within one transaction, a menu
+// having a pending price change is left for a later run
+func (s *SQLStore) MenuPriceScheduleApply(ctx context.Context, ps response.MenuPriceSchedule) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		oldPrice, err := q.MenuPriceLock(ctx, ps.MenuId)
+		if err != nil {
+			return err
+		}
+		err = q.priceChangePending(ctx, ps.MenuId)
+		if err != nil {
+			return err
+		}
+		err = q.MenuPriceScheduleStatus(ctx, ps.MenuId, ps.ScheduleId, constant.PriceScheduleApplied)
+		if err != nil {
+			return err
+		}
+		err = q.MenuPriceSet(ctx, ps.MenuId, ps.MenuPrice)
+		if err != nil {
+			return err
+		}
+		err = q.MenuPriceHistoryAdd(ctx, ps.MenuId, &oldPrice, ps.MenuPrice, &ps.ScheduleId)
+		if err != nil {
+			return err
+		}
+		err = q.MenuBundleTouch(ctx, ps.MenuId)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, ps.MenuId, constant.MenuUpdated)
+	})
+}
+
+// MenuPriceChangeRequest updates menu keeping its current price, saves the requested price as a change waiting for
+// approval and writes its requested event within one transaction, a menu has at most one pending price change
+func (s *SQLStore) MenuPriceChangeRequest(ctx context.Context, change_id, menu_id string, upm request.MenuUpdate) (mu response.MenuUpdate, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		oldPrice, txErr := q.MenuPriceLock(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.priceChangePending(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		newPrice := upm.MenuPrice
+		upm.MenuPrice = oldPrice
+		mu, txErr = q.MenuUpdate(ctx, menu_id, upm)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuPriceChangeAdd(ctx, change_id, menu_id, oldPrice, newPrice)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuPriceChangeEventAdd(ctx, change_id, constant.PriceChangeRequestedEvent)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.MenuBundleTouch(ctx, menu_id)
+		if txErr != nil {
+			return txErr
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+
+	return mu, err
+}
+
+// MenuPriceChangeApprove sets the requested price as menu price, records it in the price history as a change made
+// by the reviewing actor of ctx, applies the schedule which requested it if any, writes the approved event and records the menu with bundles containing it in the
+// change log within one transaction, a change requested from another price than the current one is a conflict
+func (s *SQLStore) MenuPriceChangeApprove(ctx context.Context, pc response.MenuPriceChange, note string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		oldPrice, err := q.MenuPriceLock(ctx, pc.MenuId)
+		if err != nil {
+			return err
+		}
+		if oldPrice != pc.OldPrice {
+			return constant.ErrConflict
+		}
+		err = q.MenuPriceChangeReview(ctx, pc.ChangeId, constant.PriceChangeApproved, note)
+		if err != nil {
+			return err
+		}
+		var scheduleId *string
+		ps, err := q.MenuPriceScheduleByChange(ctx, pc.ChangeId)
+		if err == nil {
+			scheduleId = &ps.ScheduleId
+		} else if err != constant.ErrNotFound {
+			return err
+		}
+		err = q.MenuPriceScheduleReview(ctx, pc.ChangeId, constant.PriceScheduleApplied)
+		if err != nil {
+			return err
+		}
+		err = q.MenuPriceSet(ctx, pc.MenuId, pc.NewPrice)
+		if err != nil {
+			return err
+		}
+		err = q.MenuPriceHistoryAdd(ctx, pc.MenuId, &oldPrice, pc.NewPrice, scheduleId)
+		if err != nil {
+			return err
+		}
+		err = q.MenuPriceChangeEventAdd(ctx, pc.ChangeId, constant.PriceChangeApprovedEvent)
+		if err != nil {
+			return err
+		}
+		err = q.MenuBundleTouch(ctx, pc.MenuId)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, pc.MenuId, constant.MenuUpdated)
+	})
+}
+
+// MenuPriceChangeReject rejects a pending price change as reviewed by the actor of ctx with the schedule which
+// requested it if any and writes the rejected event within one transaction
+func (s *SQLStore) MenuPriceChangeReject(ctx context.Context, change_id, note string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuPriceChangeReview(ctx, change_id, constant.PriceChangeRejected, note)
+		if err != nil {
+			return err
+		}
+		err = q.MenuPriceScheduleReview(ctx, change_id, constant.PriceScheduleRejected)
+		if err != nil {
+			return err
+		}
+		return q.MenuPriceChangeEventAdd(ctx, change_id, constant.PriceChangeRejectedEvent)
+	})
+}
+
+// BrandMenuAdd locks brand and saves a menu template of it within one transaction, the brand stays locked until a
+// transaction of ctx ends
+func (s *SQLStore) BrandMenuAdd(ctx context.Context, req request.BrandMenu) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.BrandLock(ctx, req.BrandId)
+		if err != nil {
+			return err
+		}
+		return q.BrandMenuAdd(ctx, req)
+	})
+}
+
+// BrandMenuUpdate locks brand and updates a menu template of it within one transaction, the brand stays locked until
+// a transaction of ctx ends
+func (s *SQLStore) BrandMenuUpdate(ctx context.Context, req request.BrandMenu) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.BrandLock(ctx, req.BrandId)
+		if err != nil {
+			return err
+		}
+		return q.BrandMenuUpdate(ctx, req)
+	})
+}
+
+// BrandMenuDelete deletes a menu template of brand and archives the branch menus created from it within one
+// transaction, the archived menus stay with their branch
+func (s *SQLStore) BrandMenuDelete(ctx context.Context, brand_id, template_id string) (archived []string, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		txErr := q.BrandLock(ctx, brand_id)
+		if txErr != nil {
+			return txErr
+		}
+		txErr = q.BrandMenuDelete(ctx, brand_id, template_id)
+		if txErr != nil {
+			return txErr
+		}
+		archived, txErr = q.MenuTemplateMenus(ctx, template_id)
+		if txErr != nil {
+			return txErr
+		}
+		for _, menuId := range archived {
+			txErr = q.MenuStatusSet(ctx, request.MenuStatus{MenuId: menuId, Status: constant.MenuStatusArchived})
+			if txErr != nil {
+				return txErr
+			}
+			txErr = q.MenuTouch(ctx, menuId)
+			if txErr != nil {
+				return txErr
+			}
+			txErr = q.MenuChangeAdd(ctx, menuId, constant.MenuUpdated)
+			if txErr != nil {
+				return txErr
+			}
+		}
+		return q.MenuTemplateDeleteByTemplate(ctx, template_id)
+	})
+
+	return archived, err
+}
+
+// BrandWartegSet locks brand, assigns warteg to it and unlinks the menus of warteg from templates of a previous brand
+// within one transaction, the brand stays locked until a transaction of ctx ends
+func (s *SQLStore) BrandWartegSet(ctx context.Context, req request.BrandWarteg) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.BrandLock(ctx, req.BrandId)
+		if err != nil {
+			return err
+		}
+		err = q.BrandWartegSet(ctx, req)
+		if err != nil {
+			return err
+		}
+		return q.MenuTemplateDeleteByWarteg(ctx, req.WartegId, req.BrandId)
+	})
+}
+
+// BrandWartegDelete removes warteg from its brand and unlinks its menus from the templates within one transaction,
+// the menus stay as menus of the warteg
+func (s *SQLStore) BrandWartegDelete(ctx context.Context, warteg_id string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		bw, err := q.BrandWarteg(ctx, warteg_id)
+		if err != nil {
+			return err
+		}
+		err = q.BrandLock(ctx, bw.BrandId)
+		if err != nil {
+			return err
+		}
+		err = q.BrandWartegDelete(ctx, warteg_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuTemplateDeleteByWarteg(ctx, warteg_id, "")
+	})
+}
+
+// WebhookDelete deletes webhook with its delivery logs within one transaction
+func (s *SQLStore) WebhookDelete(ctx context.Context, webhook_id string) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.WebhookDeliveryDeleteByWebhook(ctx, webhook_id)
+		if err != nil {
+			return err
+		}
+		return q.WebhookDelete(ctx, webhook_id)
+	})
+}
+
+// OutboxClaim leases events due for publishing until lease_until within one short transaction, relays of several
+// instances claim different events and publish them after the claim is committed so no lock is held meanwhile
+func (s *SQLStore) OutboxClaim(ctx context.Context, lease_until time.Time, limit int) (list []response.OutboxEvent, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		var txErr error
+		list, txErr = q.OutboxPending(ctx, limit)
+		if txErr != nil {
+			return txErr
+		}
+
+		ids := make([]int64, len(list))
+		for i, o := range list {
+			ids[i] = o.OutboxId
+		}
+		return q.OutboxLease(ctx, ids, lease_until)
+	})
+
+	return list, err
+}
+
+// WebhookDeliveryClaim leases pending deliveries of active webhooks due at now until lease_until within one short
+// transaction, senders of several instances claim different deliveries and post them after the claim is committed
+func (s *SQLStore) WebhookDeliveryClaim(ctx context.Context, now, lease_until time.Time, limit int) (list []response.WebhookDelivery, err error) {
+	err = s.execTX(ctx, func(q *Queries) error {
+		var txErr error
+		list, txErr = q.WebhookDeliveryDue(ctx, now, limit)
+		if txErr != nil {
+			return txErr
+		}
+
+		ids := make([]int64, len(list))
+		for i, d := range list {
+			ids[i] = d.DeliveryId
+		}
+		return q.WebhookDeliveryLease(ctx, ids, lease_until)
+	})
+
+	return list, err
+}
+
+// WartegHoursSet stores timezone and replaces opening hours and closure dates of a warteg within one transaction
+func (s *SQLStore) WartegHoursSet(ctx context.Context, req request.WartegHours) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.WartegScheduleSet(ctx, req.WartegId, req.Timezone)
+		if err != nil {
+			return err
+		}
+		err = q.WartegHoursDelete(ctx, req.WartegId)
+		if err != nil {
+			return err
+		}
+		for _, h := range req.Hours {
+			err = q.WartegHourAdd(ctx, req.WartegId, h)
+			if err != nil {
+				return err
+			}
+		}
+		err = q.WartegClosureDelete(ctx, req.WartegId)
+		if err != nil {
+			return err
+		}
+		for _, c := range req.Closures {
+			err = q.WartegClosureAdd(ctx, req.WartegId, c)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// MenuWindowSet replaces serving windows of menu and records the menu update within one transaction
+func (s *SQLStore) MenuWindowSet(ctx context.Context, menu_id string, windows []request.MenuWindow) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuWindowDeleteByMenu(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		for position, w := range windows {
+			err = q.MenuWindowAdd(ctx, menu_id, position, w)
+			if err != nil {
+				return err
+			}
+		}
+		err = q.MenuTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
+
+// MenuStatusSet stores publishing status of menu and records the menu update within one transaction
+func (s *SQLStore) MenuStatusSet(ctx context.Context, ms request.MenuStatus) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuStatusSet(ctx, ms)
+		if err != nil {
+			return err
+		}
+		err = q.MenuTouch(ctx, ms.MenuId)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, ms.MenuId, constant.MenuUpdated)
+	})
+}
+
+// MenuPublish publishes a due draft and records the menu update within one transaction
+func (s *SQLStore) MenuPublish(ctx context.Context, menu_id string, now time.Time) error {
+	return s.execTX(ctx, func(q *Queries) error {
+		err := q.MenuPublish(ctx, menu_id, now)
+		if err != nil {
+			return err
+		}
+		err = q.MenuTouch(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		return q.MenuChangeAdd(ctx, menu_id, constant.MenuUpdated)
+	})
+}
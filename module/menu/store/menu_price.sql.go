@@ -0,0 +1,312 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const lockMenuPrice = `-- name: LockMenuPrice :one
+SELECT menu_price FROM tb_menu WHERE menu_id = ? FOR UPDATE
+`
+
+// MenuPriceLock returns current price of menu and locks the menu row until the transaction ends
+func (q *Queries) MenuPriceLock(ctx context.Context, menu_id string) (price int, err error) {
+	err = q.db.QueryRowContext(ctx, lockMenuPrice, menu_id).Scan(&price)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return price, err
+}
+
+const setMenuPrice = `-- name: SetMenuPrice :exec
+UPDATE tb_menu SET menu_price=?, updated_date=CURRENT_TIMESTAMP(3) WHERE menu_id = ?
+`
+
+func (q *Queries) MenuPriceSet(ctx context.Context, menu_id string, price int) error {
+	_, err := q.db.ExecContext(ctx, setMenuPrice, price, menu_id)
+	return err
+}
+
+const addMenuPriceHistory = `-- name: AddMenuPriceHistory :exec
+INSERT INTO tb_menu_price_history (menu_id, old_price, new_price, schedule_id, changed_by) VALUES (?, ?, ?, ?, ?)
+`
+
+// MenuPriceHistoryAdd records a price change made by the actor of ctx, old price is nil for a new menu
+func (q *Queries) MenuPriceHistoryAdd(ctx context.Context, menu_id string, old_price *int, new_price int, schedule_id *string) error {
+	_, err := q.db.ExecContext(ctx, addMenuPriceHistory, menu_id, old_price, new_price, schedule_id, actor.FromContext(ctx))
+	return err
+}
+
+const getMenuPriceHistory = `-- name: MenuPriceHistory :many
+SELECT history_id, menu_id, old_price, new_price, schedule_id, changed_by, changed_date
+FROM tb_menu_price_history WHERE menu_id = ? ORDER BY history_id DESC
+`
+
+func (q *Queries) MenuPriceHistoryList(ctx context.Context, menu_id string) (list []response.MenuPriceHistory, err error) {
+	rows, err := q.db.QueryContext(ctx, getMenuPriceHistory, menu_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.MenuPriceHistory{}
+	for rows.Next() {
+		var i response.MenuPriceHistory
+		var oldPrice sql.NullInt64
+		var scheduleId sql.NullString
+
+		err = rows.Scan(
+			&i.HistoryId,
+			&i.MenuId,
+			&oldPrice,
+			&i.NewPrice,
+			&scheduleId,
+			&i.ChangedBy,
+			&i.ChangedDate,
+		)
+		if err != nil {
+			return
+		}
+
+		if oldPrice.Valid {
+			price := int(oldPrice.Int64)
+			i.OldPrice = &price
+		}
+		if scheduleId.Valid {
+			i.ScheduleId = &scheduleId.String
+		}
+
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const deleteMenuPriceHistoryByMenu = `-- name: DeleteMenuPriceHistoryByMenu :exec
+DELETE FROM tb_menu_price_history WHERE menu_id = ?
+`
+
+func (q *Queries) MenuPriceHistoryDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuPriceHistoryByMenu, menu_id)
+	return err
+}
+
+const addMenuPriceSchedule = `-- name: AddMenuPriceSchedule :exec
+INSERT INTO tb_menu_price_schedule (schedule_id, menu_id, new_price, effective_date, status, created_by) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+// MenuPriceScheduleAdd saves a pending price change requested by the actor of ctx
+func (q *Queries) MenuPriceScheduleAdd(ctx context.Context, schedule_id, menu_id string, ps request.MenuPriceSchedule) error {
+	_, err := q.db.ExecContext(ctx, addMenuPriceSchedule,
+		schedule_id,
+		menu_id,
+		ps.MenuPrice,
+		ps.EffectiveDate,
+		constant.PriceSchedulePending,
+		actor.FromContext(ctx),
+	)
+	return err
+}
+
+const menuPriceScheduleColumns = `schedule_id, menu_id, new_price, effective_date, status, created_by, created_date, applied_date, change_id`
+
+const getMenuPriceSchedule = `-- name: MenuPriceSchedule :one
+SELECT ` + menuPriceScheduleColumns + ` FROM tb_menu_price_schedule WHERE schedule_id = ?
+`
+
+func (q *Queries) MenuPriceScheduleDetail(ctx context.Context, schedule_id string) (ps response.MenuPriceSchedule, err error) {
+	row := q.db.QueryRowContext(ctx, getMenuPriceSchedule, schedule_id)
+	err = scanMenuPriceSchedule(row, &ps)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return ps, err
+}
+
+const getMenuPriceSchedules = `-- name: MenuPriceSchedules :many
+SELECT ` + menuPriceScheduleColumns + ` FROM tb_menu_price_schedule WHERE menu_id = ? AND status IN (?, ?)
+ORDER BY effective_date, created_date
+`
+
+// MenuPriceScheduleList returns pending price changes of menu and due ones waiting for approval, applied ones are
+// part of the price history
+func (q *Queries) MenuPriceScheduleList(ctx context.Context, menu_id string) (list []response.MenuPriceSchedule, err error) {
+	return q.menuPriceSchedules(ctx, getMenuPriceSchedules, menu_id, constant.PriceSchedulePending, constant.PriceScheduleRequested)
+}
+
+const getMenuPriceScheduleByChange = `-- name: MenuPriceScheduleByChange :one
+SELECT ` + menuPriceScheduleColumns + ` FROM tb_menu_price_schedule WHERE change_id = ?
+`
+
+// MenuPriceScheduleByChange returns the schedule which requested price change, a change made by a menu update has
+// no schedule and is not found
+func (q *Queries) MenuPriceScheduleByChange(ctx context.Context, change_id string) (ps response.MenuPriceSchedule, err error) {
+	row := q.db.QueryRowContext(ctx, getMenuPriceScheduleByChange, change_id)
+	err = scanMenuPriceSchedule(row, &ps)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return ps, err
+}
+
+const getDueMenuPriceSchedules = `-- name: DueMenuPriceSchedules :many
+SELECT ` + menuPriceScheduleColumns + ` FROM tb_menu_price_schedule s WHERE s.status = ? AND s.effective_date <= ?
+AND NOT EXISTS (SELECT 1 FROM tb_menu_price_change c WHERE c.menu_id = s.menu_id AND c.status = ?)
+ORDER BY s.effective_date, s.created_date LIMIT ?
+`
+
+// MenuPriceScheduleDue returns pending price changes effective at now, oldest first, schedules of menus having a
+// price change waiting for approval are left out until the change is reviewed
+func (q *Queries) MenuPriceScheduleDue(ctx context.Context, now time.Time, limit int) (list []response.MenuPriceSchedule, err error) {
+	return q.menuPriceSchedules(ctx, getDueMenuPriceSchedules, constant.PriceSchedulePending, now, constant.PriceChangePending, limit)
+}
+
+func (q *Queries) menuPriceSchedules(ctx context.Context, query string, args ...interface{}) (list []response.MenuPriceSchedule, err error) {
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.MenuPriceSchedule{}
+	for rows.Next() {
+		var ps response.MenuPriceSchedule
+		err = scanMenuPriceSchedule(rows, &ps)
+		if err != nil {
+			return
+		}
+		list = append(list, ps)
+	}
+
+	return list, rows.Err()
+}
+
+const updateMenuPriceScheduleStatus = `-- name: UpdateMenuPriceScheduleStatus :exec
+UPDATE tb_menu_price_schedule SET status = ?, applied_date = IF(?, CURRENT_TIMESTAMP(3), NULL)
+WHERE schedule_id = ? AND menu_id = ? AND status = ?
+`
+
+// MenuPriceScheduleStatus moves a pending schedule to applied or cancelled, other schedules are not found
+func (q *Queries) MenuPriceScheduleStatus(ctx context.Context, menu_id, schedule_id, status string) error {
+	result, err := q.db.ExecContext(ctx, updateMenuPriceScheduleStatus,
+		status,
+		status == constant.PriceScheduleApplied,
+		schedule_id,
+		menu_id,
+		constant.PriceSchedulePending,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const requestMenuPriceSchedule = `-- name: RequestMenuPriceSchedule :exec
+UPDATE tb_menu_price_schedule SET status = ?, change_id = ?
+WHERE schedule_id = ? AND menu_id = ? AND status = ?
+`
+
+// MenuPriceScheduleRequest moves a pending schedule to requested waiting for approval of price change change_id,
+// other schedules are not found
+func (q *Queries) MenuPriceScheduleRequest(ctx context.Context, menu_id, schedule_id, change_id string) error {
+	result, err := q.db.ExecContext(ctx, requestMenuPriceSchedule,
+		constant.PriceScheduleRequested,
+		change_id,
+		schedule_id,
+		menu_id,
+		constant.PriceSchedulePending,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const reviewMenuPriceSchedule = `-- name: ReviewMenuPriceSchedule :exec
+UPDATE tb_menu_price_schedule SET status = ?, applied_date = IF(?, CURRENT_TIMESTAMP(3), NULL)
+WHERE change_id = ? AND status = ?
+`
+
+// MenuPriceScheduleReview moves the schedule waiting for price change change_id to applied or rejected with the
+// change, a change without schedule is left as it is
+func (q *Queries) MenuPriceScheduleReview(ctx context.Context, change_id, status string) error {
+	_, err := q.db.ExecContext(ctx, reviewMenuPriceSchedule,
+		status,
+		status == constant.PriceScheduleApplied,
+		change_id,
+		constant.PriceScheduleRequested,
+	)
+	return err
+}
+
+const deleteMenuPriceScheduleByMenu = `-- name: DeleteMenuPriceScheduleByMenu :exec
+DELETE FROM tb_menu_price_schedule WHERE menu_id = ?
+`
+
+func (q *Queries) MenuPriceScheduleDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuPriceScheduleByMenu, menu_id)
+	return err
+}
+
+func scanMenuPriceSchedule(row scanner, ps *response.MenuPriceSchedule) error {
+	var applied sql.NullTime
+	var changeId sql.NullString
+
+	err := row.Scan(
+		&ps.ScheduleId,
+		&ps.MenuId,
+		&ps.MenuPrice,
+		&ps.EffectiveDate,
+		&ps.Status,
+		&ps.CreatedBy,
+		&ps.CreatedDate,
+		&applied,
+		&changeId,
+	)
+	if err != nil {
+		return err
+	}
+
+	if applied.Valid {
+		ps.AppliedDate = &applied.Time
+	}
+	if changeId.Valid {
+		ps.ChangeId = &changeId.String
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const addMenuPriceChange = `-- name: AddMenuPriceChange :exec
+INSERT INTO tb_menu_price_change (change_id, menu_id, warteg_id, old_price, new_price, status, requested_by)
+SELECT ?, menu_id, IFNULL(warteg_id, ''), ?, ?, ?, ? FROM tb_menu WHERE menu_id = ?
+`
+
+// MenuPriceChangeAdd saves a price change of menu waiting for approval, requested by the actor of ctx
+func (q *Queries) MenuPriceChangeAdd(ctx context.Context, change_id, menu_id string, old_price, new_price int) error {
+	_, err := q.db.ExecContext(ctx, addMenuPriceChange,
+		change_id,
+		old_price,
+		new_price,
+		constant.PriceChangePending,
+		actor.FromContext(ctx),
+		menu_id,
+	)
+	return err
+}
+
+const countPendingMenuPriceChange = `-- name: CountPendingMenuPriceChange :one
+SELECT COUNT(*) FROM tb_menu_price_change WHERE menu_id = ? AND status = ?
+`
+
+// MenuPriceChangePending returns number of price changes of menu waiting for approval
+func (q *Queries) MenuPriceChangePending(ctx context.Context, menu_id string) (count int, err error) {
+	err = q.db.QueryRowContext(ctx, countPendingMenuPriceChange, menu_id, constant.PriceChangePending).Scan(&count)
+	return count, err
+}
+
+// priceChangePending returns ErrPriceChangePending when menu has a price change waiting for approval
+func (q *Queries) priceChangePending(ctx context.Context, menu_id string) error {
+	pending, err := q.MenuPriceChangePending(ctx, menu_id)
+	if err != nil {
+		return err
+	}
+	if pending > 0 {
+		return constant.ErrPriceChangePending
+	}
+	return nil
+}
+
+const menuPriceChangeColumns = `change_id, menu_id, warteg_id, old_price, new_price, status, requested_by, requested_date,
+reviewed_by, review_note, reviewed_date`
+
+const getMenuPriceChange = `-- name: MenuPriceChange :one
+SELECT ` + menuPriceChangeColumns + ` FROM tb_menu_price_change WHERE change_id = ?
+`
+
+func (q *Queries) MenuPriceChangeDetail(ctx context.Context, change_id string) (pc response.MenuPriceChange, err error) {
+	row := q.db.QueryRowContext(ctx, getMenuPriceChange, change_id)
+	err = scanMenuPriceChange(row, &pc)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return pc, err
+}
+
+const getMenuPriceChanges = `-- name: MenuPriceChanges :many
+SELECT ` + menuPriceChangeColumns + ` FROM tb_menu_price_change
+WHERE status = ? AND (? = '' OR menu_id = ?) AND (? = '' OR warteg_id = ?)
+ORDER BY requested_date, change_id
+`
+
+// MenuPriceChangeList returns price changes of a status oldest first, optionally of a menu or warteg
+func (q *Queries) MenuPriceChangeList(ctx context.Context, filter request.MenuPriceChangeFilter) (list []response.MenuPriceChange, err error) {
+	rows, err := q.db.QueryContext(ctx, getMenuPriceChanges,
+		filter.Status,
+		filter.MenuId,
+		filter.MenuId,
+		filter.WartegId,
+		filter.WartegId,
+	)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.MenuPriceChange{}
+	for rows.Next() {
+		var pc response.MenuPriceChange
+		err = scanMenuPriceChange(rows, &pc)
+		if err != nil {
+			return
+		}
+		list = append(list, pc)
+	}
+
+	return list, rows.Err()
+}
+
+const reviewMenuPriceChange = `-- name: ReviewMenuPriceChange :exec
+UPDATE tb_menu_price_change SET status = ?, reviewed_by = ?, review_note = ?, reviewed_date = CURRENT_TIMESTAMP(3)
+WHERE change_id = ? AND status = ?
+`
+
+// MenuPriceChangeReview moves a pending price change to approved or rejected as reviewed by the actor of ctx,
+// other changes are not found
+func (q *Queries) MenuPriceChangeReview(ctx context.Context, change_id, status, note string) error {
+	result, err := q.db.ExecContext(ctx, reviewMenuPriceChange,
+		status,
+		actor.FromContext(ctx),
+		note,
+		change_id,
+		constant.PriceChangePending,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+// menuPriceChangeEventPayload is the price change c carried by its outbox event
+const menuPriceChangeEventPayload = `JSON_OBJECT('change_id', c.change_id, 'menu_id', c.menu_id, 'warteg_id', c.warteg_id,
+'old_price', c.old_price, 'new_price', c.new_price, 'status', c.status, 'requested_by', c.requested_by,
+'reviewed_by', c.reviewed_by, 'review_note', c.review_note)`
+
+const addMenuPriceChangeEvent = `-- name: AddMenuPriceChangeEvent :exec
+INSERT INTO tb_outbox (event_id, event_type, aggregate_id, warteg_id, payload)
+SELECT UUID(), ?, c.menu_id, c.warteg_id, ` + menuPriceChangeEventPayload + ` FROM tb_menu_price_change c
+WHERE c.change_id = ?
+`
+
+// MenuPriceChangeEventAdd writes an event of the price change to the outbox in the transaction of the change,
+// it is relayed in order with the menu events of the same menu
+func (q *Queries) MenuPriceChangeEventAdd(ctx context.Context, change_id, event_type string) error {
+	_, err := q.db.ExecContext(ctx, addMenuPriceChangeEvent, event_type, change_id)
+	return err
+}
+
+const deleteMenuPriceChangeByMenu = `-- name: DeleteMenuPriceChangeByMenu :exec
+DELETE FROM tb_menu_price_change WHERE menu_id = ?
+`
+
+func (q *Queries) MenuPriceChangeDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuPriceChangeByMenu, menu_id)
+	return err
+}
+
+func scanMenuPriceChange(row scanner, pc *response.MenuPriceChange) error {
+	var reviewedBy sql.NullString
+	var reviewed sql.NullTime
+
+	err := row.Scan(
+		&pc.ChangeId,
+		&pc.MenuId,
+		&pc.WartegId,
+		&pc.OldPrice,
+		&pc.NewPrice,
+		&pc.Status,
+		&pc.RequestedBy,
+		&pc.RequestedDate,
+		&reviewedBy,
+		&pc.ReviewNote,
+		&reviewed,
+	)
+	if err != nil {
+		return err
+	}
+
+	if reviewedBy.Valid {
+		pc.ReviewedBy = &reviewedBy.String
+	}
+	if reviewed.Valid {
+		pc.ReviewedDate = &reviewed.Time
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// menuStatusJoin joins publishing status of menu b
+const menuStatusJoin = `LEFT JOIN tb_menu_status ms ON ms.menu_id=b.menu_id`
+
+// menuStatus is publishing status of menu b, menus without status are published
+const menuStatus = `IFNULL(ms.status, '` + constant.MenuStatusPublished + `')`
+
+// menuPublishedB and menuPublishedC tell whether menu b or menu of change c is published without joining its status
+const (
+	menuPublishedB = `NOT EXISTS (SELECT 1 FROM tb_menu_status s WHERE s.menu_id = b.menu_id AND s.status <> '` + constant.MenuStatusPublished + `')`
+	menuPublishedC = `NOT EXISTS (SELECT 1 FROM tb_menu_status s WHERE s.menu_id = c.menu_id AND s.status <> '` + constant.MenuStatusPublished + `')`
+)
+
+const setMenuStatus = `-- name: SetMenuStatus :exec
+INSERT INTO tb_menu_status (menu_id, status, publish_at, updated_by) VALUES (?, ?, ?, ?)
+ON DUPLICATE KEY UPDATE status=VALUES(status), publish_at=VALUES(publish_at), updated_by=VALUES(updated_by),
+updated_date=CURRENT_TIMESTAMP(3)
+`
+
+// MenuStatusSet stores publishing status of menu as set by the actor of ctx
+func (q *Queries) MenuStatusSet(ctx context.Context, ms request.MenuStatus) error {
+	_, err := q.db.ExecContext(ctx, setMenuStatus, ms.MenuId, ms.Status, ms.PublishAt, actor.FromContext(ctx))
+	return err
+}
+
+const deleteMenuStatus = `-- name: DeleteMenuStatus :exec
+DELETE FROM tb_menu_status WHERE menu_id = ?
+`
+
+func (q *Queries) MenuStatusDelete(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuStatus, menu_id)
+	return err
+}
+
+const getMenuStatus = `-- name: MenuStatus :one
+SELECT b.menu_id, IFNULL(b.warteg_id, ''), ` + menuStatus + `, ms.publish_at, IFNULL(ms.updated_by, ''), ms.updated_date
+FROM tb_menu b ` + menuStatusJoin + `
+WHERE b.menu_id = ?
+`
+
+func (q *Queries) MenuStatus(ctx context.Context, menu_id string) (ms response.MenuStatus, err error) {
+	row := q.db.QueryRowContext(ctx, getMenuStatus, menu_id)
+	err = row.Scan(
+		&ms.MenuId,
+		&ms.WartegId,
+		&ms.Status,
+		&ms.PublishAt,
+		&ms.UpdatedBy,
+		&ms.UpdatedDate,
+	)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return ms, err
+}
+
+const getDuePublications = `-- name: DuePublications :many
+SELECT b.menu_id, IFNULL(b.warteg_id, ''), ms.status, ms.publish_at, ms.updated_by, ms.updated_date
+FROM tb_menu_status ms JOIN tb_menu b ON b.menu_id=ms.menu_id
+WHERE ms.status = '` + constant.MenuStatusDraft + `' AND ms.publish_at <= ?
+ORDER BY ms.publish_at LIMIT ?
+`
+
+// MenuPublishDue returns drafts whose publish time has come, oldest first
+func (q *Queries) MenuPublishDue(ctx context.Context, now time.Time, limit int) (list []response.MenuStatus, err error) {
+	list = []response.MenuStatus{}
+	rows, err := q.db.QueryContext(ctx, getDuePublications, now, limit)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var i response.MenuStatus
+		err = rows.Scan(
+			&i.MenuId,
+			&i.WartegId,
+			&i.Status,
+			&i.PublishAt,
+			&i.UpdatedBy,
+			&i.UpdatedDate,
+		)
+		if err != nil {
+			return
+		}
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const publishMenu = `-- name: PublishMenu :exec
+UPDATE tb_menu_status SET status='` + constant.MenuStatusPublished + `', publish_at=NULL, updated_date=CURRENT_TIMESTAMP(3)
+WHERE menu_id = ? AND status = '` + constant.MenuStatusDraft + `' AND publish_at <= ?
+`
+
+// MenuPublish publishes a draft due at now, ErrNotFound when it is no longer a due draft
+func (q *Queries) MenuPublish(ctx context.Context, menu_id string, now time.Time) error {
+	result, err := q.db.ExecContext(ctx, publishMenu, menu_id, now)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
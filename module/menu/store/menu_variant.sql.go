@@ -0,0 +1,194 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// variantPrice resolves price of variant mv of menu m, delta is added to menu price and absolute replaces it. A delta
+// is only checked against the menu price it was written with, a later lower menu price makes it free instead of negative
+const variantPrice = `CASE WHEN mv.price_type = 'absolute' THEN mv.price ELSE GREATEST(m.menu_price + mv.price, 0) END`
+
+const addMenuVariant = `-- name: AddMenuVariant :exec
+INSERT INTO tb_menu_variant (
+	variant_id,
+	menu_id,
+	variant_name,
+	price_type,
+	price,
+	is_default
+) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+func (q *Queries) MenuVariantAdd(ctx context.Context, mv request.MenuVariant) error {
+	_, err := q.db.ExecContext(ctx, addMenuVariant,
+		mv.VariantId,
+		mv.MenuId,
+		mv.VariantName,
+		mv.PriceType,
+		mv.Price,
+		mv.IsDefault,
+	)
+	return err
+}
+
+const updateMenuVariant = `-- name: UpdateMenuVariant :exec
+UPDATE tb_menu_variant SET variant_name=?, price_type=?, price=?, is_default=?, updated_date=CURRENT_TIMESTAMP(3)
+WHERE menu_id = ? AND variant_id = ?
+`
+
+func (q *Queries) MenuVariantUpdate(ctx context.Context, mv request.MenuVariant) error {
+	_, err := q.db.ExecContext(ctx, updateMenuVariant,
+		mv.VariantName,
+		mv.PriceType,
+		mv.Price,
+		mv.IsDefault,
+		mv.MenuId,
+		mv.VariantId,
+	)
+	return err
+}
+
+const clearMenuVariantDefault = `-- name: ClearMenuVariantDefault :exec
+UPDATE tb_menu_variant SET is_default=0 WHERE menu_id = ? AND variant_id <> ? AND is_default=1
+`
+
+// MenuVariantClearDefault unsets default flag of every other variant of the menu
+func (q *Queries) MenuVariantClearDefault(ctx context.Context, menu_id, variant_id string) error {
+	_, err := q.db.ExecContext(ctx, clearMenuVariantDefault, menu_id, variant_id)
+	return err
+}
+
+const menuVariantColumns = `mv.variant_id, mv.menu_id, mv.variant_name, mv.price_type, mv.price, ` + variantPrice + `, mv.is_default, mv.updated_date`
+
+const getMenuVariants = `-- name: MenuVariants :many
+SELECT ` + menuVariantColumns + ` FROM tb_menu_variant mv JOIN tb_menu m ON m.menu_id=mv.menu_id
+WHERE mv.menu_id = ? ORDER BY mv.is_default DESC, mv.created_date
+`
+
+func (q *Queries) MenuVariantList(ctx context.Context, menu_id string) (list []response.MenuVariant, err error) {
+	rows, err := q.db.QueryContext(ctx, getMenuVariants, menu_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.MenuVariant{}
+	for rows.Next() {
+		var i response.MenuVariant
+		err = scanMenuVariant(rows, &i)
+		if err != nil {
+			return
+		}
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const getMenuVariantsByMenu = `-- name: MenuVariantsByMenu :many
+SELECT ` + menuVariantColumns + ` FROM tb_menu_variant mv JOIN tb_menu m ON m.menu_id=mv.menu_id
+WHERE mv.menu_id IN (%s) ORDER BY mv.menu_id, mv.is_default DESC, mv.created_date
+`
+
+// MenuVariantsByMenu returns variants of several menus in one query
+func (q *Queries) MenuVariantsByMenu(ctx context.Context, menu_ids []string) (list []response.MenuVariant, err error) {
+	list = []response.MenuVariant{}
+	if len(menu_ids) == 0 {
+		return
+	}
+
+	rows, err := q.db.QueryContext(ctx, fmt.Sprintf(getMenuVariantsByMenu, placeholders(len(menu_ids))), stringArgs(menu_ids)...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		var i response.MenuVariant
+		err = scanMenuVariant(rows, &i)
+		if err != nil {
+			return
+		}
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+const getMenuVariant = `-- name: MenuVariant :one
+SELECT ` + menuVariantColumns + ` FROM tb_menu_variant mv JOIN tb_menu m ON m.menu_id=mv.menu_id
+WHERE mv.menu_id = ? AND mv.variant_id = ?
+`
+
+func (q *Queries) MenuVariantDetail(ctx context.Context, menu_id, variant_id string) (mv response.MenuVariant, err error) {
+	row := q.db.QueryRowContext(ctx, getMenuVariant, menu_id, variant_id)
+	err = scanMenuVariant(row, &mv)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return mv, err
+}
+
+const deleteMenuVariant = `-- name: DeleteMenuVariant :exec
+DELETE FROM tb_menu_variant WHERE menu_id = ? AND variant_id = ?
+`
+
+func (q *Queries) MenuVariantDelete(ctx context.Context, menu_id, variant_id string) error {
+	result, err := q.db.ExecContext(ctx, deleteMenuVariant, menu_id, variant_id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const deleteMenuVariants = `-- name: DeleteMenuVariants :exec
+DELETE FROM tb_menu_variant WHERE menu_id = ?
+`
+
+func (q *Queries) MenuVariantDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuVariants, menu_id)
+	return err
+}
+
+const touchMenu = `-- name: TouchMenu :exec
+UPDATE tb_menu SET updated_date=CURRENT_TIMESTAMP(3) WHERE menu_id = ?
+`
+
+// MenuTouch bumps updated date of menu so caches and sync clients see changes of its child rows
+func (q *Queries) MenuTouch(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, touchMenu, menu_id)
+	return err
+}
+
+func scanMenuVariant(row scanner, i *response.MenuVariant) error {
+	return row.Scan(
+		&i.VariantId,
+		&i.MenuId,
+		&i.VariantName,
+		&i.PriceType,
+		&i.Price,
+		&i.VariantPrice,
+		&i.IsDefault,
+		&i.UpdatedDate,
+	)
+}
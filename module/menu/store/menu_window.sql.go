@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const deleteMenuWindows = `-- name: DeleteMenuWindows :exec
+DELETE FROM tb_menu_window WHERE menu_id = ?
+`
+
+func (q *Queries) MenuWindowDeleteByMenu(ctx context.Context, menu_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteMenuWindows, menu_id)
+	return err
+}
+
+const addMenuWindow = `-- name: AddMenuWindow :exec
+INSERT INTO tb_menu_window (menu_id, position, weekdays, start_time, end_time) VALUES (?, ?, ?, ?, ?)
+`
+
+func (q *Queries) MenuWindowAdd(ctx context.Context, menu_id string, position int, mw request.MenuWindow) error {
+	_, err := q.db.ExecContext(ctx, addMenuWindow, menu_id, position, formatWeekdays(mw.Weekdays), mw.Start, mw.End)
+	return err
+}
+
+const getMenuWindowMenu = `-- name: MenuWindowMenu :one
+SELECT b.menu_id, IFNULL(b.warteg_id, ''), IFNULL(s.timezone, '') FROM tb_menu b
+LEFT JOIN tb_warteg_schedule s ON s.warteg_id = b.warteg_id
+WHERE b.menu_id = ?
+`
+
+const getMenuWindowMenus = `-- name: MenuWindowMenus :many
+SELECT DISTINCT b.menu_id, IFNULL(b.warteg_id, ''), IFNULL(s.timezone, '') FROM tb_menu_window mw
+JOIN tb_menu b ON b.menu_id = mw.menu_id
+LEFT JOIN tb_warteg_schedule s ON s.warteg_id = b.warteg_id
+WHERE %s ORDER BY b.menu_id
+`
+
+const getMenuWindows = `-- name: MenuWindows :many
+SELECT mw.menu_id, mw.weekdays, TIME_FORMAT(mw.start_time, '%%H:%%i'), TIME_FORMAT(mw.end_time, '%%H:%%i') FROM tb_menu_window mw
+WHERE %s ORDER BY menu_id, position
+`
+
+// MenuWindows returns serving windows of menu with timezone of its warteg, empty when the warteg has no schedule
+func (q *Queries) MenuWindows(ctx context.Context, menu_id string) (mw response.MenuWindows, err error) {
+	mw.Windows = []response.MenuWindow{}
+	row := q.db.QueryRowContext(ctx, getMenuWindowMenu, menu_id)
+	err = row.Scan(&mw.MenuId, &mw.WartegId, &mw.Timezone)
+	if err == sql.ErrNoRows {
+		return mw, constant.ErrNotFound
+	}
+	if err != nil {
+		return
+	}
+
+	list := []response.MenuWindows{mw}
+	err = q.menuWindows(ctx, list, `mw.menu_id = ?`, menu_id)
+	return list[0], err
+}
+
+// MenuWindowsList returns menus matching filter that have serving windows
+func (q *Queries) MenuWindowsList(ctx context.Context, filter request.MenuList) (list []response.MenuWindows, err error) {
+	where, args := menuListWhere(filter)
+	cond := `mw.menu_id IN (SELECT b.menu_id ` + menuListSource(filter) + ` ` + where + `)`
+
+	list = []response.MenuWindows{}
+	rows, err := q.db.QueryContext(ctx, fmt.Sprintf(getMenuWindowMenus, cond), args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		mw := response.MenuWindows{Windows: []response.MenuWindow{}}
+		err = rows.Scan(&mw.MenuId, &mw.WartegId, &mw.Timezone)
+		if err != nil {
+			return
+		}
+		list = append(list, mw)
+	}
+	if err = rows.Err(); err != nil || len(list) == 0 {
+		return
+	}
+
+	err = q.menuWindows(ctx, list, cond, args...)
+	return list, err
+}
+
+// menuWindows fills windows of menus in list reading the windows matching cond
+func (q *Queries) menuWindows(ctx context.Context, list []response.MenuWindows, cond string, args ...interface{}) error {
+	byMenu := map[string]int{}
+	for i, mw := range list {
+		byMenu[mw.MenuId] = i
+	}
+
+	rows, err := q.db.QueryContext(ctx, fmt.Sprintf(getMenuWindows, cond), args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id, weekdays string
+		var w response.MenuWindow
+		err = rows.Scan(&id, &weekdays, &w.Start, &w.End)
+		if err != nil {
+			return err
+		}
+		w.Weekdays = parseWeekdays(weekdays)
+		if i, ok := byMenu[id]; ok {
+			list[i].Windows = append(list[i].Windows, w)
+		}
+	}
+
+	return rows.Err()
+}
+
+func formatWeekdays(weekdays []int) string {
+	list := make([]string, len(weekdays))
+	for i, d := range weekdays {
+		list[i] = strconv.Itoa(d)
+	}
+	return strings.Join(list, ",")
+}
+
+func parseWeekdays(weekdays string) []int {
+	list := []int{}
+	for _, v := range strings.Split(weekdays, ",") {
+		if d, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+			list = append(list, d)
+		}
+	}
+	return list
+}
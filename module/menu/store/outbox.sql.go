@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// menuEventPayload is the state of menu b carried by its outbox event
+const menuEventPayload = `JSON_OBJECT('menu_id', b.menu_id, 'menu_type_id', b.menu_type_id, 'warteg_id', b.warteg_id,
+'menu_name', b.menu_name, 'menu_detail', b.menu_detail, 'menu_picture', b.menu_picture, 'menu_price', b.menu_price)`
+
+const lockMenuChangeSequence = `-- name: LockMenuChangeSequence :exec
+UPDATE tb_menu_change_sequence SET writes = writes + 1 WHERE sequence_id = 1
+`
+
+// menuChangesAdd records every menu b selected by from in the change log and writes its event to the outbox,
+// both rows belong to the transaction of the change so an event is never lost nor published for a rolled back change.
+// Change ids are taken while holding the change sequence row until the transaction ends, so transactions writing the
+// change log commit in the order of their ids and a reader never moves past an id that commits later.
+// A menu whose last change log row has another warteg was moved, a tombstone for that warteg is recorded first so
+// its syncs and streams drop the menu
+func (q *Queries) menuChangesAdd(ctx context.Context, change_type, from string, args ...interface{}) error {
+	_, err := q.db.ExecContext(ctx, lockMenuChangeSequence)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.db.ExecContext(ctx, `INSERT INTO tb_menu_change (menu_id, warteg_id, change_type)
+SELECT p.menu_id, p.warteg_id, ? FROM tb_menu_change p JOIN tb_menu m ON m.menu_id = p.menu_id
+WHERE p.change_id IN (SELECT MAX(x.change_id) FROM tb_menu_change x WHERE x.menu_id IN (SELECT b.menu_id `+from+`) GROUP BY x.menu_id)
+AND p.change_type <> ? AND NOT (p.warteg_id <=> m.warteg_id)
+ORDER BY p.change_id`, append([]interface{}{constant.MenuDeleted}, append(args, constant.MenuDeleted)...)...)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.db.ExecContext(ctx, `INSERT INTO tb_menu_change (menu_id, warteg_id, change_type)
+SELECT DISTINCT b.menu_id, b.warteg_id, ? `+from, append([]interface{}{change_type}, args...)...)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.db.ExecContext(ctx, `INSERT INTO tb_outbox (event_id, event_type, aggregate_id, warteg_id, payload)
+SELECT UUID(), ?, b.menu_id, b.warteg_id, `+menuEventPayload+` FROM tb_menu b
+WHERE b.menu_id IN (SELECT b.menu_id `+from+`) ORDER BY b.menu_id`, append([]interface{}{constant.MenuEventPrefix + change_type}, args...)...)
+	return err
+}
+
+const getOutboxPending = `-- name: OutboxPending :many
+SELECT o.outbox_id, o.event_id, o.event_type, o.aggregate_id, IFNULL(o.warteg_id, ''), o.payload, o.attempts, o.next_attempt_date, o.created_date
+FROM tb_outbox o
+WHERE o.published_date IS NULL AND o.failed_date IS NULL AND o.next_attempt_date <= CURRENT_TIMESTAMP(3)
+AND NOT EXISTS (
+	SELECT 1 FROM tb_outbox p
+	WHERE p.aggregate_id = o.aggregate_id AND p.outbox_id < o.outbox_id AND p.published_date IS NULL AND p.failed_date IS NULL
+)
+ORDER BY o.outbox_id LIMIT ? FOR UPDATE SKIP LOCKED
+`
+
+// OutboxPending returns events due for publishing in the order they were written, an event waits while an earlier
+// event of its menu is pending. Rows are locked until the transaction of ctx ends and rows locked by another relay
+// are skipped
+func (q *Queries) OutboxPending(ctx context.Context, limit int) (list []response.OutboxEvent, err error) {
+	rows, err := q.db.QueryContext(ctx, getOutboxPending, limit)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.OutboxEvent{}
+	for rows.Next() {
+		var i response.OutboxEvent
+		var payload string
+		err = rows.Scan(
+			&i.OutboxId,
+			&i.EventId,
+			&i.EventType,
+			&i.MenuId,
+			&i.WartegId,
+			&payload,
+			&i.Attempts,
+			&i.NextAttemptDate,
+			&i.CreatedDate,
+		)
+		if err != nil {
+			return
+		}
+		i.Payload = []byte(payload)
+		list = append(list, i)
+	}
+
+	return list, rows.Err()
+}
+
+// OutboxLease moves the next attempt of events to until, a relay holding them publishes them meanwhile and an event
+// whose relay stopped is due again once the lease ends. Later events of the same menu keep waiting for a leased one
+func (q *Queries) OutboxLease(ctx context.Context, outbox_ids []int64, until time.Time) error {
+	if len(outbox_ids) == 0 {
+		return nil
+	}
+
+	args := []interface{}{until}
+	for _, id := range outbox_ids {
+		args = append(args, id)
+	}
+
+	_, err := q.db.ExecContext(ctx, `UPDATE tb_outbox SET next_attempt_date = ?
+WHERE outbox_id IN (`+placeholders(len(outbox_ids))+`)`, args...)
+	return err
+}
+
+const updateOutboxPublished = `-- name: UpdateOutboxPublished :exec
+UPDATE tb_outbox SET published_date=CURRENT_TIMESTAMP(3), attempts=attempts+1, last_error='' WHERE outbox_id = ?
+`
+
+func (q *Queries) OutboxPublished(ctx context.Context, outbox_id int64) error {
+	_, err := q.db.ExecContext(ctx, updateOutboxPublished, outbox_id)
+	return err
+}
+
+const updateOutboxFailed = `-- name: UpdateOutboxFailed :exec
+UPDATE tb_outbox SET attempts=attempts+1, next_attempt_date=?, last_error=LEFT(?, 500) WHERE outbox_id = ?
+`
+
+// OutboxFailed keeps the event pending until next attempt
+func (q *Queries) OutboxFailed(ctx context.Context, outbox_id int64, next_attempt time.Time, last_error string) error {
+	_, err := q.db.ExecContext(ctx, updateOutboxFailed, next_attempt, last_error, outbox_id)
+	return err
+}
+
+const updateOutboxDeadLetter = `-- name: UpdateOutboxDeadLetter :exec
+UPDATE tb_outbox SET failed_date=CURRENT_TIMESTAMP(3), attempts=attempts+1, last_error=LEFT(?, 500) WHERE outbox_id = ?
+`
+
+// OutboxDeadLetter gives up the event after its last attempt, it is kept with its error and no longer holds back
+// later events of its menu
+func (q *Queries) OutboxDeadLetter(ctx context.Context, outbox_id int64, last_error string) error {
+	_, err := q.db.ExecContext(ctx, updateOutboxDeadLetter, last_error, outbox_id)
+	return err
+}
+
+const deleteOutboxPublished = `-- name: DeleteOutboxPublished :execrows
+DELETE FROM tb_outbox WHERE published_date IS NOT NULL AND published_date < ?
+`
+
+// OutboxPurge removes events published before the given time
+func (q *Queries) OutboxPurge(ctx context.Context, before time.Time) (int64, error) {
+	res, err := q.db.ExecContext(ctx, deleteOutboxPublished, before)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,250 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const addPromotion = `-- name: AddPromotion :exec
+INSERT INTO tb_promotion (
+	promotion_id,
+	promotion_name,
+	promotion_type,
+	value,
+	buy_quantity,
+	get_quantity,
+	scope_type,
+	scope_id,
+	warteg_id,
+	start_date,
+	end_date,
+	daily_start,
+	daily_end,
+	days_of_week
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+`
+
+func (q *Queries) PromotionAdd(ctx context.Context, p request.Promotion) error {
+	_, err := q.db.ExecContext(ctx, addPromotion,
+		p.PromotionId,
+		p.PromotionName,
+		p.PromotionType,
+		p.Value,
+		p.BuyQuantity,
+		p.GetQuantity,
+		p.ScopeType,
+		p.ScopeId,
+		p.WartegId,
+		p.StartDate,
+		p.EndDate,
+		p.DailyStart,
+		p.DailyEnd,
+		joinDays(p.DaysOfWeek),
+	)
+	return err
+}
+
+const updatePromotion = `-- name: UpdatePromotion :exec
+UPDATE tb_promotion SET promotion_name=?, promotion_type=?, value=?, buy_quantity=?, get_quantity=?, scope_type=?, scope_id=?,
+warteg_id=?, start_date=?, end_date=?, daily_start=?, daily_end=?, days_of_week=?, updated_date=CURRENT_TIMESTAMP(3)
+WHERE promotion_id = ?
+`
+
+func (q *Queries) PromotionUpdate(ctx context.Context, p request.Promotion) error {
+	_, err := q.db.ExecContext(ctx, updatePromotion,
+		p.PromotionName,
+		p.PromotionType,
+		p.Value,
+		p.BuyQuantity,
+		p.GetQuantity,
+		p.ScopeType,
+		p.ScopeId,
+		p.WartegId,
+		p.StartDate,
+		p.EndDate,
+		p.DailyStart,
+		p.DailyEnd,
+		joinDays(p.DaysOfWeek),
+		p.PromotionId,
+	)
+	return err
+}
+
+const deletePromotion = `-- name: DeletePromotion :exec
+DELETE FROM tb_promotion WHERE promotion_id = ?
+`
+
+func (q *Queries) PromotionDelete(ctx context.Context, promotion_id string) error {
+	result, err := q.db.ExecContext(ctx, deletePromotion, promotion_id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const promotionColumns = `promotion_id, promotion_name, promotion_type, value, buy_quantity, get_quantity, scope_type, scope_id, warteg_id,
+start_date, end_date, daily_start, daily_end, days_of_week, updated_date`
+
+const getPromotion = `-- name: Promotion :one
+SELECT ` + promotionColumns + ` FROM tb_promotion WHERE promotion_id = ?
+`
+
+func (q *Queries) PromotionDetail(ctx context.Context, promotion_id string) (p response.Promotion, err error) {
+	row := q.db.QueryRowContext(ctx, getPromotion, promotion_id)
+	err = scanPromotion(row, &p)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return p, err
+}
+
+const getPromotions = `-- name: Promotions :many
+SELECT ` + promotionColumns + ` FROM tb_promotion WHERE (? = '' OR warteg_id = ?) ORDER BY start_date DESC, promotion_id
+`
+
+func (q *Queries) PromotionList(ctx context.Context, warteg_id string) (list []response.Promotion, err error) {
+	return q.promotions(ctx, getPromotions, warteg_id, warteg_id)
+}
+
+const getCurrentPromotions = `-- name: CurrentPromotions :many
+SELECT ` + promotionColumns + ` FROM tb_promotion WHERE end_date IS NULL OR end_date > ? ORDER BY created_date, promotion_id
+`
+
+// PromotionCurrent returns promotions that have not ended before since, including the ones that start later
+func (q *Queries) PromotionCurrent(ctx context.Context, since time.Time) (list []response.Promotion, err error) {
+	return q.promotions(ctx, getCurrentPromotions, since)
+}
+
+func (q *Queries) promotions(ctx context.Context, query string, args ...interface{}) (list []response.Promotion, err error) {
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.Promotion{}
+	for rows.Next() {
+		var p response.Promotion
+		err = scanPromotion(rows, &p)
+		if err != nil {
+			return
+		}
+		list = append(list, p)
+	}
+
+	return list, rows.Err()
+}
+
+// promotionMenuScope is where clause selecting menus b covered by a promotion scope
+func promotionMenuScope(p response.Promotion) (string, []interface{}) {
+	switch p.ScopeType {
+	case constant.PromotionScopeMenu:
+		return `b.menu_id = ?`, []interface{}{p.ScopeId}
+	case constant.PromotionScopeMenuType:
+		return `b.menu_type_id = ? AND (? = '' OR b.warteg_id = ?)`, []interface{}{p.ScopeId, p.WartegId, p.WartegId}
+	default:
+		return `b.warteg_id = ?`, []interface{}{p.ScopeId}
+	}
+}
+
+// PromotionTouchMenus bumps every menu covered by the promotion and records them in the change log
+func (q *Queries) PromotionTouchMenus(ctx context.Context, p response.Promotion) error {
+	where, args := promotionMenuScope(p)
+
+	_, err := q.db.ExecContext(ctx, `UPDATE tb_menu b SET b.updated_date=CURRENT_TIMESTAMP(3) WHERE `+where, args...)
+	if err != nil {
+		return err
+	}
+
+	return q.menuChangesAdd(ctx, constant.MenuUpdated, `FROM tb_menu b WHERE `+where, args...)
+}
+
+const updatePromotionBoundary = `-- name: UpdatePromotionBoundary :execrows
+UPDATE tb_promotion SET boundary_date=?, updated_date=updated_date
+WHERE promotion_id = ? AND (boundary_date IS NULL OR boundary_date < ?) AND updated_date < ?
+`
+
+// PromotionBoundarySet records the last start or end of a run of promotion, false when it was recorded already or
+// the promotion was edited after it
+func (q *Queries) PromotionBoundarySet(ctx context.Context, promotion_id string, boundary time.Time) (bool, error) {
+	result, err := q.db.ExecContext(ctx, updatePromotionBoundary, boundary, promotion_id, boundary, boundary)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := result.RowsAffected()
+	return rows == 1, err
+}
+
+func scanPromotion(row scanner, p *response.Promotion) error {
+	var endDate sql.NullTime
+	var days string
+
+	err := row.Scan(
+		&p.PromotionId,
+		&p.PromotionName,
+		&p.PromotionType,
+		&p.Value,
+		&p.BuyQuantity,
+		&p.GetQuantity,
+		&p.ScopeType,
+		&p.ScopeId,
+		&p.WartegId,
+		&p.StartDate,
+		&endDate,
+		&p.DailyStart,
+		&p.DailyEnd,
+		&days,
+		&p.UpdatedDate,
+	)
+	if err != nil {
+		return err
+	}
+
+	if endDate.Valid {
+		p.EndDate = &endDate.Time
+	}
+	p.DaysOfWeek = splitDays(days)
+
+	return nil
+}
+
+// joinDays stores weekdays as comma separated numbers, empty means every day
+func joinDays(days []int) string {
+	s := make([]string, len(days))
+	for i, d := range days {
+		s[i] = strconv.Itoa(d)
+	}
+	return strings.Join(s, ",")
+}
+
+func splitDays(days string) []int {
+	list := []int{}
+	for _, d := range strings.Split(days, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(d))
+		if err == nil {
+			list = append(list, n)
+		}
+	}
+	return list
+}
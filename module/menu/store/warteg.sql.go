@@ -0,0 +1,235 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/geo"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// geoFromText reads well known text in longitude latitude order into a geometry comparable with the spatial index
+const geoFromText = `ST_GeomFromText(?, 4326, 'axis-order=long-lat')`
+
+const setWartegLocation = `-- name: SetWartegLocation :exec
+INSERT INTO tb_warteg (warteg_id, latitude, longitude, location) VALUES (?, ?, ?, ` + geoFromText + `)
+ON DUPLICATE KEY UPDATE latitude=VALUES(latitude), longitude=VALUES(longitude), location=VALUES(location),
+updated_date=CURRENT_TIMESTAMP(3)
+`
+
+// WartegLocationSet stores coordinates of a warteg, the warteg row is created on first use
+func (q *Queries) WartegLocationSet(ctx context.Context, req request.WartegLocation) error {
+	point := geo.Point{Lat: *req.Latitude, Lng: *req.Longitude}
+	_, err := q.db.ExecContext(ctx, setWartegLocation, req.WartegId, point.Lat, point.Lng, point.WKT())
+	return err
+}
+
+const getWartegLocation = `-- name: WartegLocation :one
+SELECT warteg_id, latitude, longitude, updated_date FROM tb_warteg WHERE warteg_id = ?
+`
+
+func (q *Queries) WartegLocation(ctx context.Context, warteg_id string) (wl response.WartegLocation, err error) {
+	row := q.db.QueryRowContext(ctx, getWartegLocation, warteg_id)
+	err = row.Scan(
+		&wl.WartegId,
+		&wl.Latitude,
+		&wl.Longitude,
+		&wl.UpdatedDate,
+	)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return wl, err
+}
+
+const setWartegSchedule = `-- name: SetWartegSchedule :exec
+INSERT INTO tb_warteg_schedule (warteg_id, timezone) VALUES (?, ?)
+ON DUPLICATE KEY UPDATE timezone=VALUES(timezone), updated_date=CURRENT_TIMESTAMP(3)
+`
+
+func (q *Queries) WartegScheduleSet(ctx context.Context, warteg_id, timezone string) error {
+	_, err := q.db.ExecContext(ctx, setWartegSchedule, warteg_id, timezone)
+	return err
+}
+
+const getWartegTimezones = `-- name: WartegTimezones :many
+SELECT warteg_id, timezone FROM tb_warteg_schedule
+`
+
+// WartegTimezones returns timezone of every warteg having opening hours by warteg id
+func (q *Queries) WartegTimezones(ctx context.Context) (zones map[string]string, err error) {
+	rows, err := q.db.QueryContext(ctx, getWartegTimezones)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	zones = map[string]string{}
+	for rows.Next() {
+		var wartegId, timezone string
+		err = rows.Scan(&wartegId, &timezone)
+		if err != nil {
+			return
+		}
+		zones[wartegId] = timezone
+	}
+
+	return zones, rows.Err()
+}
+
+const getMenuTimezone = `-- name: MenuTimezone :one
+SELECT IFNULL(s.timezone, '') FROM tb_menu b LEFT JOIN tb_warteg_schedule s ON s.warteg_id = b.warteg_id
+WHERE b.menu_id = ?
+`
+
+// MenuTimezone returns timezone of the warteg of menu, empty when the warteg has no opening hours
+func (q *Queries) MenuTimezone(ctx context.Context, menu_id string) (timezone string, err error) {
+	err = q.db.QueryRowContext(ctx, getMenuTimezone, menu_id).Scan(&timezone)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return timezone, err
+}
+
+const deleteWartegHours = `-- name: DeleteWartegHours :exec
+DELETE FROM tb_warteg_hours WHERE warteg_id = ?
+`
+
+func (q *Queries) WartegHoursDelete(ctx context.Context, warteg_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteWartegHours, warteg_id)
+	return err
+}
+
+const addWartegHour = `-- name: AddWartegHour :exec
+INSERT INTO tb_warteg_hours (warteg_id, weekday, open_time, close_time) VALUES (?, ?, ?, ?)
+`
+
+func (q *Queries) WartegHourAdd(ctx context.Context, warteg_id string, wh request.WartegHour) error {
+	_, err := q.db.ExecContext(ctx, addWartegHour, warteg_id, wh.Weekday, wh.Open, wh.Close)
+	return err
+}
+
+const deleteWartegClosures = `-- name: DeleteWartegClosures :exec
+DELETE FROM tb_warteg_closure WHERE warteg_id = ?
+`
+
+func (q *Queries) WartegClosureDelete(ctx context.Context, warteg_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteWartegClosures, warteg_id)
+	return err
+}
+
+const addWartegClosure = `-- name: AddWartegClosure :exec
+INSERT INTO tb_warteg_closure (warteg_id, closure_date, note) VALUES (?, ?, ?)
+`
+
+func (q *Queries) WartegClosureAdd(ctx context.Context, warteg_id string, wc request.WartegClosure) error {
+	_, err := q.db.ExecContext(ctx, addWartegClosure, warteg_id, wc.ClosureDate, wc.Note)
+	return err
+}
+
+const getWartegSchedules = `-- name: WartegSchedules :many
+SELECT warteg_id, timezone, updated_date FROM tb_warteg_schedule WHERE %s ORDER BY warteg_id
+`
+
+const getWartegHours = `-- name: WartegHours :many
+SELECT warteg_id, weekday, TIME_FORMAT(open_time, '%%H:%%i'), TIME_FORMAT(close_time, '%%H:%%i') FROM tb_warteg_hours
+WHERE %s ORDER BY warteg_id, weekday, open_time
+`
+
+const getWartegClosures = `-- name: WartegClosures :many
+SELECT warteg_id, DATE_FORMAT(closure_date, '%%Y-%%m-%%d'), note FROM tb_warteg_closure
+WHERE %s ORDER BY warteg_id, closure_date
+`
+
+func (q *Queries) WartegHours(ctx context.Context, warteg_id string) (wh response.WartegHours, err error) {
+	list, err := q.wartegHours(ctx, `warteg_id = ?`, warteg_id)
+	if err != nil {
+		return
+	}
+
+	if len(list) == 0 {
+		return wh, constant.ErrNotFound
+	}
+
+	return list[0], nil
+}
+
+// WartegHoursList returns schedules of the wartegs having menus matching filter
+func (q *Queries) WartegHoursList(ctx context.Context, filter request.MenuList) (list []response.WartegHours, err error) {
+	where, args := menuListWhere(filter)
+	return q.wartegHours(ctx, `warteg_id IN (SELECT b.warteg_id `+menuListSource(filter)+` `+where+`)`, args...)
+}
+
+// wartegHours reads schedules with their hours and closures of the wartegs matching cond in three queries
+func (q *Queries) wartegHours(ctx context.Context, cond string, args ...interface{}) (list []response.WartegHours, err error) {
+	list = []response.WartegHours{}
+	byWarteg := map[string]int{}
+
+	rows, err := q.db.QueryContext(ctx, fmt.Sprintf(getWartegSchedules, cond), args...)
+	if err != nil {
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		wh := response.WartegHours{Hours: []response.WartegHour{}, Closures: []response.WartegClosure{}}
+		err = rows.Scan(&wh.WartegId, &wh.Timezone, &wh.UpdatedDate)
+		if err != nil {
+			return
+		}
+		byWarteg[wh.WartegId] = len(list)
+		list = append(list, wh)
+	}
+	if err = rows.Err(); err != nil || len(list) == 0 {
+		return
+	}
+
+	hourRows, err := q.db.QueryContext(ctx, fmt.Sprintf(getWartegHours, cond), args...)
+	if err != nil {
+		return
+	}
+	defer hourRows.Close()
+
+	for hourRows.Next() {
+		var id string
+		var h response.WartegHour
+		err = hourRows.Scan(&id, &h.Weekday, &h.Open, &h.Close)
+		if err != nil {
+			return
+		}
+		if i, ok := byWarteg[id]; ok {
+			list[i].Hours = append(list[i].Hours, h)
+		}
+	}
+	if err = hourRows.Err(); err != nil {
+		return
+	}
+
+	closureRows, err := q.db.QueryContext(ctx, fmt.Sprintf(getWartegClosures, cond), args...)
+	if err != nil {
+		return
+	}
+	defer closureRows.Close()
+
+	for closureRows.Next() {
+		var id string
+		var c response.WartegClosure
+		err = closureRows.Scan(&id, &c.ClosureDate, &c.Note)
+		if err != nil {
+			return
+		}
+		if i, ok := byWarteg[id]; ok {
+			list[i].Closures = append(list[i].Closures, c)
+		}
+	}
+
+	return list, closureRows.Err()
+}
@@ -0,0 +1,298 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+const addWebhook = `-- name: AddWebhook :exec
+INSERT INTO tb_webhook (
+	webhook_id,
+	warteg_id,
+	url,
+	event_types,
+	secret,
+	is_active
+) VALUES (?, ?, ?, ?, ?, ?)
+`
+
+func (q *Queries) WebhookAdd(ctx context.Context, w request.Webhook) error {
+	_, err := q.db.ExecContext(ctx, addWebhook,
+		w.WebhookId,
+		w.WartegId,
+		w.Url,
+		strings.Join(w.EventTypes, ","),
+		w.Secret,
+		w.IsActive == nil || *w.IsActive,
+	)
+	return err
+}
+
+const updateWebhook = `-- name: UpdateWebhook :exec
+UPDATE tb_webhook SET warteg_id=?, url=?, event_types=?, secret=?, is_active=?, updated_date=CURRENT_TIMESTAMP(3)
+WHERE webhook_id = ?
+`
+
+func (q *Queries) WebhookUpdate(ctx context.Context, w request.Webhook) error {
+	_, err := q.db.ExecContext(ctx, updateWebhook,
+		w.WartegId,
+		w.Url,
+		strings.Join(w.EventTypes, ","),
+		w.Secret,
+		w.IsActive == nil || *w.IsActive,
+		w.WebhookId,
+	)
+	return err
+}
+
+const deleteWebhook = `-- name: DeleteWebhook :exec
+DELETE FROM tb_webhook WHERE webhook_id = ?
+`
+
+func (q *Queries) WebhookDelete(ctx context.Context, webhook_id string) error {
+	result, err := q.db.ExecContext(ctx, deleteWebhook, webhook_id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rows != 1 {
+		return constant.ErrNotFound
+	}
+
+	return nil
+}
+
+const webhookColumns = `webhook_id, warteg_id, url, event_types, secret, is_active, created_date, updated_date`
+
+const getWebhook = `-- name: Webhook :one
+SELECT ` + webhookColumns + ` FROM tb_webhook WHERE webhook_id = ?
+`
+
+// WebhookDetail returns webhook including its secret, callers strip it before showing the webhook
+func (q *Queries) WebhookDetail(ctx context.Context, webhook_id string) (w response.Webhook, err error) {
+	row := q.db.QueryRowContext(ctx, getWebhook, webhook_id)
+	err = scanWebhook(row, &w)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return w, err
+}
+
+const getWebhooks = `-- name: Webhooks :many
+SELECT ` + webhookColumns + ` FROM tb_webhook WHERE (? = '' OR warteg_id = ?) ORDER BY created_date, webhook_id
+`
+
+func (q *Queries) WebhookList(ctx context.Context, warteg_id string) (list []response.Webhook, err error) {
+	rows, err := q.db.QueryContext(ctx, getWebhooks, warteg_id, warteg_id)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.Webhook{}
+	for rows.Next() {
+		var w response.Webhook
+		err = scanWebhook(rows, &w)
+		if err != nil {
+			return
+		}
+		list = append(list, w)
+	}
+
+	return list, rows.Err()
+}
+
+func scanWebhook(row scanner, w *response.Webhook) error {
+	var eventTypes string
+
+	err := row.Scan(
+		&w.WebhookId,
+		&w.WartegId,
+		&w.Url,
+		&eventTypes,
+		&w.Secret,
+		&w.IsActive,
+		&w.CreatedDate,
+		&w.UpdatedDate,
+	)
+	if err != nil {
+		return err
+	}
+
+	w.EventTypes = []string{}
+	if eventTypes != "" {
+		w.EventTypes = strings.Split(eventTypes, ",")
+	}
+
+	return nil
+}
+
+const addWebhookDeliveries = `-- name: AddWebhookDeliveries :exec
+INSERT IGNORE INTO tb_webhook_delivery (webhook_id, event_id, event_type, menu_id, payload, occurred_date)
+SELECT w.webhook_id, ?, ?, ?, ?, ? FROM tb_webhook w
+WHERE w.warteg_id = ? AND w.is_active = 1 AND (w.event_types = '' OR FIND_IN_SET(?, w.event_types))
+ORDER BY w.webhook_id
+`
+
+// WebhookDeliveryEnqueue creates a pending delivery of the event for every active webhook of its warteg subscribed to
+// the event type, an event already enqueued for a webhook is skipped so the relay can enqueue it again safely
+func (q *Queries) WebhookDeliveryEnqueue(ctx context.Context, o response.OutboxEvent) error {
+	_, err := q.db.ExecContext(ctx, addWebhookDeliveries,
+		o.EventId,
+		o.EventType,
+		o.MenuId,
+		string(o.Payload),
+		o.CreatedDate,
+		o.WartegId,
+		o.EventType,
+	)
+	return err
+}
+
+const webhookDeliveryColumns = `d.delivery_id, d.webhook_id, d.event_id, d.event_type, d.menu_id, d.payload, d.occurred_date, d.status,
+d.attempts, d.next_attempt_date, d.response_code, d.last_error, d.created_date, d.updated_date`
+
+const getDueWebhookDeliveries = `-- name: DueWebhookDeliveries :many
+SELECT ` + webhookDeliveryColumns + ` FROM tb_webhook_delivery d JOIN tb_webhook w ON w.webhook_id=d.webhook_id
+WHERE d.status = ? AND d.next_attempt_date <= ? AND w.is_active = 1 ORDER BY d.delivery_id LIMIT ?
+FOR UPDATE OF d SKIP LOCKED
+`
+
+// WebhookDeliveryDue returns pending deliveries of active webhooks whose next attempt is not after now, rows are
+// locked until the transaction of ctx ends and rows locked by another sender are skipped
+func (q *Queries) WebhookDeliveryDue(ctx context.Context, now time.Time, limit int) (list []response.WebhookDelivery, err error) {
+	return q.webhookDeliveries(ctx, getDueWebhookDeliveries, constant.WebhookDeliveryPending, now, limit)
+}
+
+// WebhookDeliveryLease moves the next attempt of deliveries to until, a sender holding them posts them meanwhile
+// and a delivery whose sender stopped is due again once the lease ends
+func (q *Queries) WebhookDeliveryLease(ctx context.Context, delivery_ids []int64, until time.Time) error {
+	if len(delivery_ids) == 0 {
+		return nil
+	}
+
+	args := []interface{}{until}
+	for _, id := range delivery_ids {
+		args = append(args, id)
+	}
+
+	_, err := q.db.ExecContext(ctx, `UPDATE tb_webhook_delivery SET next_attempt_date = ?
+WHERE delivery_id IN (`+placeholders(len(delivery_ids))+`)`, args...)
+	return err
+}
+
+// WebhookDeliveryList returns delivery logs of webhook newest first, before_id pages to older deliveries
+func (q *Queries) WebhookDeliveryList(ctx context.Context, webhook_id string, filter request.WebhookDeliveryFilter) (list []response.WebhookDelivery, err error) {
+	query := `SELECT ` + webhookDeliveryColumns + ` FROM tb_webhook_delivery d WHERE d.webhook_id = ?`
+	args := []interface{}{webhook_id}
+
+	if filter.Status != "" {
+		query += ` AND d.status = ?`
+		args = append(args, filter.Status)
+	}
+	if filter.BeforeId > 0 {
+		query += ` AND d.delivery_id < ?`
+		args = append(args, filter.BeforeId)
+	}
+	query += ` ORDER BY d.delivery_id DESC LIMIT ?`
+	args = append(args, filter.Limit)
+
+	return q.webhookDeliveries(ctx, query, args...)
+}
+
+const getWebhookDelivery = `-- name: WebhookDelivery :one
+SELECT ` + webhookDeliveryColumns + ` FROM tb_webhook_delivery d WHERE d.webhook_id = ? AND d.delivery_id = ?
+`
+
+func (q *Queries) WebhookDeliveryDetail(ctx context.Context, webhook_id string, delivery_id int64) (d response.WebhookDelivery, err error) {
+	row := q.db.QueryRowContext(ctx, getWebhookDelivery, webhook_id, delivery_id)
+	err = scanWebhookDelivery(row, &d)
+
+	if err == sql.ErrNoRows {
+		err = constant.ErrNotFound
+	}
+
+	return d, err
+}
+
+func (q *Queries) webhookDeliveries(ctx context.Context, query string, args ...interface{}) (list []response.WebhookDelivery, err error) {
+	rows, err := q.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return
+	}
+
+	defer rows.Close()
+
+	list = []response.WebhookDelivery{}
+	for rows.Next() {
+		var d response.WebhookDelivery
+		err = scanWebhookDelivery(rows, &d)
+		if err != nil {
+			return
+		}
+		list = append(list, d)
+	}
+
+	return list, rows.Err()
+}
+
+func scanWebhookDelivery(row scanner, d *response.WebhookDelivery) error {
+	var payload string
+
+	err := row.Scan(
+		&d.DeliveryId,
+		&d.WebhookId,
+		&d.EventId,
+		&d.EventType,
+		&d.MenuId,
+		&payload,
+		&d.OccurredDate,
+		&d.Status,
+		&d.Attempts,
+		&d.NextAttemptDate,
+		&d.ResponseCode,
+		&d.LastError,
+		&d.CreatedDate,
+		&d.UpdatedDate,
+	)
+	if err != nil {
+		return err
+	}
+
+	d.Payload = []byte(payload)
+	return nil
+}
+
+const updateWebhookDeliveryResult = `-- name: UpdateWebhookDeliveryResult :exec
+UPDATE tb_webhook_delivery SET status=?, attempts=attempts+1, next_attempt_date=?, response_code=?, last_error=LEFT(?, 500),
+updated_date=CURRENT_TIMESTAMP(3) WHERE delivery_id = ?
+`
+
+// WebhookDeliveryResult records an attempt of delivery with the status it leads to
+func (q *Queries) WebhookDeliveryResult(ctx context.Context, delivery_id int64, status string, next_attempt time.Time, response_code int, last_error string) error {
+	_, err := q.db.ExecContext(ctx, updateWebhookDeliveryResult, status, next_attempt, response_code, last_error, delivery_id)
+	return err
+}
+
+const deleteWebhookDeliveries = `-- name: DeleteWebhookDeliveries :exec
+DELETE FROM tb_webhook_delivery WHERE webhook_id = ?
+`
+
+func (q *Queries) WebhookDeliveryDeleteByWebhook(ctx context.Context, webhook_id string) error {
+	_, err := q.db.ExecContext(ctx, deleteWebhookDeliveries, webhook_id)
+	return err
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	log "go.uber.org/zap"
+)
+
+func (u *MenuUsecase) AuditList(ctx context.Context, filter request.AuditFilter) (list []response.AuditLog, err error) {
+	resp := []response.AuditLog{}
+
+	if filter.Limit <= 0 {
+		filter.Limit = constant.AuditLogLimit
+	}
+	if filter.Limit > constant.AuditLogMaxLimit {
+		filter.Limit = constant.AuditLogMaxLimit
+	}
+
+	logs, err := u.menuRepo.AuditList(ctx, filter)
+	if err != nil {
+		return resp, err
+	}
+
+	return logs, nil
+}
+
+// auditNotices collects wartegs of the audit entries of a transaction, their live menu streams are notified once
+// it commits
+type auditNotices struct {
+	wartegs []string
+}
+
+type auditNoticesKey struct{}
+
+// inTx runs fn in one transaction with the change and its audit entries, so a change is never saved without its
+// entry. The row of entity is locked first when id is set so snapshots read in fn are not changed by another writer
+// meanwhile. A transaction already in ctx is joined and notifies when the outer one commits
+func (u *MenuUsecase) inTx(ctx context.Context, entity, id string, fn func(ctx context.Context) error) error {
+	notices, joined := ctx.Value(auditNoticesKey{}).(*auditNotices)
+	if !joined {
+		notices = &auditNotices{}
+		ctx = context.WithValue(ctx, auditNoticesKey{}, notices)
+	}
+
+	err := u.menuRepo.Transaction(ctx, func(ctx context.Context) error {
+		if id != "" {
+			err := u.menuRepo.Lock(ctx, entity, id)
+			if err != nil {
+				return err
+			}
+		}
+		return fn(ctx)
+	})
+	if err != nil || joined {
+		return err
+	}
+
+	for _, wartegId := range notices.wartegs {
+		u.menuHub.Publish(wartegId)
+	}
+
+	return nil
+}
+
+// audit appends an entry to the audit trail within the transaction of the change, a failure fails the change. Every
+// saved change is audited, so live menu streams of its warteg are notified after commit
+func (u *MenuUsecase) audit(ctx context.Context, a request.AuditLog) error {
+	err := u.menuRepo.AuditAdd(ctx, a)
+	if err != nil {
+		log.S().Errorf("audit %s %s %s error : %s ", a.EntityType, a.EntityId, a.Action, err.Error())
+		return err
+	}
+
+	if notices, ok := ctx.Value(auditNoticesKey{}).(*auditNotices); ok {
+		notices.wartegs = append(notices.wartegs, a.WartegId)
+	} else {
+		u.menuHub.Publish(a.WartegId)
+	}
+
+	return nil
+}
+
+// menuSnapshot returns menu detail of any status for the audit trail, nil when the menu does not exist
+func (u *MenuUsecase) menuSnapshot(ctx context.Context, menu_id string) (*response.MenuDetail, error) {
+	mdetail, err := u.menuDetailAt(ctx, menu_id, time.Now())
+	if err == constant.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &mdetail, nil
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/businessday"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	log "go.uber.org/zap"
+)
+
+// MenuAvailability returns availability of published menu
+func (u *MenuUsecase) MenuAvailability(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error) {
+	resp := response.MenuAvailability{
+		MenuId: menu_id,
+	}
+
+	err = u.publishedMenu(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	avail, err := u.menuRepo.MenuAvailability(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	return avail, nil
+}
+
+func (u *MenuUsecase) MenuSoldOut(ctx context.Context, menu_id string) (ma response.MenuAvailability, err error) {
+	resp := response.MenuAvailability{
+		MenuId: menu_id,
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.MenuAvailability(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		businessDate, err := u.businessDate(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuSoldOut(ctx, menu_id, businessDate)
+		if err != nil {
+			return err
+		}
+
+		ma, err = u.auditAvailability(ctx, before)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return ma, nil
+}
+
+func (u *MenuUsecase) MenuRestock(ctx context.Context, menu_id string, rs request.MenuRestock) (ma response.MenuAvailability, err error) {
+	resp := response.MenuAvailability{
+		MenuId: menu_id,
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.MenuAvailability(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		businessDate, err := u.businessDate(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuRestock(ctx, menu_id, businessDate, rs)
+		if err != nil {
+			return err
+		}
+
+		ma, err = u.auditAvailability(ctx, before)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return ma, nil
+}
+
+func (u *MenuUsecase) MenuConsume(ctx context.Context, menu_id string, mc request.MenuConsume) (ma response.MenuAvailability, err error) {
+	resp := response.MenuAvailability{
+		MenuId: menu_id,
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		avail, err := u.menuRepo.MenuAvailability(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		ma = avail
+
+		if avail.IsSoldOut {
+			return constant.ErrOutOfStock
+		}
+
+		// portions are not tracked for this menu, nothing to take
+		if avail.Stock == nil {
+			return nil
+		}
+
+		businessDate, err := u.businessDate(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuConsume(ctx, menu_id, businessDate, mc.Quantity)
+		if err != nil {
+			return err
+		}
+
+		ma, err = u.auditAvailability(ctx, avail)
+		return err
+	})
+
+	if err == constant.ErrNotFound {
+		return resp, err
+	}
+
+	return ma, err
+}
+
+// auditAvailability records availability of menu before and after a change in the audit trail and returns the new one
+func (u *MenuUsecase) auditAvailability(ctx context.Context, before response.MenuAvailability) (ma response.MenuAvailability, err error) {
+	after, err := u.menuRepo.MenuAvailability(ctx, before.MenuId)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityMenuAvailability,
+		EntityId:   before.MenuId,
+		MenuId:     before.MenuId,
+		Action:     constant.AuditUpdated,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+// MenuAvailabilityReset makes every menu available again with its daily stock once opening time is reached in the
+// timezone of its warteg, run at the opening time of every timezone
+func (u *MenuUsecase) MenuAvailabilityReset(ctx context.Context) (err error) {
+	zones, err := u.menuRepo.WartegTimezones(ctx)
+	if err != nil {
+		return err
+	}
+
+	timezones := map[string]bool{"": true}
+	for _, timezone := range zones {
+		timezones[timezone] = true
+	}
+
+	now := time.Now()
+	total := int64(0)
+	for timezone := range timezones {
+		clock, err := u.zoneClock(timezone)
+		if err != nil {
+			log.S().Warn("menu availability reset skips timezone ", timezone, " : ", err)
+			continue
+		}
+
+		businessDate := clock.Date(now)
+		reset, err := u.menuRepo.MenuAvailabilityReset(ctx, businessDate, timezone)
+		if err != nil {
+			return err
+		}
+		if reset > 0 {
+			log.S().Info("menu availability reset for ", businessDate, " in ", clock.Location(), ", menus : ", reset)
+		}
+		total += reset
+	}
+
+	if total > 0 {
+		u.menuHub.Publish("")
+	}
+
+	return nil
+}
+
+// zoneClock returns business day clock of wartegs in timezone, empty for wartegs without opening hours
+func (u *MenuUsecase) zoneClock(timezone string) (*businessday.Clock, error) {
+	if timezone == "" {
+		return u.clock, nil
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.clock.In(loc), nil
+}
+
+// businessDate returns the current business date of menu in the timezone of its warteg
+func (u *MenuUsecase) businessDate(ctx context.Context, menu_id string) (string, error) {
+	timezone, err := u.menuRepo.MenuTimezone(ctx, menu_id)
+	if err != nil {
+		return "", err
+	}
+
+	clock, err := u.zoneClock(timezone)
+	if err != nil {
+		return "", err
+	}
+
+	return clock.Date(time.Now()), nil
+}
@@ -0,0 +1,617 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+	log "go.uber.org/zap"
+)
+
+func (u *MenuUsecase) BrandAdd(ctx context.Context, req request.Brand) (b response.Brand, err error) {
+	resp := response.Brand{
+		BrandName: req.BrandName,
+	}
+
+	req.BrandId = uuid.New().String()
+	err = u.inTx(ctx, "", "", func(ctx context.Context) error {
+		err := u.menuRepo.BrandAdd(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		b, err = u.menuRepo.BrandDetail(ctx, req.BrandId)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityBrand,
+			EntityId:   b.BrandId,
+			Action:     constant.AuditCreated,
+			After:      b,
+		})
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return b, nil
+}
+
+func (u *MenuUsecase) BrandList(ctx context.Context) (list []response.Brand, err error) {
+	resp := []response.Brand{}
+
+	list, err = u.menuRepo.BrandList(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	return list, nil
+}
+
+// BrandMenuAdd saves a menu template of brand and creates its menu at every branch of the brand
+func (u *MenuUsecase) BrandMenuAdd(ctx context.Context, brand_id string, req request.BrandMenu) (bm response.BrandMenu, err error) {
+	resp := response.BrandMenu{
+		BrandId:     brand_id,
+		MenuTypeId:  req.MenuTypeId,
+		MenuName:    req.MenuName,
+		MenuDetail:  req.MenuDetail,
+		MenuPicture: req.MenuPicture,
+		MenuPrice:   req.MenuPrice,
+	}
+
+	req.BrandId = brand_id
+	req.TemplateId = uuid.New().String()
+	err = u.inTx(ctx, constant.AuditEntityBrand, brand_id, func(ctx context.Context) error {
+		err := u.menuRepo.BrandMenuAdd(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		bp, err := u.brandPropagate(ctx, brand_id, req.TemplateId, "")
+		if err != nil {
+			return err
+		}
+
+		bm, err = u.auditBrandMenu(ctx, req.TemplateId, brand_id, constant.AuditCreated, nil, bp)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return bm, nil
+}
+
+func (u *MenuUsecase) BrandMenuList(ctx context.Context, brand_id string) (list []response.BrandMenu, err error) {
+	resp := []response.BrandMenu{}
+
+	_, err = u.menuRepo.BrandDetail(ctx, brand_id)
+	if err != nil {
+		return resp, err
+	}
+
+	list, err = u.menuRepo.BrandMenuList(ctx, brand_id)
+	if err != nil {
+		return resp, err
+	}
+
+	return list, nil
+}
+
+// BrandMenuUpdate updates a menu template of brand and propagates it to every branch of the brand, branches keep
+// their own price and publishing status
+func (u *MenuUsecase) BrandMenuUpdate(ctx context.Context, brand_id, template_id string, req request.BrandMenu) (bm response.BrandMenu, err error) {
+	resp := response.BrandMenu{
+		BrandId:    brand_id,
+		TemplateId: template_id,
+	}
+
+	req.BrandId = brand_id
+	req.TemplateId = template_id
+	err = u.inTx(ctx, constant.AuditEntityBrand, brand_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.BrandMenuDetail(ctx, brand_id, template_id)
+		if err != nil {
+			return err
+		}
+		resp = before
+
+		err = u.menuRepo.BrandMenuUpdate(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		bp, err := u.brandPropagate(ctx, brand_id, template_id, "")
+		if err != nil {
+			return err
+		}
+
+		bm, err = u.auditBrandMenu(ctx, template_id, brand_id, constant.AuditUpdated, &before, bp)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return bm, nil
+}
+
+// BrandMenuDelete deletes a menu template of brand, branch menus created from it are archived
+func (u *MenuUsecase) BrandMenuDelete(ctx context.Context, brand_id, template_id string) (err error) {
+	return u.inTx(ctx, constant.AuditEntityBrand, brand_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.BrandMenuDetail(ctx, brand_id, template_id)
+		if err != nil {
+			return err
+		}
+
+		archived, err := u.menuRepo.BrandMenuDelete(ctx, brand_id, template_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityBrandMenu,
+			EntityId:   template_id,
+			Action:     constant.AuditDeleted,
+			Before:     before,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, menuId := range archived {
+			after, err := u.menuRepo.MenuStatus(ctx, menuId)
+			if err != nil {
+				return err
+			}
+
+			err = u.audit(ctx, request.AuditLog{
+				EntityType: constant.AuditEntityMenuStatus,
+				EntityId:   menuId,
+				MenuId:     menuId,
+				WartegId:   after.WartegId,
+				Action:     constant.AuditUpdated,
+				After:      after,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// BrandWartegSet assigns warteg to brand as a branch, its menus of a previous brand stay as its own menus and a
+// menu is created or updated from every template of the brand
+func (u *MenuUsecase) BrandWartegSet(ctx context.Context, warteg_id string, req request.BrandWarteg) (bw response.BrandWarteg, err error) {
+	resp := response.BrandWarteg{
+		WartegId: warteg_id,
+		BrandId:  req.BrandId,
+	}
+
+	req.WartegId = warteg_id
+	err = u.inTx(ctx, constant.AuditEntityBrandWarteg, warteg_id, func(ctx context.Context) error {
+		action := constant.AuditUpdated
+		before, err := u.menuRepo.BrandWarteg(ctx, warteg_id)
+		if err == constant.ErrNotFound {
+			action = constant.AuditCreated
+		} else if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.BrandWartegSet(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		bp, err := u.brandPropagate(ctx, req.BrandId, "", warteg_id)
+		if err != nil {
+			return err
+		}
+
+		bw, err = u.menuRepo.BrandWarteg(ctx, warteg_id)
+		if err != nil {
+			return err
+		}
+
+		audit := request.AuditLog{
+			EntityType: constant.AuditEntityBrandWarteg,
+			EntityId:   warteg_id,
+			WartegId:   warteg_id,
+			Action:     action,
+			After:      bw,
+		}
+		if action == constant.AuditUpdated {
+			audit.Before = before
+		}
+		err = u.audit(ctx, audit)
+		if err != nil {
+			return err
+		}
+
+		bw.Propagation = &bp
+		return u.auditPropagation(ctx, bp)
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return bw, nil
+}
+
+// BrandWartegDelete removes warteg from its brand, the menus created from templates stay as its own menus
+func (u *MenuUsecase) BrandWartegDelete(ctx context.Context, warteg_id string) (err error) {
+	return u.inTx(ctx, constant.AuditEntityBrandWarteg, warteg_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.BrandWarteg(ctx, warteg_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.BrandWartegDelete(ctx, warteg_id)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityBrandWarteg,
+			EntityId:   warteg_id,
+			WartegId:   warteg_id,
+			Action:     constant.AuditDeleted,
+			Before:     before,
+		})
+	})
+}
+
+// BrandPropagate brings menus of every branch of brand up to date with the templates, a missing branch menu is
+// created again
+func (u *MenuUsecase) BrandPropagate(ctx context.Context, brand_id string) (bp response.BrandPropagation, err error) {
+	err = u.inTx(ctx, constant.AuditEntityBrand, brand_id, func(ctx context.Context) error {
+		err := u.menuRepo.BrandLock(ctx, brand_id)
+		if err != nil {
+			return err
+		}
+
+		bp, err = u.brandPropagate(ctx, brand_id, "", "")
+		if err != nil {
+			return err
+		}
+
+		return u.auditPropagation(ctx, bp)
+	})
+
+	return bp, err
+}
+
+// brandPropagate brings branch menus of brand up to date with their templates, optionally of a template or a branch
+// only, the brand is locked by the transaction of ctx. A missing branch menu is created from its template, an existing
+// one gets type, name, detail and picture of its template. Its price follows the template while it equals the
+// template price last synced, a branch that set its own price keeps it. A price that needs approval is requested as a
+// price change of the branch menu by the actor of ctx and is synced once the change is approved, a branch menu having
+// a pending price change keeps its price until a later propagation. Publishing status and daily availability of
+// branch menus are never changed
+func (u *MenuUsecase) brandPropagate(ctx context.Context, brand_id, template_id, warteg_id string) (bp response.BrandPropagation, err error) {
+	bp = response.BrandPropagation{
+		BrandId: brand_id,
+		Menus:   []response.BrandMenuSync{},
+	}
+
+	branchMenus, err := u.menuRepo.BrandBranchMenus(ctx, brand_id, template_id, warteg_id)
+	if err != nil {
+		return bp, err
+	}
+
+	for _, bm := range branchMenus {
+		if bm.Menu == nil {
+			sync, err := u.brandMenuCreate(ctx, bm)
+			if err != nil {
+				return bp, err
+			}
+
+			bp.Created++
+			bp.Menus = append(bp.Menus, sync)
+			continue
+		}
+
+		t := bm.Template
+		before := *bm.Menu
+		after := before
+		after.MenuTypeId = t.MenuTypeId
+		after.WartegId = bm.WartegId
+		after.MenuName = t.MenuName
+		after.MenuDetail = t.MenuDetail
+		after.MenuPicture = t.MenuPicture
+
+		priceKept := before.MenuPrice != bm.SyncedPrice
+		pricePending := false
+		priceRequested := false
+		switch {
+		case priceKept:
+			bp.PriceOverrides++
+		case before.MenuPrice == t.MenuPrice:
+		default:
+			// the menu row is locked so no change can be requested meanwhile
+			_, err = u.menuRepo.MenuPriceLock(ctx, before.MenuId)
+			if err != nil {
+				return bp, err
+			}
+			pending, err := u.menuRepo.MenuPriceChangePending(ctx, before.MenuId)
+			if err != nil {
+				return bp, err
+			}
+			if pending > 0 {
+				pricePending = true
+				bp.PricePending++
+				break
+			}
+			if u.priceNeedsApproval(before.MenuPrice, t.MenuPrice) {
+				priceRequested = true
+				break
+			}
+			after.MenuPrice = t.MenuPrice
+		}
+
+		// a template price waiting for approval or for a pending change of the branch is not synced yet
+		if bm.SyncedPrice != t.MenuPrice && !pricePending && !priceRequested {
+			err = u.menuRepo.MenuTemplateSet(ctx, t.TemplateId, bm.WartegId, before.MenuId, t.MenuPrice)
+			if err != nil {
+				return bp, err
+			}
+		}
+
+		if after == before && !priceRequested {
+			continue
+		}
+
+		upm := request.MenuUpdate{
+			MenuTypeId:  after.MenuTypeId,
+			WartegId:    after.WartegId,
+			MenuName:    after.MenuName,
+			MenuDetail:  after.MenuDetail,
+			MenuPicture: after.MenuPicture,
+			MenuPrice:   after.MenuPrice,
+		}
+
+		var priceChange *response.MenuPriceChange
+		if priceRequested {
+			upm.MenuPrice = t.MenuPrice
+			mu, err := u.menuPriceChangeRequest(ctx, before.MenuId, upm)
+			if err != nil {
+				return bp, err
+			}
+			priceChange = mu.PriceChange
+
+			err = u.menuRepo.MenuTemplateRequest(ctx, before.MenuId, priceChange.ChangeId)
+			if err != nil {
+				return bp, err
+			}
+			bp.PriceChanges++
+		} else {
+			_, err = u.menuRepo.MenuUpdate(ctx, before.MenuId, upm)
+			if err != nil {
+				return bp, err
+			}
+		}
+
+		bp.Updated++
+		bp.Menus = append(bp.Menus, response.BrandMenuSync{
+			TemplateId:  t.TemplateId,
+			WartegId:    bm.WartegId,
+			MenuId:      before.MenuId,
+			Action:      constant.BrandMenuUpdated,
+			PriceKept:   priceKept,
+			PriceChange: priceChange,
+			Before:      &before,
+			After:       after,
+		})
+	}
+
+	return bp, nil
+}
+
+// brandMenuCreate creates the missing menu of a branch from its template and links it to the template
+func (u *MenuUsecase) brandMenuCreate(ctx context.Context, bm response.BrandBranchMenu) (sync response.BrandMenuSync, err error) {
+	t := bm.Template
+
+	mn, err := u.menuRepo.MenuAdd(ctx, request.Menu{
+		MenuTypeId:  t.MenuTypeId,
+		WartegId:    bm.WartegId,
+		MenuName:    t.MenuName,
+		MenuDetail:  t.MenuDetail,
+		MenuPicture: t.MenuPicture,
+		MenuPrice:   t.MenuPrice,
+	})
+	if err != nil {
+		return sync, err
+	}
+
+	err = u.menuRepo.MenuTemplateSet(ctx, t.TemplateId, bm.WartegId, mn.MenuId, t.MenuPrice)
+	if err != nil {
+		return sync, err
+	}
+
+	return response.BrandMenuSync{
+		TemplateId: t.TemplateId,
+		WartegId:   bm.WartegId,
+		MenuId:     mn.MenuId,
+		Action:     constant.BrandMenuCreated,
+		After: response.MenuUpdate{
+			MenuId:      mn.MenuId,
+			MenuTypeId:  mn.MenuTypeId,
+			WartegId:    mn.WartegId,
+			MenuName:    mn.MenuName,
+			MenuDetail:  mn.MenuDetail,
+			MenuPicture: mn.MenuPicture,
+			MenuPrice:   mn.MenuPrice,
+		},
+	}, nil
+}
+
+// BrandDivergence reports for every branch of brand which template menus differ from their template, a branch menu
+// diverges when it is missing, not published or any of its type, name, detail, picture or price differs
+func (u *MenuUsecase) BrandDivergence(ctx context.Context, brand_id string) (bd response.BrandDivergence, err error) {
+	resp := response.BrandDivergence{
+		BrandId:  brand_id,
+		Branches: []response.BranchDivergence{},
+	}
+
+	brand, err := u.menuRepo.BrandDetail(ctx, brand_id)
+	if err != nil {
+		return resp, err
+	}
+	resp.Templates = brand.Templates
+
+	branches, err := u.menuRepo.BrandWartegList(ctx, brand_id)
+	if err != nil {
+		return resp, err
+	}
+
+	branchMenus, err := u.menuRepo.BrandBranchMenus(ctx, brand_id, "", "")
+	if err != nil {
+		return resp, err
+	}
+
+	index := map[string]int{}
+	for _, bw := range branches {
+		index[bw.WartegId] = len(resp.Branches)
+		resp.Branches = append(resp.Branches, response.BranchDivergence{
+			WartegId: bw.WartegId,
+			Diverged: []response.MenuDivergence{},
+		})
+	}
+
+	for _, bm := range branchMenus {
+		k, ok := index[bm.WartegId]
+		if !ok {
+			continue
+		}
+
+		md := menuDivergence(bm)
+		if len(md.Fields) == 0 {
+			resp.Branches[k].InSync++
+			continue
+		}
+		resp.Branches[k].Diverged = append(resp.Branches[k].Diverged, md)
+	}
+
+	return resp, nil
+}
+
+// menuDivergence compares branch menu with its template, fields is empty when they are the same
+func menuDivergence(bm response.BrandBranchMenu) response.MenuDivergence {
+	t := bm.Template
+	md := response.MenuDivergence{
+		TemplateId:    t.TemplateId,
+		MenuName:      t.MenuName,
+		Fields:        []string{},
+		TemplatePrice: t.MenuPrice,
+	}
+
+	if bm.Menu == nil {
+		md.Fields = append(md.Fields, "missing")
+		return md
+	}
+
+	m := bm.Menu
+	md.MenuId = &m.MenuId
+	md.BranchPrice = &m.MenuPrice
+	md.Status = bm.Status
+
+	if m.MenuTypeId != t.MenuTypeId {
+		md.Fields = append(md.Fields, "menu_type_id")
+	}
+	if m.MenuName != t.MenuName {
+		md.Fields = append(md.Fields, "menu_name")
+	}
+	if m.MenuDetail != t.MenuDetail {
+		md.Fields = append(md.Fields, "menu_detail")
+	}
+	if m.MenuPicture != t.MenuPicture {
+		md.Fields = append(md.Fields, "menu_picture")
+	}
+	if m.MenuPrice != t.MenuPrice {
+		md.Fields = append(md.Fields, "menu_price")
+	}
+	if bm.Status != constant.MenuStatusPublished {
+		md.Fields = append(md.Fields, "status")
+	}
+
+	return md
+}
+
+// auditBrandMenu records menu template before and after a change with the branch menus it changed in the audit trail
+// and returns the saved template with its propagation
+func (u *MenuUsecase) auditBrandMenu(ctx context.Context, template_id, brand_id, action string, before *response.BrandMenu, bp response.BrandPropagation) (bm response.BrandMenu, err error) {
+	after, err := u.menuRepo.BrandMenuDetail(ctx, brand_id, template_id)
+	if err != nil {
+		return after, err
+	}
+
+	audit := request.AuditLog{
+		EntityType: constant.AuditEntityBrandMenu,
+		EntityId:   template_id,
+		Action:     action,
+		After:      after,
+	}
+	if before != nil {
+		audit.Before = before
+	}
+	err = u.audit(ctx, audit)
+	if err != nil {
+		return after, err
+	}
+
+	after.Propagation = &bp
+	return after, u.auditPropagation(ctx, bp)
+}
+
+// auditPropagation records every branch menu created or updated by a propagation in the audit trail
+func (u *MenuUsecase) auditPropagation(ctx context.Context, bp response.BrandPropagation) error {
+	for _, m := range bp.Menus {
+		audit := request.AuditLog{
+			EntityType: constant.AuditEntityMenu,
+			EntityId:   m.MenuId,
+			MenuId:     m.MenuId,
+			WartegId:   m.WartegId,
+			Action:     constant.AuditCreated,
+			After:      m.After,
+		}
+		if m.Before != nil {
+			audit.Action = constant.AuditUpdated
+			audit.Before = m.Before
+		}
+		err := u.audit(ctx, audit)
+		if err != nil {
+			return err
+		}
+
+		if m.PriceChange == nil {
+			continue
+		}
+		err = u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenuPriceChange,
+			EntityId:   m.PriceChange.ChangeId,
+			MenuId:     m.MenuId,
+			WartegId:   m.WartegId,
+			Action:     constant.AuditCreated,
+			After:      m.PriceChange,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(bp.Menus) > 0 || bp.PricePending > 0 {
+		log.S().Info("brand ", bp.BrandId, " propagated, created : ", bp.Created, ", updated : ", bp.Updated, ", price overrides : ", bp.PriceOverrides,
+			", price changes : ", bp.PriceChanges, ", waiting for a pending price change : ", bp.PricePending)
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+func (u *MenuUsecase) MenuBundle(ctx context.Context, menu_id string) (mb response.MenuBundle, err error) {
+	resp := response.MenuBundle{
+		MenuId: menu_id,
+		Items:  []response.MenuBundleItem{},
+	}
+
+	mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	if !mdetail.IsBundle || mdetail.Status != constant.MenuStatusPublished {
+		return resp, constant.ErrNotFound
+	}
+
+	return u.menuBundle(ctx, mdetail)
+}
+
+func (u *MenuUsecase) MenuBundleSet(ctx context.Context, menu_id string, req request.MenuBundle) (mb response.MenuBundle, err error) {
+	resp := response.MenuBundle{
+		MenuId: menu_id,
+		Items:  []response.MenuBundleItem{},
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		// bundles are not nested, a component can not become a bundle
+		usage, err := u.menuRepo.MenuBundleUsage(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+		if usage > 0 && len(req.Items) > 0 {
+			return constant.ErrInvalidBundleItem
+		}
+
+		seen := map[string]bool{}
+		for _, item := range req.Items {
+			if item.MenuId == menu_id || seen[item.MenuId] {
+				return constant.ErrInvalidBundleItem
+			}
+			seen[item.MenuId] = true
+
+			component, err := u.menuRepo.MenuDetail(ctx, item.MenuId)
+			if err == constant.ErrNotFound {
+				return constant.ErrInvalidBundleItem
+			}
+			if err != nil {
+				return err
+			}
+
+			if component.IsBundle || component.WartegId != mdetail.WartegId {
+				return constant.ErrInvalidBundleItem
+			}
+		}
+
+		var before *response.MenuBundle
+		if mdetail.IsBundle {
+			bundle, err := u.menuBundle(ctx, mdetail)
+			if err != nil {
+				return err
+			}
+			before = &bundle
+		}
+
+		err = u.menuRepo.MenuBundleSet(ctx, menu_id, req.Items)
+		if err != nil {
+			return err
+		}
+
+		audit := request.AuditLog{
+			EntityType: constant.AuditEntityMenuBundle,
+			EntityId:   menu_id,
+			MenuId:     menu_id,
+			WartegId:   mdetail.WartegId,
+			Action:     constant.AuditUpdated,
+			Before:     before,
+		}
+
+		if len(req.Items) == 0 {
+			mb = resp
+			return u.audit(ctx, audit)
+		}
+
+		after, err := u.menuRepo.MenuDetail(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		mb, err = u.menuBundle(ctx, after)
+		if err != nil {
+			return err
+		}
+
+		audit.After = mb
+		return u.audit(ctx, audit)
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return mb, nil
+}
+
+// menuBundle loads components of a bundle menu and compares its price with buying them one by one
+func (u *MenuUsecase) menuBundle(ctx context.Context, mdetail response.MenuDetail) (mb response.MenuBundle, err error) {
+	mb = response.MenuBundle{
+		MenuId:      mdetail.MenuId,
+		BundlePrice: mdetail.MenuPrice,
+		IsSoldOut:   mdetail.IsSoldOut,
+	}
+
+	mb.Items, err = u.menuRepo.MenuBundleItemList(ctx, mdetail.MenuId)
+	if err != nil {
+		return mb, err
+	}
+
+	for i := range mb.Items {
+		mb.Items[i].Subtotal = mb.Items[i].MenuPrice * mb.Items[i].Quantity
+		mb.ALaCartePrice += mb.Items[i].Subtotal
+	}
+	mb.Savings = mb.ALaCartePrice - mb.BundlePrice
+
+	return mb, nil
+}
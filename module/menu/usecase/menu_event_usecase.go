@@ -0,0 +1,102 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/event"
+	"github.com/cpartogi/foodmenu/schema/response"
+	log "go.uber.org/zap"
+)
+
+const (
+	outboxRetryMin = 2 * time.Second
+	outboxRetryMax = 10 * time.Minute
+)
+
+// MenuEventRelay enqueues webhook deliveries of pending outbox events and publishes them to the event sink, run
+// periodically by the scheduler. Delivery is at least once, a failed event is retried with exponential backoff until
+// OutboxMaxAttempts and later events of the same menu wait for it so consumers see the changes of a menu in order.
+// A batch is claimed for a lease in a short transaction so relays of several instances share the outbox, every event
+// is then published and recorded on its own without holding a transaction open
+func (u *MenuUsecase) MenuEventRelay(ctx context.Context) (err error) {
+	for {
+		batch, err := u.menuRepo.OutboxClaim(ctx, time.Now().Add(constant.OutboxLeaseSeconds*time.Second), constant.OutboxBatch)
+		if err != nil {
+			return err
+		}
+
+		published := 0
+		for _, o := range batch {
+			ok, err := u.relayEvent(ctx, o)
+			if err != nil {
+				return err
+			}
+			if ok {
+				published++
+			}
+		}
+
+		if len(batch) < constant.OutboxBatch || published == 0 {
+			break
+		}
+	}
+
+	purged, err := u.menuRepo.OutboxPurge(ctx, time.Now().AddDate(0, 0, -constant.OutboxRetentionDays))
+	if err != nil {
+		return err
+	}
+	if purged > 0 {
+		log.S().Info("menu event relay purged ", purged, " published events")
+	}
+
+	return nil
+}
+
+// relayEvent enqueues webhook deliveries of event and publishes it, a failure is recorded for the next attempt or
+// dead letters the event after its last attempt
+func (u *MenuUsecase) relayEvent(ctx context.Context, o response.OutboxEvent) (bool, error) {
+	err := u.menuRepo.WebhookDeliveryEnqueue(ctx, o)
+	if err != nil {
+		return false, err
+	}
+
+	err = u.eventSink.Publish(ctx, outboxEvent(o))
+	if err == nil {
+		return true, u.menuRepo.OutboxPublished(ctx, o.OutboxId)
+	}
+
+	if o.Attempts+1 >= constant.OutboxMaxAttempts {
+		log.S().Error("menu event ", o.EventId, " dead lettered after ", o.Attempts+1, " attempts : ", err)
+		return false, u.menuRepo.OutboxDeadLetter(ctx, o.OutboxId, err.Error())
+	}
+
+	next := time.Now().Add(backoff(o.Attempts, outboxRetryMin, outboxRetryMax))
+	log.S().Warn("menu event ", o.EventId, " publish failed, attempt ", o.Attempts+1, ", retry at ", next, " : ", err)
+
+	return false, u.menuRepo.OutboxFailed(ctx, o.OutboxId, next, err.Error())
+}
+
+func outboxEvent(o response.OutboxEvent) event.Event {
+	return event.Event{
+		EventId:     o.EventId,
+		EventType:   o.EventType,
+		AggregateId: o.MenuId,
+		WartegId:    o.WartegId,
+		OccurredAt:  o.CreatedDate,
+		Data:        o.Payload,
+	}
+}
+
+// backoff doubles the wait from min after every failed attempt up to max
+func backoff(attempts int, min, max time.Duration) time.Duration {
+	wait := min
+	for i := 0; i < attempts && wait < max; i++ {
+		wait *= 2
+	}
+	if wait > max {
+		wait = max
+	}
+	return wait
+}
@@ -0,0 +1,247 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/picture"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+	log "go.uber.org/zap"
+)
+
+func (u *MenuUsecase) MenuImageAdd(ctx context.Context, menu_id string, files []request.MenuImageFile) (list []response.MenuImage, err error) {
+	resp := []response.MenuImage{}
+
+	// process every file first so a bad file does not leave half of the upload stored
+	variants := make([][]picture.Variant, len(files))
+	for i, f := range files {
+		variants[i], err = picture.Process(f.Data, constant.ThumbnailWidth, u.maxPixels)
+		if err == picture.ErrUnsupportedType {
+			return resp, constant.ErrInvalidImageType
+		}
+		if err == picture.ErrTooManyPixels {
+			return resp, constant.ErrImageTooManyPixels
+		}
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	// rows are rolled back and files stored before a later file or the audit fails are removed so the upload is
+	// all or nothing
+	stored := []response.MenuImage{}
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		images, err := u.menuImageList(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		position := len(images)
+		for _, v := range variants {
+			img, err := u.storeImage(ctx, menu_id, position, v)
+			if err != nil {
+				return err
+			}
+			stored = append(stored, storedImage(img))
+			position++
+		}
+
+		list, err = u.auditImages(ctx, menu_id, constant.AuditCreated, images)
+		return err
+	})
+
+	if err != nil {
+		u.removeImageFiles(ctx, stored...)
+		return resp, err
+	}
+
+	return list, nil
+}
+
+// MenuImageList returns images of published menu
+func (u *MenuUsecase) MenuImageList(ctx context.Context, menu_id string) (list []response.MenuImage, err error) {
+	err = u.publishedMenu(ctx, menu_id)
+	if err != nil {
+		return []response.MenuImage{}, err
+	}
+
+	return u.menuImageList(ctx, menu_id)
+}
+
+// menuImageList returns images of menu of any status with their urls
+func (u *MenuUsecase) menuImageList(ctx context.Context, menu_id string) (list []response.MenuImage, err error) {
+	resp := []response.MenuImage{}
+
+	images, err := u.menuRepo.MenuImageList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	for i := range images {
+		images[i].Url = u.storage.URL(images[i].OriginalKey)
+		images[i].ThumbnailUrl = u.storage.URL(images[i].ThumbnailKey)
+		images[i].WebpUrl = u.storage.URL(images[i].WebpKey)
+		images[i].ThumbnailWebpUrl = u.storage.URL(images[i].ThumbnailWebpKey)
+	}
+
+	return images, nil
+}
+
+func (u *MenuUsecase) MenuImageDelete(ctx context.Context, menu_id, image_id string) (err error) {
+	var img response.MenuImage
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		img, err = u.menuRepo.MenuImageDetail(ctx, menu_id, image_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuImageDelete(ctx, menu_id, image_id)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenuImage,
+			EntityId:   image_id,
+			MenuId:     menu_id,
+			Action:     constant.AuditDeleted,
+			Before:     img,
+		})
+	})
+
+	if err != nil {
+		return err
+	}
+
+	// files are removed once the row is gone for good
+	u.removeImageFiles(ctx, img)
+
+	return nil
+}
+
+func (u *MenuUsecase) MenuImageOrder(ctx context.Context, menu_id string, order request.MenuImageOrder) (list []response.MenuImage, err error) {
+	resp := []response.MenuImage{}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		images, err := u.menuImageList(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		if len(images) != len(order.ImageIds) {
+			return constant.ErrInvalidImageOrder
+		}
+
+		existing := map[string]bool{}
+		for _, img := range images {
+			existing[img.ImageId] = true
+		}
+		for _, imageId := range order.ImageIds {
+			if !existing[imageId] {
+				return constant.ErrInvalidImageOrder
+			}
+			delete(existing, imageId)
+		}
+
+		err = u.menuRepo.MenuImageOrder(ctx, menu_id, order.ImageIds)
+		if err != nil {
+			return err
+		}
+
+		list, err = u.auditImages(ctx, menu_id, constant.AuditUpdated, images)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return list, nil
+}
+
+// auditImages records gallery of menu before and after a change in the audit trail and returns the gallery
+func (u *MenuUsecase) auditImages(ctx context.Context, menu_id, action string, before []response.MenuImage) (list []response.MenuImage, err error) {
+	after, err := u.menuImageList(ctx, menu_id)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityMenuImage,
+		EntityId:   menu_id,
+		MenuId:     menu_id,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+// storeImage puts every variant to storage and saves the image row, stored files are removed when saving fails
+func (u *MenuUsecase) storeImage(ctx context.Context, menu_id string, position int, variants []picture.Variant) (img request.MenuImage, err error) {
+	img = request.MenuImage{
+		ImageId:  uuid.New().String(),
+		MenuId:   menu_id,
+		Position: position,
+	}
+
+	keys := map[string]*string{
+		picture.VariantOriginal:      &img.OriginalKey,
+		picture.VariantThumbnail:     &img.ThumbnailKey,
+		picture.VariantWebp:          &img.WebpKey,
+		picture.VariantThumbnailWebp: &img.ThumbnailWebpKey,
+	}
+
+	for _, v := range variants {
+		key := fmt.Sprintf("menus/%s/%s/%s.%s", menu_id, img.ImageId, v.Name, v.Ext)
+		err = u.storage.Put(ctx, key, bytes.NewReader(v.Data), int64(len(v.Data)), v.ContentType)
+		if err != nil {
+			u.removeImageFiles(ctx, storedImage(img))
+			return img, err
+		}
+		*keys[v.Name] = key
+
+		if v.Name == picture.VariantOriginal {
+			img.ContentType = v.ContentType
+			img.FileSize = len(v.Data)
+			img.Width = v.Width
+			img.Height = v.Height
+		}
+	}
+
+	err = u.menuRepo.MenuImageAdd(ctx, img)
+	if err != nil {
+		u.removeImageFiles(ctx, storedImage(img))
+		return img, err
+	}
+
+	return img, nil
+}
+
+func storedImage(img request.MenuImage) response.MenuImage {
+	return response.MenuImage{
+		OriginalKey:      img.OriginalKey,
+		ThumbnailKey:     img.ThumbnailKey,
+		WebpKey:          img.WebpKey,
+		ThumbnailWebpKey: img.ThumbnailWebpKey,
+	}
+}
+
+// removeImageFiles deletes stored files, failures are only logged because the rows are already gone
+func (u *MenuUsecase) removeImageFiles(ctx context.Context, images ...response.MenuImage) {
+	for _, img := range images {
+		for _, key := range []string{img.OriginalKey, img.ThumbnailKey, img.WebpKey, img.ThumbnailWebpKey} {
+			if key == "" {
+				continue
+			}
+			if err := u.storage.Delete(ctx, key); err != nil {
+				log.S().Errorf("delete image file error : %s ", err.Error())
+			}
+		}
+	}
+}
@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/utils"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/go-playground/validator/v10"
+)
+
+// importHeaders maps accepted header names to import column
+var importHeaders = map[string]string{
+	"name":         "name",
+	"menu_name":    "name",
+	"type":         "type",
+	"menu_type":    "type",
+	"price":        "price",
+	"menu_price":   "price",
+	"detail":       "detail",
+	"menu_detail":  "detail",
+	"picture":      "picture",
+	"menu_picture": "picture",
+}
+
+func (u *MenuUsecase) MenuImport(ctx context.Context, imp request.MenuImport) (mi response.MenuImport, err error) {
+	resp := response.MenuImport{
+		DryRun: imp.DryRun,
+		Rows:   []response.MenuImportRow{},
+	}
+
+	if len(imp.Records) == 0 {
+		return resp, constant.ErrInvalidImportFile
+	}
+
+	columns, err := importColumns(imp.Records[0])
+	if err != nil {
+		return resp, err
+	}
+
+	mtypes, err := u.menuRepo.MenuType(ctx)
+	if err != nil && err != constant.ErrNotFound {
+		return resp, err
+	}
+
+	typeIds := map[string]int{}
+	for _, mt := range mtypes {
+		typeIds[strings.ToLower(strings.TrimSpace(mt.MenuTypeName))] = mt.MenuTypeId
+	}
+
+	validate := validator.New()
+	menus := []request.Menu{}
+
+	for i, record := range imp.Records[1:] {
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		m, errs := importRow(record, columns, typeIds, imp.WartegId)
+		messages := importMessages(errs, validate.Struct(m))
+
+		row := response.MenuImportRow{
+			Row:      i + 2,
+			MenuName: m.MenuName,
+			Status:   constant.ImportRowValid,
+		}
+
+		if len(messages) > 0 {
+			row.Status = constant.ImportRowInvalid
+			row.Errors = messages
+			resp.Invalid++
+		} else {
+			menus = append(menus, m)
+			resp.Valid++
+		}
+
+		resp.Rows = append(resp.Rows, row)
+		resp.Total++
+	}
+
+	if resp.Total == 0 {
+		return resp, constant.ErrInvalidImportFile
+	}
+
+	if resp.Invalid > 0 {
+		return resp, constant.ErrImportRowInvalid
+	}
+
+	if imp.DryRun {
+		return resp, nil
+	}
+
+	err = u.inTx(ctx, "", "", func(ctx context.Context) error {
+		added, err := u.menuRepo.MenuImport(ctx, menus)
+		if err != nil {
+			return err
+		}
+
+		for k := range added {
+			err = u.audit(ctx, request.AuditLog{
+				EntityType: constant.AuditEntityMenu,
+				EntityId:   added[k].MenuId,
+				MenuId:     added[k].MenuId,
+				WartegId:   added[k].WartegId,
+				Action:     constant.AuditCreated,
+				After:      added[k],
+			})
+			if err != nil {
+				return err
+			}
+
+			resp.Rows[k].MenuId = added[k].MenuId
+			resp.Rows[k].Status = constant.ImportRowCreated
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return resp, err
+	}
+	resp.Applied = true
+
+	return resp, nil
+}
+
+// importColumns returns position of every known column, name, type and price are mandatory
+func importColumns(header []string) (map[string]int, error) {
+	columns := map[string]int{}
+	for i, h := range header {
+		key := strings.ToLower(strings.TrimSpace(h))
+		key = strings.Replace(key, " ", "_", -1)
+		if col, ok := importHeaders[key]; ok {
+			columns[col] = i
+		}
+	}
+
+	for _, col := range []string{"name", "type", "price"} {
+		if _, ok := columns[col]; !ok {
+			return nil, constant.ErrInvalidImportFile
+		}
+	}
+
+	return columns, nil
+}
+
+// importRow converts one record to request.Menu and returns errors that validator can not detect, keyed by field
+func importRow(record []string, columns map[string]int, typeIds map[string]int, wartegId string) (m request.Menu, errs map[string]string) {
+	errs = map[string]string{}
+
+	value := func(col string) string {
+		i, ok := columns[col]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	m = request.Menu{
+		WartegId:    wartegId,
+		MenuName:    value("name"),
+		MenuDetail:  value("detail"),
+		MenuPicture: value("picture"),
+	}
+
+	if typeName := value("type"); typeName != "" {
+		typeId, ok := typeIds[strings.ToLower(typeName)]
+		if !ok {
+			errs["MenuTypeId"] = fmt.Sprintf("menu type %s not found", typeName)
+		}
+		m.MenuTypeId = typeId
+	}
+
+	if price := value("price"); price != "" {
+		p, err := importPrice(price)
+		if err != nil {
+			errs["MenuPrice"] = "menu price must be whole rupiah such as 15000 or Rp 15.000"
+		}
+		m.MenuPrice = p
+	}
+
+	return m, errs
+}
+
+// importPriceFormat matches whole rupiah, digits only or grouped by thousands with either "." or ","
+var importPriceFormat = regexp.MustCompile(`^(\d+|\d{1,3}(\.\d{3})+|\d{1,3}(,\d{3})+)$`)
+
+// importPrice reads rupiah notation such as "Rp 15.000", a decimal such as "15.5" or "15,50" is rejected instead of
+// being read as a bigger price
+func importPrice(price string) (int, error) {
+	price = strings.TrimPrefix(strings.ToLower(price), "rp")
+	price = strings.ReplaceAll(price, " ", "")
+	if !importPriceFormat.MatchString(price) {
+		return 0, fmt.Errorf("invalid price %s", price)
+	}
+
+	return strconv.Atoi(strings.NewReplacer(".", "", ",", "").Replace(price))
+}
+
+// importMessages combines conversion errors with validator errors, a field is reported once
+func importMessages(errs map[string]string, vErr error) (messages []string) {
+	for _, field := range []string{"MenuTypeId", "MenuPrice"} {
+		if msg, ok := errs[field]; ok {
+			messages = append(messages, msg)
+		}
+	}
+
+	castedObject, ok := vErr.(validator.ValidationErrors)
+	if !ok {
+		return
+	}
+
+	for _, fe := range castedObject {
+		if _, ok := errs[fe.Field()]; ok {
+			continue
+		}
+		messages = append(messages, utils.ValidationMessages(validator.ValidationErrors{fe})...)
+	}
+	return
+}
@@ -0,0 +1,335 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+)
+
+func (u *MenuUsecase) ModifierGroupAdd(ctx context.Context, mg request.ModifierGroup) (group response.ModifierGroup, err error) {
+	resp := response.ModifierGroup{
+		WartegId:  mg.WartegId,
+		GroupName: mg.GroupName,
+		Options:   []response.ModifierOption{},
+	}
+
+	mg, err = normalizeModifierGroup(mg, nil)
+	if err != nil {
+		return resp, err
+	}
+	mg.GroupId = uuid.New().String()
+
+	err = u.inTx(ctx, "", "", func(ctx context.Context) error {
+		err := u.menuRepo.ModifierGroupAdd(ctx, mg)
+		if err != nil {
+			return err
+		}
+
+		group, err = u.auditModifierGroup(ctx, mg.GroupId, constant.AuditCreated, nil)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return group, nil
+}
+
+func (u *MenuUsecase) ModifierGroupUpdate(ctx context.Context, group_id string, mg request.ModifierGroup) (group response.ModifierGroup, err error) {
+	resp := response.ModifierGroup{
+		GroupId:   group_id,
+		WartegId:  mg.WartegId,
+		GroupName: mg.GroupName,
+		Options:   []response.ModifierOption{},
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityModifierGroup, group_id, func(ctx context.Context) error {
+		current, err := u.menuRepo.ModifierGroupDetail(ctx, group_id)
+		if err != nil {
+			return err
+		}
+
+		// a group linked to menus can not move to another warteg
+		if current.WartegId != mg.WartegId {
+			return constant.ErrInvalidModifierLink
+		}
+
+		mg, err = normalizeModifierGroup(mg, current.Options)
+		if err != nil {
+			return err
+		}
+		mg.GroupId = group_id
+
+		err = u.menuRepo.ModifierGroupUpdate(ctx, mg)
+		if err != nil {
+			return err
+		}
+
+		group, err = u.auditModifierGroup(ctx, group_id, constant.AuditUpdated, &current)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return group, nil
+}
+
+func (u *MenuUsecase) ModifierGroupList(ctx context.Context, warteg_id string) (list []response.ModifierGroup, err error) {
+	resp := []response.ModifierGroup{}
+
+	groups, err := u.menuRepo.ModifierGroupList(ctx, warteg_id)
+	if err != nil {
+		return resp, err
+	}
+
+	return groups, nil
+}
+
+func (u *MenuUsecase) ModifierGroupDetail(ctx context.Context, group_id string) (group response.ModifierGroup, err error) {
+	return u.menuRepo.ModifierGroupDetail(ctx, group_id)
+}
+
+func (u *MenuUsecase) ModifierGroupDelete(ctx context.Context, group_id string) (err error) {
+	return u.inTx(ctx, constant.AuditEntityModifierGroup, group_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.ModifierGroupDetail(ctx, group_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.ModifierGroupDelete(ctx, group_id)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityModifierGroup,
+			EntityId:   group_id,
+			WartegId:   before.WartegId,
+			Action:     constant.AuditDeleted,
+			Before:     before,
+		})
+	})
+}
+
+// auditModifierGroup records modifier group before and after a change in the audit trail and returns the saved group
+func (u *MenuUsecase) auditModifierGroup(ctx context.Context, group_id, action string, before *response.ModifierGroup) (group response.ModifierGroup, err error) {
+	after, err := u.menuRepo.ModifierGroupDetail(ctx, group_id)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityModifierGroup,
+		EntityId:   group_id,
+		WartegId:   after.WartegId,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+func (u *MenuUsecase) MenuModifierSet(ctx context.Context, menu_id string, mm request.MenuModifier) (list []response.ModifierGroup, err error) {
+	resp := []response.ModifierGroup{}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		groupIds := []string{}
+		seen := map[string]bool{}
+		for _, groupId := range mm.GroupIds {
+			if seen[groupId] {
+				continue
+			}
+			seen[groupId] = true
+
+			group, err := u.menuRepo.ModifierGroupDetail(ctx, groupId)
+			if err == constant.ErrNotFound || (err == nil && group.WartegId != mdetail.WartegId) {
+				return constant.ErrInvalidModifierLink
+			}
+			if err != nil {
+				return err
+			}
+
+			groupIds = append(groupIds, groupId)
+		}
+
+		before, err := u.menuRepo.MenuModifierList(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuModifierSet(ctx, menu_id, groupIds)
+		if err != nil {
+			return err
+		}
+
+		after, err := u.menuRepo.MenuModifierList(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		list = after
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenuModifier,
+			EntityId:   menu_id,
+			MenuId:     menu_id,
+			WartegId:   mdetail.WartegId,
+			Action:     constant.AuditUpdated,
+			Before:     before,
+			After:      after,
+		})
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return list, nil
+}
+
+func (u *MenuUsecase) MenuModifierList(ctx context.Context, menu_id string) (list []response.ModifierGroup, err error) {
+	resp := []response.ModifierGroup{}
+
+	mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	if mdetail.Status != constant.MenuStatusPublished {
+		return resp, constant.ErrNotFound
+	}
+
+	groups, err := u.menuRepo.MenuModifierList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	return groups, nil
+}
+
+func (u *MenuUsecase) MenuModifierValidate(ctx context.Context, menu_id string, sel request.MenuModifierSelection) (ms response.MenuModifierSelection, err error) {
+	resp := response.MenuModifierSelection{
+		MenuId:     menu_id,
+		Selections: []response.ModifierSelectionResult{},
+	}
+
+	groups, err := u.MenuModifierList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	selected := map[string][]string{}
+	for _, s := range sel.Selections {
+		selected[s.GroupId] = append(selected[s.GroupId], s.OptionIds...)
+	}
+
+	linked := map[string]bool{}
+	for _, g := range groups {
+		linked[g.GroupId] = true
+	}
+	for _, s := range sel.Selections {
+		if !linked[s.GroupId] {
+			resp.Errors = append(resp.Errors, fmt.Sprintf("modifier group %s is not available for this menu", s.GroupId))
+			linked[s.GroupId] = true
+		}
+	}
+
+	for _, g := range groups {
+		result, errs := selectModifierOptions(g, selected[g.GroupId])
+		resp.Errors = append(resp.Errors, errs...)
+
+		if len(result.Options) > 0 {
+			resp.Selections = append(resp.Selections, result)
+			for _, o := range result.Options {
+				resp.ModifierPrice += o.OptionPrice
+			}
+		}
+	}
+
+	if len(resp.Errors) > 0 {
+		return resp, constant.ErrInvalidModifierSelection
+	}
+	resp.Valid = true
+
+	return resp, nil
+}
+
+// normalizeModifierGroup checks selection limits against the options and gives every new option an id,
+// ids of current options are kept so selections stored elsewhere stay valid
+func normalizeModifierGroup(mg request.ModifierGroup, current []response.ModifierOption) (request.ModifierGroup, error) {
+	if mg.IsRequired && mg.MinSelect == 0 {
+		mg.MinSelect = 1
+	}
+	mg.IsRequired = mg.MinSelect > 0
+
+	if mg.MinSelect > mg.MaxSelect || mg.MaxSelect > len(mg.Options) {
+		return mg, constant.ErrInvalidModifierGroup
+	}
+
+	existing := map[string]bool{}
+	for _, o := range current {
+		existing[o.OptionId] = true
+	}
+
+	options := make([]request.ModifierOption, len(mg.Options))
+	for i, o := range mg.Options {
+		if !existing[o.OptionId] {
+			o.OptionId = uuid.New().String()
+		}
+		delete(existing, o.OptionId)
+		options[i] = o
+	}
+	mg.Options = options
+
+	return mg, nil
+}
+
+// selectModifierOptions resolves selected option ids of one group and reports every rule they break
+func selectModifierOptions(g response.ModifierGroup, optionIds []string) (result response.ModifierSelectionResult, errs []string) {
+	result = response.ModifierSelectionResult{
+		GroupId:   g.GroupId,
+		GroupName: g.GroupName,
+		Options:   []response.ModifierOption{},
+	}
+
+	options := map[string]response.ModifierOption{}
+	for _, o := range g.Options {
+		options[o.OptionId] = o
+	}
+
+	seen := map[string]bool{}
+	for _, optionId := range optionIds {
+		o, ok := options[optionId]
+		if !ok {
+			errs = append(errs, fmt.Sprintf("option %s is not part of %s", optionId, g.GroupName))
+			continue
+		}
+		if seen[optionId] {
+			errs = append(errs, fmt.Sprintf("option %s is selected more than once in %s", o.OptionName, g.GroupName))
+			continue
+		}
+		seen[optionId] = true
+		result.Options = append(result.Options, o)
+	}
+
+	if len(result.Options) < g.MinSelect {
+		errs = append(errs, fmt.Sprintf("%s requires at least %d selection", g.GroupName, g.MinSelect))
+	}
+	if len(result.Options) > g.MaxSelect {
+		errs = append(errs, fmt.Sprintf("%s allows at most %d selection", g.GroupName, g.MaxSelect))
+	}
+
+	return result, errs
+}
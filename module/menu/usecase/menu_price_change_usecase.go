@@ -0,0 +1,239 @@
+package usecase
+
+import (
+	"context"
+	"math"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+	log "go.uber.org/zap"
+)
+
+// MenuPriceChangeList returns price changes oldest first, pending ones when no status is given
+func (u *MenuUsecase) MenuPriceChangeList(ctx context.Context, filter request.MenuPriceChangeFilter) (list []response.MenuPriceChange, err error) {
+	resp := []response.MenuPriceChange{}
+
+	if filter.Status == "" {
+		filter.Status = constant.PriceChangePending
+	}
+
+	list, err = u.menuRepo.MenuPriceChangeList(ctx, filter)
+	if err != nil {
+		return resp, err
+	}
+
+	return list, nil
+}
+
+// MenuPriceChangeApprove sets the requested price of a pending change as menu price, reviewed by the actor of ctx
+func (u *MenuUsecase) MenuPriceChangeApprove(ctx context.Context, menu_id, change_id string, req request.MenuPriceChangeReview) (pc response.MenuPriceChange, err error) {
+	resp := response.MenuPriceChange{
+		ChangeId: change_id,
+		MenuId:   menu_id,
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.pendingPriceChange(ctx, menu_id, change_id)
+		if err != nil {
+			return err
+		}
+
+		menuBefore, err := u.menuSnapshot(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		schedule, err := u.priceChangeSchedule(ctx, change_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuPriceChangeApprove(ctx, before, req.Note)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuTemplateReview(ctx, change_id, constant.PriceChangeApproved, before.NewPrice)
+		if err != nil {
+			return err
+		}
+
+		menuAfter, err := u.menuSnapshot(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenu,
+			EntityId:   menu_id,
+			MenuId:     menu_id,
+			WartegId:   before.WartegId,
+			Action:     constant.AuditUpdated,
+			Before:     menuBefore,
+			After:      menuAfter,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = u.auditPriceChangeSchedule(ctx, schedule)
+		if err != nil {
+			return err
+		}
+
+		pc, err = u.auditPriceChange(ctx, change_id, &before)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	log.S().Info("menu price change ", change_id, " approved by ", actor.FromContext(ctx), ", menu : ", menu_id, ", price : ", pc.NewPrice)
+
+	return pc, nil
+}
+
+// MenuPriceChangeReject rejects a pending price change keeping menu price, reviewed by the actor of ctx
+func (u *MenuUsecase) MenuPriceChangeReject(ctx context.Context, menu_id, change_id string, req request.MenuPriceChangeReview) (pc response.MenuPriceChange, err error) {
+	resp := response.MenuPriceChange{
+		ChangeId: change_id,
+		MenuId:   menu_id,
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.pendingPriceChange(ctx, menu_id, change_id)
+		if err != nil {
+			return err
+		}
+
+		schedule, err := u.priceChangeSchedule(ctx, change_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuPriceChangeReject(ctx, change_id, req.Note)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuTemplateReview(ctx, change_id, constant.PriceChangeRejected, before.NewPrice)
+		if err != nil {
+			return err
+		}
+
+		err = u.auditPriceChangeSchedule(ctx, schedule)
+		if err != nil {
+			return err
+		}
+
+		pc, err = u.auditPriceChange(ctx, change_id, &before)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return pc, nil
+}
+
+// pendingPriceChange returns a pending price change of menu that the actor of ctx may review, maker and checker
+// of a change must be different identified actors
+func (u *MenuUsecase) pendingPriceChange(ctx context.Context, menu_id, change_id string) (pc response.MenuPriceChange, err error) {
+	pc, err = u.menuRepo.MenuPriceChangeDetail(ctx, change_id)
+	if err != nil {
+		return pc, err
+	}
+
+	if pc.MenuId != menu_id || pc.Status != constant.PriceChangePending {
+		return pc, constant.ErrNotFound
+	}
+
+	reviewer := actor.FromContext(ctx)
+	if reviewer == actor.Anonymous || reviewer == pc.RequestedBy {
+		return pc, constant.ErrPriceChangeReviewer
+	}
+
+	return pc, nil
+}
+
+// auditPriceChange records price change before and after its review in the audit trail and returns the saved change
+func (u *MenuUsecase) auditPriceChange(ctx context.Context, change_id string, before *response.MenuPriceChange) (pc response.MenuPriceChange, err error) {
+	after, err := u.menuRepo.MenuPriceChangeDetail(ctx, change_id)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityMenuPriceChange,
+		EntityId:   change_id,
+		MenuId:     after.MenuId,
+		WartegId:   after.WartegId,
+		Action:     constant.AuditUpdated,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+// priceChangeSchedule returns the schedule which requested price change, nil for a change made by a menu update
+func (u *MenuUsecase) priceChangeSchedule(ctx context.Context, change_id string) (*response.MenuPriceSchedule, error) {
+	ps, err := u.menuRepo.MenuPriceScheduleByChange(ctx, change_id)
+	if err == constant.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &ps, nil
+}
+
+// auditPriceChangeSchedule records the schedule which requested a reviewed price change in the audit trail
+func (u *MenuUsecase) auditPriceChangeSchedule(ctx context.Context, before *response.MenuPriceSchedule) error {
+	if before == nil {
+		return nil
+	}
+
+	_, err := u.auditPriceSchedule(ctx, before.ScheduleId, constant.AuditUpdated, before)
+	return err
+}
+
+// menuPriceChangeRequest updates menu keeping its price and saves the requested price as a change waiting for approval
+func (u *MenuUsecase) menuPriceChangeRequest(ctx context.Context, menu_id string, upm request.MenuUpdate) (mu response.MenuUpdate, err error) {
+	changeId := uuid.New().String()
+
+	mu, err = u.menuRepo.MenuPriceChangeRequest(ctx, changeId, menu_id, upm)
+	if err != nil {
+		return mu, err
+	}
+
+	pc, err := u.menuRepo.MenuPriceChangeDetail(ctx, changeId)
+	if err != nil {
+		return mu, err
+	}
+	mu.PriceChange = &pc
+
+	log.S().Info("menu price change ", changeId, " waiting for approval, menu : ", menu_id, ", price : ", pc.OldPrice, " to ", pc.NewPrice)
+
+	return mu, nil
+}
+
+// priceNeedsApproval tells whether changing menu price from old_price to new_price goes beyond the approval threshold
+// in percent of old_price, a threshold of zero or less turns approval off
+func (u *MenuUsecase) priceNeedsApproval(old_price, new_price int) bool {
+	if u.priceApproval <= 0 || old_price == new_price {
+		return false
+	}
+	if old_price <= 0 {
+		return true
+	}
+
+	change := math.Abs(float64(new_price-old_price)) * 100 / float64(old_price)
+	return change > u.priceApproval
+}
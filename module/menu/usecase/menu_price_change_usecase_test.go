@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPriceNeedsApproval(t *testing.T) {
+	type input struct {
+		priceApproval float64
+		oldPrice      int
+		newPrice      int
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput bool
+	}{
+		{
+			name:           "#1 approval turned off",
+			expectedInput:  input{priceApproval: 0, oldPrice: 10000, newPrice: 50000},
+			expectedOutput: false,
+		},
+		{
+			name:           "#2 approval turned off by a negative threshold",
+			expectedInput:  input{priceApproval: -5, oldPrice: 10000, newPrice: 50000},
+			expectedOutput: false,
+		},
+		{
+			name:           "#3 same price",
+			expectedInput:  input{priceApproval: 10, oldPrice: 10000, newPrice: 10000},
+			expectedOutput: false,
+		},
+		{
+			name:           "#4 increase at the threshold",
+			expectedInput:  input{priceApproval: 10, oldPrice: 10000, newPrice: 11000},
+			expectedOutput: false,
+		},
+		{
+			name:           "#5 increase beyond the threshold",
+			expectedInput:  input{priceApproval: 10, oldPrice: 10000, newPrice: 11001},
+			expectedOutput: true,
+		},
+		{
+			name:           "#6 decrease beyond the threshold",
+			expectedInput:  input{priceApproval: 10, oldPrice: 10000, newPrice: 8000},
+			expectedOutput: true,
+		},
+		{
+			name:           "#7 decrease within the threshold",
+			expectedInput:  input{priceApproval: 10, oldPrice: 10000, newPrice: 9500},
+			expectedOutput: false,
+		},
+		{
+			name:           "#8 price of a free menu",
+			expectedInput:  input{priceApproval: 10, oldPrice: 0, newPrice: 500},
+			expectedOutput: true,
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			u := MenuUsecase{priceApproval: testCase.expectedInput.priceApproval}
+
+			needsApproval := u.priceNeedsApproval(testCase.expectedInput.oldPrice, testCase.expectedInput.newPrice)
+			assert.Equal(t, testCase.expectedOutput, needsApproval)
+		})
+	}
+}
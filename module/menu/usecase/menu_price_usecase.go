@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+	log "go.uber.org/zap"
+)
+
+func (u *MenuUsecase) MenuPrices(ctx context.Context, menu_id string) (mp response.MenuPrices, err error) {
+	resp := response.MenuPrices{
+		MenuId:    menu_id,
+		Scheduled: []response.MenuPriceSchedule{},
+		History:   []response.MenuPriceHistory{},
+	}
+
+	mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	if mdetail.Status != constant.MenuStatusPublished {
+		return resp, constant.ErrNotFound
+	}
+	resp.MenuPrice = mdetail.MenuPrice
+
+	resp.Scheduled, err = u.menuRepo.MenuPriceScheduleList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	resp.History, err = u.menuRepo.MenuPriceHistoryList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+func (u *MenuUsecase) MenuPriceScheduleAdd(ctx context.Context, menu_id string, req request.MenuPriceSchedule) (ps response.MenuPriceSchedule, err error) {
+	resp := response.MenuPriceSchedule{
+		MenuId:        menu_id,
+		MenuPrice:     req.MenuPrice,
+		EffectiveDate: req.EffectiveDate,
+		Status:        constant.PriceSchedulePending,
+	}
+
+	if !req.EffectiveDate.After(time.Now()) {
+		return resp, constant.ErrInvalidPriceSchedule
+	}
+
+	scheduleId := uuid.New().String()
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		err := u.menuRepo.MenuPriceScheduleAdd(ctx, scheduleId, menu_id, req)
+		if err != nil {
+			return err
+		}
+
+		ps, err = u.auditPriceSchedule(ctx, scheduleId, constant.AuditCreated, nil)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return ps, nil
+}
+
+func (u *MenuUsecase) MenuPriceScheduleCancel(ctx context.Context, menu_id, schedule_id string) (err error) {
+	return u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.MenuPriceScheduleDetail(ctx, schedule_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuPriceScheduleStatus(ctx, menu_id, schedule_id, constant.PriceScheduleCancelled)
+		if err != nil {
+			return err
+		}
+
+		_, err = u.auditPriceSchedule(ctx, schedule_id, constant.AuditUpdated, &before)
+		return err
+	})
+}
+
+// auditPriceSchedule records scheduled price before and after a change in the audit trail and returns the saved schedule
+func (u *MenuUsecase) auditPriceSchedule(ctx context.Context, schedule_id, action string, before *response.MenuPriceSchedule) (ps response.MenuPriceSchedule, err error) {
+	after, err := u.menuRepo.MenuPriceScheduleDetail(ctx, schedule_id)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityMenuPriceSchedule,
+		EntityId:   schedule_id,
+		MenuId:     after.MenuId,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+// MenuPriceScheduleRun applies every price schedule that became effective, run periodically by the scheduler,
+// a price change is recorded as made by the actor who scheduled it. A scheduled price beyond the approval threshold
+// of the price at that time becomes a price change requested by that actor, a menu having a pending price change
+// keeps its schedules until the change is reviewed
+func (u *MenuUsecase) MenuPriceScheduleRun(ctx context.Context) (err error) {
+	for {
+		due, err := u.menuRepo.MenuPriceScheduleDue(ctx, time.Now(), constant.PriceScheduleBatch)
+		if err != nil {
+			return err
+		}
+
+		applied := 0
+		for _, ps := range due {
+			actorCtx := actor.NewContext(ctx, ps.CreatedBy)
+			err = u.inTx(actorCtx, constant.AuditEntityMenu, ps.MenuId, func(ctx context.Context) error {
+				before, err := u.menuSnapshot(ctx, ps.MenuId)
+				if err != nil {
+					return err
+				}
+				if before == nil {
+					return constant.ErrNotFound
+				}
+
+				price, err := u.menuRepo.MenuPriceLock(ctx, ps.MenuId)
+				if err != nil {
+					return err
+				}
+
+				if u.priceNeedsApproval(price, ps.MenuPrice) {
+					return u.menuPriceScheduleRequest(ctx, ps, before)
+				}
+
+				err = u.menuRepo.MenuPriceScheduleApply(ctx, ps)
+				if err != nil {
+					return err
+				}
+
+				after, err := u.menuSnapshot(ctx, ps.MenuId)
+				if err != nil {
+					return err
+				}
+
+				return u.audit(ctx, request.AuditLog{
+					EntityType: constant.AuditEntityMenu,
+					EntityId:   ps.MenuId,
+					MenuId:     ps.MenuId,
+					WartegId:   after.WartegId,
+					Action:     constant.AuditUpdated,
+					Before:     before,
+					After:      after,
+				})
+			})
+			if err == constant.ErrNotFound {
+				// cancelled, applied by another instance or menu deleted meanwhile
+				continue
+			}
+			if err == constant.ErrPriceChangePending {
+				log.S().Info("menu price schedule ", ps.ScheduleId, " waits for the pending price change of menu : ", ps.MenuId)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			applied++
+			log.S().Info("menu price schedule ", ps.ScheduleId, " applied, menu : ", ps.MenuId, ", price : ", ps.MenuPrice)
+		}
+
+		if len(due) < constant.PriceScheduleBatch || applied == 0 {
+			return nil
+		}
+	}
+}
+
+// menuPriceScheduleRequest saves the price of a due schedule as a change waiting for approval and keeps the schedule
+// requested until the change is reviewed, the other fields of menu are kept as they are in before
+func (u *MenuUsecase) menuPriceScheduleRequest(ctx context.Context, ps response.MenuPriceSchedule, before *response.MenuDetail) error {
+	mu, err := u.menuPriceChangeRequest(ctx, ps.MenuId, request.MenuUpdate{
+		MenuTypeId:  before.MenuTypeId,
+		WartegId:    before.WartegId,
+		MenuName:    before.MenuName,
+		MenuDetail:  before.MenuDetail,
+		MenuPicture: before.MenuPicture,
+		MenuPrice:   ps.MenuPrice,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = u.menuRepo.MenuPriceScheduleRequest(ctx, ps.MenuId, ps.ScheduleId, mu.PriceChange.ChangeId)
+	if err != nil {
+		return err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityMenuPriceChange,
+		EntityId:   mu.PriceChange.ChangeId,
+		MenuId:     ps.MenuId,
+		WartegId:   mu.PriceChange.WartegId,
+		Action:     constant.AuditCreated,
+		After:      mu.PriceChange,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = u.auditPriceSchedule(ctx, ps.ScheduleId, constant.AuditUpdated, &ps)
+	return err
+}
@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/pkg/businessday"
+	"github.com/cpartogi/foodmenu/pkg/pubsub"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var errorMenu = errors.New("error menu")
+
+// newTestMenuUsecase creates usecase on mocked repository with the business day in UTC
+func newTestMenuUsecase(t *testing.T, mockRepo *mocks.Repository, priceApproval float64) *MenuUsecase {
+	clock, err := businessday.New("UTC", "06:00")
+	assert.NoError(t, err)
+
+	return NewMenuUsecase(mockRepo, nil, clock, nil, nil, pubsub.NewHub(), nil, priceApproval, 0, time.Second).(*MenuUsecase)
+}
+
+// mockTransaction runs transactions of usecase on mocked repository and accepts their locks and audit entries
+func mockTransaction(mockRepo *mocks.Repository) {
+	mockRepo.
+		On("Transaction", mock.Anything, mock.Anything).
+		Return(func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
+	mockRepo.
+		On("Lock", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+	mockRepo.
+		On("AuditAdd", mock.Anything, mock.Anything).
+		Return(nil).Maybe()
+}
+
+// mockMenuSnapshot returns md as the menu of its id without images, variants, modifiers, windows and promotions
+func mockMenuSnapshot(mockRepo *mocks.Repository, md response.MenuDetail) {
+	mockRepo.
+		On("MenuDetail", mock.Anything, md.MenuId).
+		Return(md, nil).Maybe()
+	mockRepo.
+		On("MenuImageList", mock.Anything, md.MenuId).
+		Return([]response.MenuImage{}, nil).Maybe()
+	mockRepo.
+		On("MenuVariantList", mock.Anything, md.MenuId).
+		Return([]response.MenuVariant{}, nil).Maybe()
+	mockRepo.
+		On("MenuModifierList", mock.Anything, md.MenuId).
+		Return([]response.ModifierGroup{}, nil).Maybe()
+	mockRepo.
+		On("MenuWindows", mock.Anything, md.MenuId).
+		Return(response.MenuWindows{MenuId: md.MenuId}, nil).Maybe()
+	mockRepo.
+		On("PromotionCurrent", mock.Anything, mock.Anything).
+		Return([]response.Promotion{}, nil).Maybe()
+	mockRepo.
+		On("WartegTimezones", mock.Anything).
+		Return(map[string]string{}, nil).Maybe()
+}
+
+func TestMenuPriceScheduleRun(t *testing.T) {
+	type input struct {
+		due []response.MenuPriceSchedule
+	}
+
+	type output struct {
+		err error
+	}
+
+	menuDetail := response.MenuDetail{
+		MenuId:     "m1",
+		MenuTypeId: 1,
+		WartegId:   "w1",
+		MenuName:   "sayur asem",
+		MenuPrice:  10000,
+	}
+	schedule := func(schedule_id string, price int) response.MenuPriceSchedule {
+		return response.MenuPriceSchedule{
+			ScheduleId:    schedule_id,
+			MenuId:        "m1",
+			MenuPrice:     price,
+			EffectiveDate: time.Date(2027, 3, 10, 0, 0, 0, 0, time.UTC),
+			Status:        constant.PriceSchedulePending,
+			CreatedBy:     "alice",
+		}
+	}
+	// scheduledBy matches a context acting as the actor who scheduled the price
+	scheduledBy := mock.MatchedBy(func(ctx context.Context) bool {
+		return actor.FromContext(ctx) == "alice"
+	})
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockRepo *mocks.Repository,
+		)
+	}{
+		{
+			name: "#1 apply schedule within the approval threshold",
+			expectedInput: input{
+				due: []response.MenuPriceSchedule{schedule("s1", 11000)},
+			},
+			expectedOutput: output{nil},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockMenuSnapshot(mockRepo, menuDetail)
+				mockRepo.
+					On("MenuPriceLock", mock.Anything, "m1").
+					Return(10000, nil)
+				mockRepo.
+					On("MenuPriceScheduleApply", scheduledBy, payload.due[0]).
+					Return(nil).Once()
+			},
+		},
+		{
+			name: "#2 request approval of schedule beyond the approval threshold",
+			expectedInput: input{
+				due: []response.MenuPriceSchedule{schedule("s1", 15000)},
+			},
+			expectedOutput: output{nil},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockMenuSnapshot(mockRepo, menuDetail)
+				mockRepo.
+					On("MenuPriceLock", mock.Anything, "m1").
+					Return(10000, nil)
+				mockRepo.
+					On("MenuPriceChangeRequest", scheduledBy, mock.Anything, "m1", request.MenuUpdate{
+						MenuTypeId: 1,
+						WartegId:   "w1",
+						MenuName:   "sayur asem",
+						MenuPrice:  15000,
+					}).
+					Return(response.MenuUpdate{MenuId: "m1", MenuPrice: 10000}, nil).Once()
+				mockRepo.
+					On("MenuPriceChangeDetail", mock.Anything, mock.Anything).
+					Return(response.MenuPriceChange{ChangeId: "c1", MenuId: "m1", WartegId: "w1", OldPrice: 10000, NewPrice: 15000}, nil)
+				mockRepo.
+					On("MenuPriceScheduleRequest", mock.Anything, "m1", "s1", "c1").
+					Return(nil).Once()
+				mockRepo.
+					On("MenuPriceScheduleDetail", mock.Anything, "s1").
+					Return(schedule("s1", 15000), nil)
+			},
+		},
+		{
+			name: "#3 skip schedule of menu deleted meanwhile",
+			expectedInput: input{
+				due: []response.MenuPriceSchedule{schedule("s1", 11000)},
+			},
+			expectedOutput: output{nil},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockRepo.
+					On("MenuDetail", mock.Anything, "m1").
+					Return(response.MenuDetail{}, constant.ErrNotFound)
+			},
+		},
+		{
+			name: "#4 skip schedule of menu waiting for a pending price change",
+			expectedInput: input{
+				due: []response.MenuPriceSchedule{schedule("s1", 11000)},
+			},
+			expectedOutput: output{nil},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockMenuSnapshot(mockRepo, menuDetail)
+				mockRepo.
+					On("MenuPriceLock", mock.Anything, "m1").
+					Return(10000, nil)
+				mockRepo.
+					On("MenuPriceScheduleApply", mock.Anything, payload.due[0]).
+					Return(constant.ErrPriceChangePending).Once()
+			},
+		},
+		{
+			name: "#5 stop on error of applying schedule",
+			expectedInput: input{
+				due: []response.MenuPriceSchedule{schedule("s1", 11000), schedule("s2", 12000)},
+			},
+			expectedOutput: output{errorMenu},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockMenuSnapshot(mockRepo, menuDetail)
+				mockRepo.
+					On("MenuPriceLock", mock.Anything, "m1").
+					Return(10000, nil)
+				mockRepo.
+					On("MenuPriceScheduleApply", mock.Anything, payload.due[0]).
+					Return(errorMenu).Once()
+			},
+		},
+		{
+			name: "#6 read due schedules once when nothing of a full batch is applied",
+			expectedInput: input{
+				due: func() []response.MenuPriceSchedule {
+					due := make([]response.MenuPriceSchedule, constant.PriceScheduleBatch)
+					for i := range due {
+						due[i] = schedule("s1", 11000)
+					}
+					return due
+				}(),
+			},
+			expectedOutput: output{nil},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockRepo.
+					On("MenuDetail", mock.Anything, "m1").
+					Return(response.MenuDetail{}, constant.ErrNotFound)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(mocks.Repository)
+			mockTransaction(mockRepo)
+			mockRepo.
+				On("MenuPriceScheduleDue", mock.Anything, mock.Anything, constant.PriceScheduleBatch).
+				Return(testCase.expectedInput.due, nil).Once()
+
+			testCase.configureMock(
+				testCase.expectedInput,
+				mockRepo,
+			)
+
+			u := newTestMenuUsecase(t, mockRepo, 20)
+
+			err := u.MenuPriceScheduleRun(context.Background())
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+
+	t.Run("#7 error reading due schedules", func(t *testing.T) {
+		mockRepo := new(mocks.Repository)
+		mockRepo.
+			On("MenuPriceScheduleDue", mock.Anything, mock.Anything, constant.PriceScheduleBatch).
+			Return(nil, errorMenu).Once()
+
+		u := newTestMenuUsecase(t, mockRepo, 20)
+
+		err := u.MenuPriceScheduleRun(context.Background())
+		assert.Equal(t, errorMenu, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
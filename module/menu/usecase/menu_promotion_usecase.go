@@ -0,0 +1,479 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+	log "go.uber.org/zap"
+)
+
+func (u *MenuUsecase) PromotionAdd(ctx context.Context, p request.Promotion) (promo response.Promotion, err error) {
+	resp := response.Promotion{
+		PromotionName: p.PromotionName,
+		PromotionType: p.PromotionType,
+		DaysOfWeek:    []int{},
+	}
+
+	p, err = u.normalizePromotion(ctx, p)
+	if err != nil {
+		return resp, err
+	}
+	p.PromotionId = uuid.New().String()
+
+	err = u.inTx(ctx, "", "", func(ctx context.Context) error {
+		err := u.menuRepo.PromotionAdd(ctx, p)
+		if err != nil {
+			return err
+		}
+
+		promo, err = u.auditPromotion(ctx, p.PromotionId, constant.AuditCreated, nil)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return promo, nil
+}
+
+func (u *MenuUsecase) PromotionUpdate(ctx context.Context, promotion_id string, p request.Promotion) (promo response.Promotion, err error) {
+	resp := response.Promotion{
+		PromotionId:   promotion_id,
+		PromotionName: p.PromotionName,
+		PromotionType: p.PromotionType,
+		DaysOfWeek:    []int{},
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityPromotion, promotion_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.PromotionDetail(ctx, promotion_id)
+		if err != nil {
+			return err
+		}
+
+		p, err = u.normalizePromotion(ctx, p)
+		if err != nil {
+			return err
+		}
+		p.PromotionId = promotion_id
+
+		err = u.menuRepo.PromotionUpdate(ctx, p)
+		if err != nil {
+			return err
+		}
+
+		promo, err = u.auditPromotion(ctx, promotion_id, constant.AuditUpdated, &before)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return promo, nil
+}
+
+func (u *MenuUsecase) PromotionList(ctx context.Context, warteg_id string) (list []response.Promotion, err error) {
+	resp := []response.Promotion{}
+
+	promos, err := u.menuRepo.PromotionList(ctx, warteg_id)
+	if err != nil {
+		return resp, err
+	}
+
+	return promos, nil
+}
+
+func (u *MenuUsecase) PromotionDetail(ctx context.Context, promotion_id string) (promo response.Promotion, err error) {
+	return u.menuRepo.PromotionDetail(ctx, promotion_id)
+}
+
+func (u *MenuUsecase) PromotionDelete(ctx context.Context, promotion_id string) (err error) {
+	return u.inTx(ctx, constant.AuditEntityPromotion, promotion_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.PromotionDetail(ctx, promotion_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.PromotionDelete(ctx, promotion_id)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityPromotion,
+			EntityId:   promotion_id,
+			MenuId:     promotionMenu(before),
+			WartegId:   before.WartegId,
+			Action:     constant.AuditDeleted,
+			Before:     before,
+		})
+	})
+}
+
+// PromotionBoundaryRun records menus of every promotion whose run started or ended since it was last recorded in the
+// change log, run periodically by the scheduler. The effective price changes at those moments without a write, this
+// way syncs, events, streams and the search index see it
+func (u *MenuUsecase) PromotionBoundaryRun(ctx context.Context) (err error) {
+	promos, err := u.currentPromotions(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range promos.list {
+		boundary := promos.boundary(p)
+		if !boundary.After(p.UpdatedDate) {
+			continue
+		}
+
+		touched, err := u.menuRepo.PromotionBoundary(ctx, p, boundary)
+		if err != nil {
+			return err
+		}
+		if !touched {
+			continue
+		}
+
+		u.menuHub.Publish(p.WartegId)
+		log.S().Info("promotion ", p.PromotionId, " boundary at ", boundary.Format(time.RFC3339), " recorded")
+	}
+
+	return nil
+}
+
+// auditPromotion records promotion before and after a change in the audit trail and returns the saved promotion
+func (u *MenuUsecase) auditPromotion(ctx context.Context, promotion_id, action string, before *response.Promotion) (promo response.Promotion, err error) {
+	after, err := u.menuRepo.PromotionDetail(ctx, promotion_id)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityPromotion,
+		EntityId:   promotion_id,
+		MenuId:     promotionMenu(after),
+		WartegId:   after.WartegId,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+// promotionMenu returns menu of a promotion scoped to one menu
+func promotionMenu(p response.Promotion) string {
+	if p.ScopeType == constant.PromotionScopeMenu {
+		return p.ScopeId
+	}
+	return ""
+}
+
+// normalizePromotion checks value and schedule of promotion and fills warteg of its scope
+func (u *MenuUsecase) normalizePromotion(ctx context.Context, p request.Promotion) (request.Promotion, error) {
+	switch p.PromotionType {
+	case constant.PromotionPercentage:
+		if p.Value < 1 || p.Value > 100 {
+			return p, constant.ErrInvalidPromotion
+		}
+		p.BuyQuantity, p.GetQuantity = 0, 0
+	case constant.PromotionFixed:
+		if p.Value < 1 {
+			return p, constant.ErrInvalidPromotion
+		}
+		p.BuyQuantity, p.GetQuantity = 0, 0
+	default:
+		if p.BuyQuantity < 1 || p.GetQuantity < 1 {
+			return p, constant.ErrInvalidPromotion
+		}
+		p.Value = 0
+	}
+
+	if p.EndDate != nil && !p.EndDate.After(p.StartDate) {
+		return p, constant.ErrInvalidPromotion
+	}
+
+	if (p.DailyStart == "") != (p.DailyEnd == "") || p.DailyStart != "" && p.DailyStart == p.DailyEnd {
+		return p, constant.ErrInvalidPromotion
+	}
+	if p.DailyStart != "" {
+		_, err1 := time.Parse("15:04", p.DailyStart)
+		_, err2 := time.Parse("15:04", p.DailyEnd)
+		if err1 != nil || err2 != nil {
+			return p, constant.ErrInvalidPromotion
+		}
+	}
+
+	switch p.ScopeType {
+	case constant.PromotionScopeMenu:
+		mdetail, err := u.menuRepo.MenuDetail(ctx, p.ScopeId)
+		if err == constant.ErrNotFound {
+			return p, constant.ErrInvalidPromotion
+		}
+		if err != nil {
+			return p, err
+		}
+		p.WartegId = mdetail.WartegId
+	case constant.PromotionScopeMenuType:
+		if _, err := strconv.Atoi(p.ScopeId); err != nil {
+			return p, constant.ErrInvalidPromotion
+		}
+	default:
+		p.WartegId = p.ScopeId
+	}
+
+	return p, nil
+}
+
+// promotionSet evaluates promotions at one moment, weekdays and daily hours are local to the timezone of the warteg
+// of each menu, or of the business day when the warteg has no opening hours
+type promotionSet struct {
+	list  []response.Promotion
+	now   time.Time
+	loc   *time.Location
+	zones map[string]*time.Location
+}
+
+func (u *MenuUsecase) currentPromotions(ctx context.Context) (promotionSet, error) {
+	return u.promotionsAt(ctx, time.Now())
+}
+
+// promotionsAt evaluates promotions at another moment than now, used to preview prices
+func (u *MenuUsecase) promotionsAt(ctx context.Context, at time.Time) (promotionSet, error) {
+	s := promotionSet{now: at, loc: u.clock.Location(), zones: map[string]*time.Location{}}
+
+	list, err := u.menuRepo.PromotionCurrent(ctx, at.AddDate(0, 0, -constant.PromotionLookbackDays))
+	if err != nil {
+		return s, err
+	}
+	s.list = list
+
+	zones, err := u.menuRepo.WartegTimezones(ctx)
+	if err != nil {
+		return s, err
+	}
+
+	for wartegId, timezone := range zones {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			log.S().Warn("timezone ", timezone, " of warteg ", wartegId, " : ", err)
+			continue
+		}
+		s.zones[wartegId] = loc
+	}
+
+	return s, nil
+}
+
+// location returns the timezone promotions are evaluated in for menus of warteg
+func (s promotionSet) location(warteg_id string) *time.Location {
+	if loc, ok := s.zones[warteg_id]; ok {
+		return loc
+	}
+	return s.loc
+}
+
+// boundary returns the latest start or end of promotion not after now, a promotion of menu types of every warteg
+// runs on the local time of each of them and its latest boundary among their timezones is returned
+func (s promotionSet) boundary(p response.Promotion) time.Time {
+	if p.WartegId != "" {
+		return s.lastBoundary(p, s.location(p.WartegId))
+	}
+
+	changed := s.lastBoundary(p, s.loc)
+	for _, loc := range s.zones {
+		if b := s.lastBoundary(p, loc); b.After(changed) {
+			changed = b
+		}
+	}
+	return changed
+}
+
+// covers tells whether promotion scope includes the menu
+func covers(p response.Promotion, menu_id string, menu_type_id int, warteg_id string) bool {
+	switch p.ScopeType {
+	case constant.PromotionScopeMenu:
+		return p.ScopeId == menu_id
+	case constant.PromotionScopeMenuType:
+		return p.ScopeId == strconv.Itoa(menu_type_id) && (p.WartegId == "" || p.WartegId == warteg_id)
+	default:
+		return p.ScopeId == warteg_id
+	}
+}
+
+// price returns the lowest effective price of base among promotions covering the menu, the applied promotion
+// and the last moment any of those promotions started, ended or was edited
+func (s promotionSet) price(menu_id string, menu_type_id int, warteg_id string, base int) (int, *response.AppliedPromotion, time.Time) {
+	price := base
+	var applied *response.AppliedPromotion
+	var changed time.Time
+
+	loc := s.location(warteg_id)
+	for _, p := range s.list {
+		if !covers(p, menu_id, menu_type_id, warteg_id) {
+			continue
+		}
+
+		if c := s.lastChange(p, loc); c.After(changed) {
+			changed = c
+		}
+
+		active, endsAt := s.window(p, loc)
+		if !active {
+			continue
+		}
+
+		discount := 0
+		switch p.PromotionType {
+		case constant.PromotionPercentage:
+			discount = base * p.Value / 100
+		case constant.PromotionFixed:
+			discount = p.Value
+		}
+		if discount > base {
+			discount = base
+		}
+
+		// buy x get y keeps the price, it is shown only when nothing lowers the price
+		if applied != nil && base-discount >= price {
+			continue
+		}
+
+		price = base - discount
+		applied = &response.AppliedPromotion{
+			PromotionId:   p.PromotionId,
+			PromotionName: p.PromotionName,
+			PromotionType: p.PromotionType,
+			Value:         p.Value,
+			BuyQuantity:   p.BuyQuantity,
+			GetQuantity:   p.GetQuantity,
+			Discount:      discount,
+			EndsAt:        endsAt,
+		}
+	}
+
+	return price, applied, changed
+}
+
+// dailyWindows returns daily windows of promotion in loc starting from days before today up to today, windows past
+// midnight end on the next day
+func (s promotionSet) dailyWindows(p response.Promotion, days int, loc *time.Location) (windows [][2]time.Time) {
+	start, _ := time.Parse("15:04", p.DailyStart)
+	end, _ := time.Parse("15:04", p.DailyEnd)
+	length := end.Sub(start)
+	if length <= 0 {
+		length += 24 * time.Hour
+	}
+
+	y, m, d := s.now.In(loc).Date()
+	for i := -days; i <= 0; i++ {
+		ws := time.Date(y, m, d+i, start.Hour(), start.Minute(), 0, 0, loc)
+		if !allowedDay(p.DaysOfWeek, ws.Weekday()) {
+			continue
+		}
+		windows = append(windows, [2]time.Time{ws, ws.Add(length)})
+	}
+
+	return windows
+}
+
+func allowedDay(days []int, day time.Weekday) bool {
+	if len(days) == 0 {
+		return true
+	}
+	for _, d := range days {
+		if d == int(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// window tells whether promotion is running now in loc and when the current run ends
+func (s promotionSet) window(p response.Promotion, loc *time.Location) (bool, *time.Time) {
+	if s.now.Before(p.StartDate) || p.EndDate != nil && !s.now.Before(*p.EndDate) {
+		return false, nil
+	}
+
+	local := s.now.In(loc)
+	if p.DailyStart == "" {
+		if !allowedDay(p.DaysOfWeek, local.Weekday()) {
+			return false, nil
+		}
+		if len(p.DaysOfWeek) == 0 {
+			return true, p.EndDate
+		}
+		y, m, d := local.Date()
+		endsAt := time.Date(y, m, d+1, 0, 0, 0, 0, loc)
+		return true, earliest(endsAt, p.EndDate)
+	}
+
+	for _, w := range s.dailyWindows(p, 1, loc) {
+		if !s.now.Before(w[0]) && s.now.Before(w[1]) {
+			return true, earliest(w[1], p.EndDate)
+		}
+	}
+
+	return false, nil
+}
+
+// lastChange returns the latest edit, start or end of promotion in loc not after now
+func (s promotionSet) lastChange(p response.Promotion, loc *time.Location) time.Time {
+	if b := s.lastBoundary(p, loc); b.After(p.UpdatedDate) {
+		return b
+	}
+	return p.UpdatedDate
+}
+
+// lastBoundary returns the latest start or end of promotion or of one of its runs in loc not after now, zero before
+// it starts
+func (s promotionSet) lastBoundary(p response.Promotion, loc *time.Location) (changed time.Time) {
+	moments := []time.Time{p.StartDate}
+	if p.EndDate != nil {
+		moments = append(moments, *p.EndDate)
+	}
+
+	if p.DailyStart != "" {
+		for _, w := range s.dailyWindows(p, 7, loc) {
+			moments = append(moments, w[0], w[1])
+		}
+	} else if len(p.DaysOfWeek) > 0 {
+		y, m, d := s.now.In(loc).Date()
+		for i := -7; i <= 0; i++ {
+			moments = append(moments, time.Date(y, m, d+i, 0, 0, 0, 0, loc))
+		}
+	}
+
+	for _, t := range moments {
+		if t.After(changed) && !t.After(s.now) {
+			changed = t
+		}
+	}
+
+	return changed
+}
+
+func earliest(t time.Time, end *time.Time) *time.Time {
+	if end != nil && end.Before(t) {
+		return end
+	}
+	return &t
+}
+
+// priceMenuDetail fills effective price of menu and its variants
+func (s promotionSet) priceMenuDetail(mdetail *response.MenuDetail) {
+	var changed time.Time
+	mdetail.EffectivePrice, mdetail.Promotion, changed = s.price(mdetail.MenuId, mdetail.MenuTypeId, mdetail.WartegId, mdetail.MenuPrice)
+	if changed.After(mdetail.UpdatedDate) {
+		mdetail.UpdatedDate = changed
+	}
+
+	for i, v := range mdetail.Variants {
+		mdetail.Variants[i].EffectivePrice, _, _ = s.price(mdetail.MenuId, mdetail.MenuTypeId, mdetail.WartegId, v.VariantPrice)
+	}
+}
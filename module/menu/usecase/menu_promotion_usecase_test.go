@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/module/menu/mocks"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestPromotionsAt(t *testing.T) {
+	type input struct {
+		promotion response.Promotion
+		wartegId  string
+		at        time.Time
+	}
+
+	type output struct {
+		price  int
+		endsAt *time.Time
+		err    error
+	}
+
+	// 2027-03-10 is a wednesday, Asia/Jakarta is UTC+7 and the business day runs in UTC
+	start := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	ended := time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hour, min int) time.Time {
+		return time.Date(2027, 3, 10, hour, min, 0, 0, time.UTC)
+	}
+	endsAt := func(t time.Time) *time.Time {
+		return &t
+	}
+	promotion := func(days []int, dailyStart, dailyEnd string) response.Promotion {
+		return response.Promotion{
+			PromotionId:   "p1",
+			PromotionType: constant.PromotionPercentage,
+			Value:         10,
+			ScopeType:     constant.PromotionScopeMenuType,
+			ScopeId:       "1",
+			StartDate:     start,
+			DailyStart:    dailyStart,
+			DailyEnd:      dailyEnd,
+			DaysOfWeek:    days,
+		}
+	}
+
+	cases := []struct {
+		name           string
+		expectedInput  input
+		expectedOutput output
+		configureMock  func(
+			payload input,
+			mockRepo *mocks.Repository,
+		)
+	}{
+		{
+			name: "#1 weekday in the timezone of the warteg",
+			expectedInput: input{
+				promotion: promotion([]int{int(time.Thursday)}, "", ""),
+				wartegId:  "w1",
+				at:        at(23, 30),
+			},
+			expectedOutput: output{9000, endsAt(time.Date(2027, 3, 11, 17, 0, 0, 0, time.UTC)), nil},
+		},
+		{
+			name: "#2 weekday of a warteg without opening hours in the timezone of the business day",
+			expectedInput: input{
+				promotion: promotion([]int{int(time.Thursday)}, "", ""),
+				wartegId:  "w2",
+				at:        at(23, 30),
+			},
+			expectedOutput: output{10000, nil, nil},
+		},
+		{
+			name: "#3 daily hours in the timezone of the warteg",
+			expectedInput: input{
+				promotion: promotion(nil, "11:00", "14:00"),
+				wartegId:  "w1",
+				at:        at(5, 0),
+			},
+			expectedOutput: output{9000, endsAt(at(7, 0)), nil},
+		},
+		{
+			name: "#4 daily hours of a warteg without opening hours in the timezone of the business day",
+			expectedInput: input{
+				promotion: promotion(nil, "11:00", "14:00"),
+				wartegId:  "w2",
+				at:        at(5, 0),
+			},
+			expectedOutput: output{10000, nil, nil},
+		},
+		{
+			name: "#5 daily hours past midnight started the day before",
+			expectedInput: input{
+				promotion: promotion([]int{int(time.Wednesday)}, "22:00", "02:00"),
+				wartegId:  "w1",
+				at:        at(18, 30),
+			},
+			expectedOutput: output{9000, endsAt(at(19, 0)), nil},
+		},
+		{
+			name: "#6 invalid timezone of warteg falls back to the business day",
+			expectedInput: input{
+				promotion: promotion([]int{int(time.Thursday)}, "", ""),
+				wartegId:  "w3",
+				at:        at(23, 30),
+			},
+			expectedOutput: output{10000, nil, nil},
+		},
+		{
+			name: "#7 ended promotion",
+			expectedInput: input{
+				promotion: func() response.Promotion {
+					p := promotion(nil, "", "")
+					p.EndDate = &ended
+					return p
+				}(),
+				wartegId: "w1",
+				at:       at(5, 0),
+			},
+			expectedOutput: output{10000, nil, nil},
+		},
+		{
+			name: "#8 error reading promotions",
+			expectedInput: input{
+				wartegId: "w1",
+				at:       at(5, 0),
+			},
+			expectedOutput: output{10000, nil, errorMenu},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockRepo.
+					On("PromotionCurrent", mock.Anything, payload.at.AddDate(0, 0, -constant.PromotionLookbackDays)).
+					Return(nil, errorMenu)
+			},
+		},
+		{
+			name: "#9 error reading timezones",
+			expectedInput: input{
+				promotion: promotion(nil, "", ""),
+				wartegId:  "w1",
+				at:        at(5, 0),
+			},
+			expectedOutput: output{10000, nil, errorMenu},
+			configureMock: func(
+				payload input,
+				mockRepo *mocks.Repository,
+			) {
+				mockRepo.
+					On("PromotionCurrent", mock.Anything, payload.at.AddDate(0, 0, -constant.PromotionLookbackDays)).
+					Return([]response.Promotion{payload.promotion}, nil)
+				mockRepo.
+					On("WartegTimezones", mock.Anything).
+					Return(nil, errorMenu)
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mockRepo := new(mocks.Repository)
+
+			if testCase.configureMock != nil {
+				testCase.configureMock(testCase.expectedInput, mockRepo)
+			} else {
+				mockRepo.
+					On("PromotionCurrent", mock.Anything, testCase.expectedInput.at.AddDate(0, 0, -constant.PromotionLookbackDays)).
+					Return([]response.Promotion{testCase.expectedInput.promotion}, nil)
+				mockRepo.
+					On("WartegTimezones", mock.Anything).
+					Return(map[string]string{"w1": "Asia/Jakarta", "w3": "Mars/Base"}, nil)
+			}
+
+			u := newTestMenuUsecase(t, mockRepo, 0)
+
+			promos, err := u.promotionsAt(context.Background(), testCase.expectedInput.at)
+			assert.Equal(t, testCase.expectedOutput.err, err)
+			mockRepo.AssertExpectations(t)
+			if err != nil {
+				return
+			}
+
+			price, applied, _ := promos.price("m1", 1, testCase.expectedInput.wartegId, 10000)
+			assert.Equal(t, testCase.expectedOutput.price, price)
+			if testCase.expectedOutput.endsAt == nil {
+				assert.Nil(t, applied)
+				return
+			}
+			if assert.NotNil(t, applied) {
+				assert.True(t, testCase.expectedOutput.endsAt.Equal(*applied.EndsAt), "ends at %v", applied.EndsAt)
+			}
+		})
+	}
+}
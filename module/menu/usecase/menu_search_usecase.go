@@ -0,0 +1,216 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/search"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// search fields of a menu document, the menu name is the primary field
+const (
+	searchFieldName   = "menu_name"
+	searchFieldType   = "menu_type_name"
+	searchFieldDetail = "menu_detail"
+)
+
+// MenuSearch ranks menus matching every word of the query, the index is brought up to date first when the change log
+// moved past it so a menu is searchable right after it is saved. Price, promotion and availability come from the
+// database.
+func (u *MenuUsecase) MenuSearch(ctx context.Context, req request.MenuSearch) (list []response.MenuSearch, err error) {
+	resp := []response.MenuSearch{}
+
+	if req.Limit <= 0 {
+		req.Limit = constant.MenuSearchLimit
+	}
+	if req.Limit > constant.MenuSearchMaxLimit {
+		req.Limit = constant.MenuSearchMaxLimit
+	}
+
+	err = u.searchCatchUp(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	hits := u.searchIndex.Search(search.Query{
+		Text: req.Query,
+		Tags: map[string]string{"warteg_id": req.WartegId, "menu_type_id": req.MenuTypeId},
+	})
+	if hits == nil {
+		return resp, constant.ErrInvalidSearchQuery
+	}
+
+	// sold out menus are only known after loading, keep loading pages of hits until the limit is filled
+	for start := 0; start < len(hits) && len(resp) < req.Limit; start += req.Limit {
+		end := start + req.Limit
+		if end > len(hits) {
+			end = len(hits)
+		}
+
+		ids := make([]string, end-start)
+		for i, h := range hits[start:end] {
+			ids[i] = h.Id
+		}
+
+		mdetails, err := u.MenuDetails(ctx, ids)
+		if err != nil {
+			return resp, err
+		}
+
+		byId := map[string]response.MenuDetail{}
+		for _, m := range mdetails {
+			byId[m.MenuId] = m
+		}
+
+		for _, h := range hits[start:end] {
+			m, ok := byId[h.Id]
+			if !ok || (req.AvailableOnly && m.IsSoldOut) || len(resp) == req.Limit {
+				continue
+			}
+			resp = append(resp, response.MenuSearch{
+				MenuId:         m.MenuId,
+				MenuTypeId:     m.MenuTypeId,
+				MenuTypeName:   m.MenuTypeName,
+				WartegId:       m.WartegId,
+				MenuName:       m.MenuName,
+				MenuPrice:      m.MenuPrice,
+				EffectivePrice: m.EffectivePrice,
+				Promotion:      m.Promotion,
+				IsSoldOut:      m.IsSoldOut,
+				Score:          h.Score,
+				Highlights:     h.Highlights,
+			})
+		}
+	}
+
+	return resp, nil
+}
+
+// MenuSearchSync builds the search index and the suggester from every menu on first use and afterwards applies the
+// menu change log since the last applied change, every instance keeps its own indexes in sync this way
+func (u *MenuUsecase) MenuSearchSync(ctx context.Context) (err error) {
+	u.searchSync.Lock()
+	defer u.searchSync.Unlock()
+
+	position, ready := u.searchIndex.Position()
+	if !ready {
+		// changes made while reading are applied again by the next sync
+		latest, err := u.menuRepo.MenuChangeLatest(ctx)
+		if err != nil {
+			return err
+		}
+
+		docs := []search.Document{}
+		err = u.menuRepo.MenuScan(ctx, func(m response.MenuDetail) error {
+			docs = append(docs, menuDocument(m))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		u.searchIndex.Reset(docs, latest)
+		u.menuSuggester.Reset(docs)
+		return nil
+	}
+
+	for {
+		changes, err := u.menuRepo.MenuChangeList(ctx, position, "", constant.MenuChangesLimit)
+		if err != nil {
+			return err
+		}
+
+		for _, c := range changes {
+			if c.Menu == nil || c.ChangeType == constant.MenuDeleted {
+				u.searchIndex.Delete(c.MenuId)
+				u.menuSuggester.Delete(c.MenuId)
+			} else {
+				doc := menuDocument(*c.Menu)
+				u.searchIndex.Put(doc)
+				u.menuSuggester.Put(doc)
+			}
+			position = c.ChangeId
+		}
+		u.searchIndex.SetPosition(position)
+
+		if len(changes) < constant.MenuChangesLimit {
+			return nil
+		}
+	}
+}
+
+// searchCatchUp syncs the search index only when it is not built yet or behind the change log, searches of an index
+// in sync do not wait for the sync lock
+func (u *MenuUsecase) searchCatchUp(ctx context.Context) error {
+	position, ready := u.searchIndex.Position()
+	if ready {
+		latest, err := u.menuRepo.MenuChangeLatest(ctx)
+		if err != nil {
+			return err
+		}
+		if latest <= position {
+			return nil
+		}
+	}
+
+	return u.MenuSearchSync(ctx)
+}
+
+// MenuSuggest completes menu names and menu type names from the in memory suggester without reading the database, it
+// is kept up to date by the search sync job and by searches. Text shorter than the minimum gets no suggestions.
+func (u *MenuUsecase) MenuSuggest(ctx context.Context, req request.MenuSuggest) (list []response.MenuSuggest, err error) {
+	resp := []response.MenuSuggest{}
+
+	if utf8.RuneCountInString(strings.TrimSpace(req.Query)) < constant.MenuSuggestMinLength {
+		return resp, nil
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = constant.MenuSuggestLimit
+	}
+	if req.Limit > constant.MenuSuggestMaxLimit {
+		req.Limit = constant.MenuSuggestMaxLimit
+	}
+
+	if _, ready := u.searchIndex.Position(); !ready {
+		err = u.MenuSearchSync(ctx)
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	for _, s := range u.menuSuggester.Suggest(req.Query, req.WartegId, req.Limit) {
+		kind := constant.SuggestMenu
+		if s.Field == searchFieldType {
+			kind = constant.SuggestMenuType
+		}
+		resp = append(resp, response.MenuSuggest{
+			Text:      s.Text,
+			Type:      kind,
+			Highlight: s.Highlight,
+			MenuCount: s.Count,
+		})
+	}
+
+	return resp, nil
+}
+
+func menuDocument(m response.MenuDetail) search.Document {
+	return search.Document{
+		Id: m.MenuId,
+		Fields: []search.Field{
+			{Name: searchFieldName, Text: m.MenuName, Weight: 1},
+			{Name: searchFieldType, Text: m.MenuTypeName, Weight: 0.5},
+			{Name: searchFieldDetail, Text: m.MenuDetail, Weight: 0.3},
+		},
+		Tags: map[string]string{
+			"warteg_id":    m.WartegId,
+			"menu_type_id": strconv.Itoa(m.MenuTypeId),
+		},
+	}
+}
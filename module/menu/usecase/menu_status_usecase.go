@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/actor"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	log "go.uber.org/zap"
+)
+
+// MenuStatusSet moves menu between draft, published and archived. A draft with publish at is published by the
+// publish scheduler at that time, customers only see published menus
+func (u *MenuUsecase) MenuStatusSet(ctx context.Context, menu_id string, req request.MenuStatus) (ms response.MenuStatus, err error) {
+	resp := response.MenuStatus{
+		MenuId: menu_id,
+		Status: req.Status,
+	}
+
+	err = validateMenuStatus(req.Status, req.PublishAt, time.Now())
+	if err != nil {
+		return resp, err
+	}
+
+	req.MenuId = menu_id
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.MenuStatus(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuStatusSet(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		after, err := u.menuRepo.MenuStatus(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		ms = after
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenuStatus,
+			EntityId:   menu_id,
+			MenuId:     menu_id,
+			WartegId:   after.WartegId,
+			Action:     constant.AuditUpdated,
+			Before:     before,
+			After:      after,
+		})
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return ms, nil
+}
+
+// MenuStaffList returns menus of the statuses of the filter, every status when none is given, including menus
+// outside their serving windows
+func (u *MenuUsecase) MenuStaffList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error) {
+	if actor.FromContext(ctx) == actor.Anonymous {
+		return []response.MenuList{}, constant.ErrActorRequired
+	}
+
+	at := time.Now()
+	if filter.At != nil {
+		at = *filter.At
+	}
+
+	if len(filter.Statuses) == 0 {
+		filter.Statuses = []string{constant.MenuStatusDraft, constant.MenuStatusPublished, constant.MenuStatusArchived}
+	}
+
+	if filter.OpenOnly {
+		filter.ClosedWartegIds, err = u.closedWartegs(ctx, filter, at)
+		if err != nil {
+			return []response.MenuList{}, err
+		}
+	}
+
+	return u.menuList(ctx, filter, at)
+}
+
+// MenuPreview returns menu of any status as customers would see it at
+func (u *MenuUsecase) MenuPreview(ctx context.Context, menu_id string, at time.Time) (mnd response.MenuDetail, err error) {
+	if actor.FromContext(ctx) == actor.Anonymous {
+		return response.MenuDetail{}, constant.ErrActorRequired
+	}
+
+	return u.menuDetailAt(ctx, menu_id, at)
+}
+
+// publishedMenu returns ErrNotFound when menu is not published, customers do not see anything of other menus
+func (u *MenuUsecase) publishedMenu(ctx context.Context, menu_id string) error {
+	ms, err := u.menuRepo.MenuStatus(ctx, menu_id)
+	if err != nil {
+		return err
+	}
+
+	if ms.Status != constant.MenuStatusPublished {
+		return constant.ErrNotFound
+	}
+	return nil
+}
+
+// MenuPublishRun publishes every draft whose publish time has come, run periodically by the scheduler, publishing is
+// recorded as made by the actor who scheduled it
+func (u *MenuUsecase) MenuPublishRun(ctx context.Context) (err error) {
+	for {
+		now := time.Now()
+		due, err := u.menuRepo.MenuPublishDue(ctx, now, constant.PublishScheduleBatch)
+		if err != nil {
+			return err
+		}
+
+		published := 0
+		for _, ms := range due {
+			actorCtx := actor.NewContext(ctx, ms.UpdatedBy)
+			err = u.inTx(actorCtx, constant.AuditEntityMenu, ms.MenuId, func(ctx context.Context) error {
+				before, err := u.menuRepo.MenuStatus(ctx, ms.MenuId)
+				if err != nil {
+					return err
+				}
+
+				err = u.menuRepo.MenuPublish(ctx, ms.MenuId, now)
+				if err != nil {
+					return err
+				}
+
+				after, err := u.menuRepo.MenuStatus(ctx, ms.MenuId)
+				if err != nil {
+					return err
+				}
+
+				return u.audit(ctx, request.AuditLog{
+					EntityType: constant.AuditEntityMenuStatus,
+					EntityId:   ms.MenuId,
+					MenuId:     ms.MenuId,
+					WartegId:   after.WartegId,
+					Action:     constant.AuditUpdated,
+					Before:     before,
+					After:      after,
+				})
+			})
+			if err == constant.ErrNotFound {
+				// rescheduled, published by another instance or deleted meanwhile
+				continue
+			}
+			if err != nil {
+				return err
+			}
+
+			published++
+			log.S().Info("menu ", ms.MenuId, " published as scheduled at ", ms.PublishAt)
+		}
+
+		if len(due) < constant.PublishScheduleBatch || published == 0 {
+			return nil
+		}
+	}
+}
+
+// validateMenuStatus allows publish at only on drafts and only in the future
+func validateMenuStatus(status string, publish_at *time.Time, now time.Time) error {
+	if publish_at == nil {
+		return nil
+	}
+
+	if status != constant.MenuStatusDraft || !publish_at.After(now) {
+		return constant.ErrInvalidMenuStatus
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// MenuStream returns menu changes of warteg after last_event_id, event ids are change log ids so a client resumes
+// from the last event it saw. Without last_event_id the stream starts at the latest change and returns no events
+func (u *MenuUsecase) MenuStream(ctx context.Context, warteg_id, last_event_id string) (ms response.MenuStream, err error) {
+	ms = response.MenuStream{
+		Events:      []response.MenuStreamEvent{},
+		LastEventId: last_event_id,
+	}
+
+	if last_event_id == "" {
+		latest, err := u.menuRepo.MenuChangeLatest(ctx)
+		if err != nil {
+			return ms, err
+		}
+		ms.LastEventId = strconv.FormatInt(latest, 10)
+		return ms, nil
+	}
+
+	since, err := strconv.ParseInt(last_event_id, 10, 64)
+	if err != nil || since < 0 {
+		return ms, constant.ErrInvalidSyncToken
+	}
+
+	changes, err := u.menuRepo.MenuChangeList(ctx, since, warteg_id, constant.MenuChangesLimit)
+	if err != nil {
+		return ms, err
+	}
+	if len(changes) == 0 {
+		return ms, nil
+	}
+
+	promos, err := u.currentPromotions(ctx)
+	if err != nil {
+		return ms, err
+	}
+
+	// rows carry the current menu state, so only the last change of every menu is sent
+	last := map[string]response.MenuChange{}
+	for _, c := range changes {
+		last[c.MenuId] = c
+	}
+
+	for _, c := range last {
+		changed := c.ChangedDate
+		e := response.MenuStreamEvent{
+			EventId:     strconv.FormatInt(c.ChangeId, 10),
+			EventType:   constant.MenuEventPrefix + c.ChangeType,
+			MenuId:      c.MenuId,
+			WartegId:    c.WartegId,
+			ChangedDate: &changed,
+		}
+		if c.Menu == nil {
+			e.EventType = constant.MenuEventPrefix + constant.MenuDeleted
+		} else if c.ChangeType != constant.MenuDeleted {
+			promos.priceMenuDetail(c.Menu)
+			e.Menu = c.Menu
+		}
+		ms.Events = append(ms.Events, e)
+	}
+
+	sort.Slice(ms.Events, func(i, j int) bool {
+		return last[ms.Events[i].MenuId].ChangeId < last[ms.Events[j].MenuId].ChangeId
+	})
+
+	ms.LastEventId = strconv.FormatInt(changes[len(changes)-1].ChangeId, 10)
+	ms.HasMore = len(changes) == constant.MenuChangesLimit
+
+	return ms, nil
+}
+
+// MenuStreamSubscribe returns channel notified when menus of warteg may have changed, the subscription ends with ctx
+func (u *MenuUsecase) MenuStreamSubscribe(ctx context.Context, warteg_id string) (notify <-chan struct{}) {
+	notify, unsubscribe := u.menuHub.Subscribe(warteg_id)
+
+	go func() {
+		<-ctx.Done()
+		unsubscribe()
+	}()
+
+	return notify
+}
@@ -2,9 +2,17 @@ package usecase
 
 import (
 	"context"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/cpartogi/foodmenu/constant"
 	"github.com/cpartogi/foodmenu/module/menu"
+	"github.com/cpartogi/foodmenu/pkg/businessday"
+	"github.com/cpartogi/foodmenu/pkg/event"
+	"github.com/cpartogi/foodmenu/pkg/pubsub"
+	"github.com/cpartogi/foodmenu/pkg/search"
+	"github.com/cpartogi/foodmenu/pkg/storage"
 	"github.com/cpartogi/foodmenu/schema/request"
 	"github.com/cpartogi/foodmenu/schema/response"
 )
@@ -12,13 +20,36 @@ import (
 // AuthUsecase will create a usecase with its required repo
 type MenuUsecase struct {
 	menuRepo       menu.Repository
+	storage        storage.Storage
+	clock          *businessday.Clock
+	eventSink      event.Sink
+	webhookPoster  event.Poster
+	menuHub        *pubsub.Hub
+	searchIndex    *search.Index
+	menuSuggester  *search.Suggester
+	searchSync     sync.Mutex
+	priceApproval  float64
+	maxPixels      int64
 	contextTimeout time.Duration
 }
 
 // NewAuthUsecase will create new an contactUsecase object representation of auth.Usecase
-func NewMenuUsecase(ar menu.Repository, timeout time.Duration) menu.Usecase {
+func NewMenuUsecase(ar menu.Repository, st storage.Storage, clock *businessday.Clock, sink event.Sink, poster event.Poster, hub *pubsub.Hub, index *search.Index, priceApproval float64, maxPixels int64, timeout time.Duration) menu.Usecase {
+	if maxPixels <= 0 {
+		maxPixels = constant.MaxImagePixels
+	}
+
 	return &MenuUsecase{
 		menuRepo:       ar,
+		storage:        st,
+		clock:          clock,
+		eventSink:      sink,
+		webhookPoster:  poster,
+		menuHub:        hub,
+		searchIndex:    index,
+		menuSuggester:  search.NewSuggester("warteg_id", searchFieldName, searchFieldType),
+		priceApproval:  priceApproval,
+		maxPixels:      maxPixels,
 		contextTimeout: timeout,
 	}
 }
@@ -43,6 +74,8 @@ func (u *MenuUsecase) MenuAdd(ctx context.Context, addm request.Menu) (mn respon
 		MenuDetail:  addm.MenuDetail,
 		MenuPicture: addm.MenuPicture,
 		MenuPrice:   addm.MenuPrice,
+		Status:      addm.Status,
+		PublishAt:   addm.PublishAt,
 	}
 
 	req := request.Menu{
@@ -52,13 +85,44 @@ func (u *MenuUsecase) MenuAdd(ctx context.Context, addm request.Menu) (mn respon
 		MenuDetail:  addm.MenuDetail,
 		MenuPicture: addm.MenuPicture,
 		MenuPrice:   addm.MenuPrice,
+		Status:      addm.Status,
+		PublishAt:   addm.PublishAt,
 	}
 
-	addmenu, err := u.menuRepo.MenuAdd(ctx, req)
+	if req.Status != "" || req.PublishAt != nil {
+		err = validateMenuStatus(req.Status, req.PublishAt, time.Now())
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	var addmenu response.MenuAdd
+	err = u.inTx(ctx, "", "", func(ctx context.Context) error {
+		var err error
+		addmenu, err = u.menuRepo.MenuAdd(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		after, err := u.menuSnapshot(ctx, addmenu.MenuId)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenu,
+			EntityId:   addmenu.MenuId,
+			MenuId:     addmenu.MenuId,
+			WartegId:   addmenu.WartegId,
+			Action:     constant.AuditCreated,
+			After:      after,
+		})
+	})
 
 	if err != nil {
 		return resp, err
 	}
+
 	return addmenu, err
 }
 
@@ -67,14 +131,49 @@ func (u *MenuUsecase) MenuDelete(ctx context.Context, menu_id string) (md respon
 		MenuId: menu_id,
 	}
 
-	delmenu, err := u.menuRepo.MenuDelete(ctx, menu_id)
+	var images []response.MenuImage
+	var delmenu response.MenuDelete
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		var err error
+		images, err = u.menuRepo.MenuImageList(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		before, err := u.menuSnapshot(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		delmenu, err = u.menuRepo.MenuDelete(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		audit := request.AuditLog{
+			EntityType: constant.AuditEntityMenu,
+			EntityId:   menu_id,
+			MenuId:     menu_id,
+			Action:     constant.AuditDeleted,
+			Before:     before,
+		}
+		if before != nil {
+			audit.WartegId = before.WartegId
+		}
+		return u.audit(ctx, audit)
+	})
+
 	if err != nil {
 		return resp, err
 	}
 
+	// files are removed once the rows are gone for good
+	u.removeImageFiles(ctx, images...)
+
 	return delmenu, err
 }
 
+// MenuUpdate updates menu, a price change beyond the approval threshold waits for approval while the rest is updated
 func (u *MenuUsecase) MenuUpdate(ctx context.Context, menu_id string, upm request.MenuUpdate) (mu response.MenuUpdate, err error) {
 	resp := response.MenuUpdate{
 		MenuId:      menu_id,
@@ -95,7 +194,56 @@ func (u *MenuUsecase) MenuUpdate(ctx context.Context, menu_id string, upm reques
 		MenuPrice:   upm.MenuPrice,
 	}
 
-	upmenu, err := u.menuRepo.MenuUpdate(ctx, menu_id, req)
+	var upmenu response.MenuUpdate
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuSnapshot(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		// the approval is decided on the price locked for the rest of the transaction
+		price, err := u.menuRepo.MenuPriceLock(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		if u.priceNeedsApproval(price, req.MenuPrice) {
+			upmenu, err = u.menuPriceChangeRequest(ctx, menu_id, req)
+		} else {
+			upmenu, err = u.menuRepo.MenuUpdate(ctx, menu_id, req)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		after, err := u.menuSnapshot(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenu,
+			EntityId:   menu_id,
+			MenuId:     menu_id,
+			WartegId:   upmenu.WartegId,
+			Action:     constant.AuditUpdated,
+			Before:     before,
+			After:      after,
+		})
+		if err != nil || upmenu.PriceChange == nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenuPriceChange,
+			EntityId:   upmenu.PriceChange.ChangeId,
+			MenuId:     menu_id,
+			WartegId:   upmenu.PriceChange.WartegId,
+			Action:     constant.AuditCreated,
+			After:      upmenu.PriceChange,
+		})
+	})
 
 	if err != nil {
 		return resp, err
@@ -105,20 +253,120 @@ func (u *MenuUsecase) MenuUpdate(ctx context.Context, menu_id string, upm reques
 
 }
 
-func (u *MenuUsecase) MenuList(ctx context.Context, warteg_id, menu_type_id, menu_name string) (list []response.MenuList, err error) {
+// MenuList returns published menus served at the time of the filter
+func (u *MenuUsecase) MenuList(ctx context.Context, filter request.MenuList) (list []response.MenuList, err error) {
+	resp := []response.MenuList{}
+
+	at := time.Now()
+	if filter.At != nil {
+		at = *filter.At
+	}
+
+	filter.Statuses = []string{constant.MenuStatusPublished}
+
+	filter, err = u.servedFilter(ctx, filter, at)
+	if err != nil {
+		return resp, err
+	}
+
+	return u.menuList(ctx, filter, at)
+}
+
+// MenuListModified returns when menu list of filter last changed, the latest of its change log, which records deleted
+// menus and menus leaving a warteg as well, and of the serving windows, and opening hours when only open wartegs are
+// listed, starting or ending until now. A preview at another time changes with the change log only
+func (u *MenuUsecase) MenuListModified(ctx context.Context, filter request.MenuList) (modified time.Time, err error) {
+	modified, err = u.menuRepo.MenuChangeModified(ctx, filter.WartegId)
+	if err != nil || filter.At != nil {
+		return modified, err
+	}
+
+	now := time.Now()
+	filter.Statuses = []string{constant.MenuStatusPublished}
+
+	changed, err := u.windowsChanged(ctx, filter, now)
+	if err != nil {
+		return modified, err
+	}
+	if changed.After(modified) {
+		modified = changed
+	}
+
+	if filter.OpenOnly {
+		changed, err = u.openingsChanged(ctx, filter, now)
+		if err != nil {
+			return modified, err
+		}
+		if changed.After(modified) {
+			modified = changed
+		}
+	}
+
+	return modified, nil
+}
+
+// servedFilter leaves out of filter menus of wartegs closed at when only open ones are asked and menus outside
+// their serving windows at, only the wartegs and menus matching filter are evaluated
+func (u *MenuUsecase) servedFilter(ctx context.Context, filter request.MenuList, at time.Time) (request.MenuList, error) {
+	var err error
+	if filter.OpenOnly {
+		filter.ClosedWartegIds, err = u.closedWartegs(ctx, filter, at)
+		if err != nil {
+			return filter, err
+		}
+	}
+
+	filter.OffScheduleMenuIds, err = u.offScheduleMenus(ctx, filter, at)
+	return filter, err
+}
+
+// menuList returns menus matching filter priced at
+func (u *MenuUsecase) menuList(ctx context.Context, filter request.MenuList, at time.Time) (list []response.MenuList, err error) {
 	resp := []response.MenuList{}
 
-	menulist, err := u.menuRepo.MenuList(ctx, warteg_id, menu_type_id, menu_name)
+	menulist, err := u.menuRepo.MenuList(ctx, filter)
+
+	if err != nil {
+		return resp, err
+	}
 
+	promos, err := u.promotionsAt(ctx, at)
 	if err != nil {
 		return resp, err
 	}
 
+	for i, m := range menulist {
+		var changed time.Time
+		menulist[i].EffectivePrice, menulist[i].Promotion, changed = promos.price(m.MenuId, m.MenuTypeId, m.WartegId, m.MenuPrice)
+		if changed.After(m.UpdatedDate) {
+			menulist[i].UpdatedDate = changed
+		}
+	}
+
 	return menulist, err
 
 }
 
 func (u *MenuUsecase) MenuDetail(ctx context.Context, menu_id string) (mnd response.MenuDetail, err error) {
+	return u.MenuDetailAt(ctx, menu_id, time.Now())
+}
+
+// MenuDetailAt returns published menu as served at, with price and whether it is outside its serving windows then
+func (u *MenuUsecase) MenuDetailAt(ctx context.Context, menu_id string, at time.Time) (mnd response.MenuDetail, err error) {
+	mdetail, err := u.menuDetailAt(ctx, menu_id, at)
+	if err != nil {
+		return mdetail, err
+	}
+
+	if mdetail.Status != constant.MenuStatusPublished {
+		return response.MenuDetail{}, constant.ErrNotFound
+	}
+
+	return mdetail, nil
+}
+
+// menuDetailAt returns menu of any status as served at
+func (u *MenuUsecase) menuDetailAt(ctx context.Context, menu_id string, at time.Time) (mnd response.MenuDetail, err error) {
 	resp := response.MenuDetail{}
 
 	mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
@@ -127,5 +375,169 @@ func (u *MenuUsecase) MenuDetail(ctx context.Context, menu_id string) (mnd respo
 		return resp, err
 	}
 
+	mdetail.Images, err = u.menuImageList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	mdetail.Variants, err = u.menuRepo.MenuVariantList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	mdetail.Modifiers, err = u.menuRepo.MenuModifierList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	if mdetail.IsBundle {
+		bundle, err := u.menuBundle(ctx, mdetail)
+		if err != nil {
+			return resp, err
+		}
+		mdetail.Bundle = &bundle
+	}
+
+	windows, err := u.menuRepo.MenuWindows(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+	mdetail.Windows = windows.Windows
+	mdetail.IsOffSchedule = !u.menuServedAt(windows, at)
+
+	promos, err := u.promotionsAt(ctx, at)
+	if err != nil {
+		return resp, err
+	}
+	promos.priceMenuDetail(&mdetail)
+
 	return mdetail, err
 }
+
+// MenuDetails returns several menus with their variants and effective price in a fixed number of queries, images,
+// modifiers and bundle items are left out
+func (u *MenuUsecase) MenuDetails(ctx context.Context, menu_ids []string) (list []response.MenuDetail, err error) {
+	resp := []response.MenuDetail{}
+
+	mdetails, err := u.menuRepo.MenuDetails(ctx, menu_ids)
+	if err != nil {
+		return resp, err
+	}
+
+	variants, err := u.menuRepo.MenuVariantsByMenu(ctx, menu_ids)
+	if err != nil {
+		return resp, err
+	}
+
+	byMenu := map[string][]response.MenuVariant{}
+	for _, v := range variants {
+		byMenu[v.MenuId] = append(byMenu[v.MenuId], v)
+	}
+
+	promos, err := u.currentPromotions(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	for i := range mdetails {
+		mdetails[i].Variants = byMenu[mdetails[i].MenuId]
+		if mdetails[i].Variants == nil {
+			mdetails[i].Variants = []response.MenuVariant{}
+		}
+		promos.priceMenuDetail(&mdetails[i])
+	}
+
+	return mdetails, nil
+}
+
+func (u *MenuUsecase) MenuChanges(ctx context.Context, since, warteg_id string) (mc response.MenuChanges, err error) {
+	resp := response.MenuChanges{
+		Created:   []response.MenuDetail{},
+		Updated:   []response.MenuDetail{},
+		Deleted:   []response.MenuTombstone{},
+		SyncToken: since,
+	}
+
+	var sinceId int64
+	if since != "" {
+		sinceId, err = strconv.ParseInt(since, 10, 64)
+		if err != nil || sinceId < 0 {
+			return resp, constant.ErrInvalidSyncToken
+		}
+	}
+	resp.SyncToken = strconv.FormatInt(sinceId, 10)
+
+	changes, err := u.menuRepo.MenuChangeList(ctx, sinceId, warteg_id, constant.MenuChangesLimit)
+	if err != nil {
+		return resp, err
+	}
+
+	promos, err := u.currentPromotions(ctx)
+	if err != nil {
+		return resp, err
+	}
+
+	// collapse the log so every menu appears once, in order of its first change
+	order := []string{}
+	last := map[string]response.MenuChange{}
+	created := map[string]bool{}
+	for _, c := range changes {
+		if _, ok := last[c.MenuId]; !ok {
+			order = append(order, c.MenuId)
+		}
+		if c.ChangeType == constant.MenuCreated {
+			created[c.MenuId] = true
+		}
+		last[c.MenuId] = c
+	}
+
+	for _, menuId := range order {
+		c := last[menuId]
+		switch {
+		case c.Menu == nil || c.ChangeType == constant.MenuDeleted:
+			resp.Deleted = append(resp.Deleted, response.MenuTombstone{
+				MenuId:      c.MenuId,
+				WartegId:    c.WartegId,
+				DeletedDate: c.ChangedDate,
+			})
+		case created[menuId]:
+			promos.priceMenuDetail(c.Menu)
+			resp.Created = append(resp.Created, *c.Menu)
+		default:
+			promos.priceMenuDetail(c.Menu)
+			resp.Updated = append(resp.Updated, *c.Menu)
+		}
+	}
+
+	if len(changes) > 0 {
+		resp.SyncToken = strconv.FormatInt(changes[len(changes)-1].ChangeId, 10)
+	}
+	resp.HasMore = len(changes) == constant.MenuChangesLimit
+
+	return resp, nil
+}
+
+// MenuExport streams published menus served at the time of the filter to fn, priced with the promotions then
+func (u *MenuUsecase) MenuExport(ctx context.Context, filter request.MenuList, fn func(response.MenuExport) error) (err error) {
+	at := time.Now()
+	if filter.At != nil {
+		at = *filter.At
+	}
+
+	filter.Statuses = []string{constant.MenuStatusPublished}
+
+	filter, err = u.servedFilter(ctx, filter, at)
+	if err != nil {
+		return err
+	}
+
+	promos, err := u.promotionsAt(ctx, at)
+	if err != nil {
+		return err
+	}
+
+	return u.menuRepo.MenuExport(ctx, filter, func(m response.MenuExport) error {
+		m.EffectivePrice, _, _ = promos.price(m.MenuId, m.MenuTypeId, m.WartegId, m.MenuPrice)
+		return fn(m)
+	})
+}
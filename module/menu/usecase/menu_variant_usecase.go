@@ -0,0 +1,161 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+)
+
+func (u *MenuUsecase) MenuVariantAdd(ctx context.Context, menu_id string, mv request.MenuVariant) (variant response.MenuVariant, err error) {
+	resp := response.MenuVariant{
+		MenuId:      menu_id,
+		VariantName: mv.VariantName,
+		PriceType:   mv.PriceType,
+		Price:       mv.Price,
+		IsDefault:   mv.IsDefault,
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		if !validVariantPrice(mdetail.MenuPrice, mv) {
+			return constant.ErrInvalidVariantPrice
+		}
+
+		mv.VariantId = uuid.New().String()
+		mv.MenuId = menu_id
+
+		err = u.menuRepo.MenuVariantAdd(ctx, mv)
+		if err != nil {
+			return err
+		}
+
+		variant, err = u.auditVariant(ctx, menu_id, mv.VariantId, constant.AuditCreated, nil)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return variant, nil
+}
+
+func (u *MenuUsecase) MenuVariantUpdate(ctx context.Context, menu_id, variant_id string, mv request.MenuVariant) (variant response.MenuVariant, err error) {
+	resp := response.MenuVariant{
+		VariantId:   variant_id,
+		MenuId:      menu_id,
+		VariantName: mv.VariantName,
+		PriceType:   mv.PriceType,
+		Price:       mv.Price,
+		IsDefault:   mv.IsDefault,
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.MenuVariantDetail(ctx, menu_id, variant_id)
+		if err != nil {
+			return err
+		}
+
+		mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		if !validVariantPrice(mdetail.MenuPrice, mv) {
+			return constant.ErrInvalidVariantPrice
+		}
+
+		mv.VariantId = variant_id
+		mv.MenuId = menu_id
+
+		err = u.menuRepo.MenuVariantUpdate(ctx, mv)
+		if err != nil {
+			return err
+		}
+
+		variant, err = u.auditVariant(ctx, menu_id, variant_id, constant.AuditUpdated, &before)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return variant, nil
+}
+
+func (u *MenuUsecase) MenuVariantList(ctx context.Context, menu_id string) (list []response.MenuVariant, err error) {
+	resp := []response.MenuVariant{}
+
+	mdetail, err := u.menuRepo.MenuDetail(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	if mdetail.Status != constant.MenuStatusPublished {
+		return resp, constant.ErrNotFound
+	}
+
+	variants, err := u.menuRepo.MenuVariantList(ctx, menu_id)
+	if err != nil {
+		return resp, err
+	}
+
+	return variants, nil
+}
+
+func (u *MenuUsecase) MenuVariantDelete(ctx context.Context, menu_id, variant_id string) (err error) {
+	return u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.MenuVariantDetail(ctx, menu_id, variant_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuVariantDelete(ctx, menu_id, variant_id)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenuVariant,
+			EntityId:   variant_id,
+			MenuId:     menu_id,
+			Action:     constant.AuditDeleted,
+			Before:     before,
+		})
+	})
+}
+
+// auditVariant records variant before and after a change in the audit trail and returns the saved variant
+func (u *MenuUsecase) auditVariant(ctx context.Context, menu_id, variant_id, action string, before *response.MenuVariant) (variant response.MenuVariant, err error) {
+	after, err := u.menuRepo.MenuVariantDetail(ctx, menu_id, variant_id)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityMenuVariant,
+		EntityId:   variant_id,
+		MenuId:     menu_id,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+// validVariantPrice checks the price customers pay for the variant is not negative
+func validVariantPrice(menuPrice int, mv request.MenuVariant) bool {
+	if mv.PriceType == constant.VariantPriceAbsolute {
+		return mv.Price >= 0
+	}
+	return menuPrice+mv.Price >= 0
+}
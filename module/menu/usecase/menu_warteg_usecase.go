@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/openinghours"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// WartegLocationSet stores coordinates of a warteg used to list menus near a customer
+func (u *MenuUsecase) WartegLocationSet(ctx context.Context, warteg_id string, req request.WartegLocation) (wl response.WartegLocation, err error) {
+	resp := response.WartegLocation{
+		WartegId: warteg_id,
+	}
+
+	req.WartegId = warteg_id
+	err = u.inTx(ctx, constant.AuditEntityWartegLocation, warteg_id, func(ctx context.Context) error {
+		action := constant.AuditUpdated
+		before, err := u.menuRepo.WartegLocation(ctx, warteg_id)
+		if err == constant.ErrNotFound {
+			action = constant.AuditCreated
+		} else if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.WartegLocationSet(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		after, err := u.menuRepo.WartegLocation(ctx, warteg_id)
+		if err != nil {
+			return err
+		}
+
+		audit := request.AuditLog{
+			EntityType: constant.AuditEntityWartegLocation,
+			EntityId:   warteg_id,
+			WartegId:   warteg_id,
+			Action:     action,
+			After:      after,
+		}
+		if action == constant.AuditUpdated {
+			audit.Before = before
+		}
+		wl = after
+		return u.audit(ctx, audit)
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return wl, nil
+}
+
+func (u *MenuUsecase) WartegLocation(ctx context.Context, warteg_id string) (wl response.WartegLocation, err error) {
+	return u.menuRepo.WartegLocation(ctx, warteg_id)
+}
+
+// WartegHoursSet stores timezone, weekly opening hours and closure dates of a warteg replacing the previous ones, times
+// are local to the timezone of the warteg
+func (u *MenuUsecase) WartegHoursSet(ctx context.Context, warteg_id string, req request.WartegHours) (wh response.WartegHours, err error) {
+	resp := response.WartegHours{
+		WartegId: warteg_id,
+		Timezone: req.Timezone,
+		Hours:    []response.WartegHour{},
+		Closures: []response.WartegClosure{},
+	}
+
+	err = validateWartegHours(&req)
+	if err != nil {
+		return resp, err
+	}
+
+	req.WartegId = warteg_id
+	err = u.inTx(ctx, constant.AuditEntityWartegHours, warteg_id, func(ctx context.Context) error {
+		action := constant.AuditUpdated
+		before, err := u.menuRepo.WartegHours(ctx, warteg_id)
+		if err == constant.ErrNotFound {
+			action = constant.AuditCreated
+		} else if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.WartegHoursSet(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		after, err := u.menuRepo.WartegHours(ctx, warteg_id)
+		if err != nil {
+			return err
+		}
+
+		audit := request.AuditLog{
+			EntityType: constant.AuditEntityWartegHours,
+			EntityId:   warteg_id,
+			WartegId:   warteg_id,
+			Action:     action,
+			After:      after,
+		}
+		if action == constant.AuditUpdated {
+			audit.Before = before
+		}
+		wh = after
+		return u.audit(ctx, audit)
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	wartegOpening(&wh, time.Now())
+	return wh, nil
+}
+
+// WartegHours returns schedule of a warteg with whether it is open now and when it opens next
+func (u *MenuUsecase) WartegHours(ctx context.Context, warteg_id string) (wh response.WartegHours, err error) {
+	wh, err = u.menuRepo.WartegHours(ctx, warteg_id)
+	if err != nil {
+		return wh, err
+	}
+
+	wartegOpening(&wh, time.Now())
+	return wh, nil
+}
+
+// closedWartegs returns wartegs having menus matching filter that are closed at now, wartegs without a schedule are
+// taken as open
+func (u *MenuUsecase) closedWartegs(ctx context.Context, filter request.MenuList, now time.Time) (ids []string, err error) {
+	schedules, err := u.menuRepo.WartegHoursList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ids = []string{}
+	for _, wh := range schedules {
+		schedule, err := wartegSchedule(wh)
+		if err != nil {
+			continue
+		}
+		if !schedule.OpenAt(now) {
+			ids = append(ids, wh.WartegId)
+		}
+	}
+
+	return ids, nil
+}
+
+// openingsChanged returns the latest opening or closing of wartegs matching filter at or before at, zero when none of
+// them has opening hours
+func (u *MenuUsecase) openingsChanged(ctx context.Context, filter request.MenuList, at time.Time) (changed time.Time, err error) {
+	schedules, err := u.menuRepo.WartegHoursList(ctx, filter)
+	if err != nil {
+		return changed, err
+	}
+
+	for _, wh := range schedules {
+		schedule, err := wartegSchedule(wh)
+		if err != nil {
+			continue
+		}
+		if last, ok := schedule.LastChange(at); ok && last.After(changed) {
+			changed = last
+		}
+	}
+
+	return changed, nil
+}
+
+// wartegOpening fills open now and next opening of a warteg at now, the next opening is given only while closed
+func wartegOpening(wh *response.WartegHours, now time.Time) {
+	wh.OpenNow, wh.NextOpening = false, nil
+
+	schedule, err := wartegSchedule(*wh)
+	if err != nil {
+		return
+	}
+
+	wh.OpenNow = schedule.OpenAt(now)
+	if !wh.OpenNow {
+		if next, ok := schedule.NextOpening(now); ok {
+			wh.NextOpening = &next
+		}
+	}
+}
+
+func wartegSchedule(wh response.WartegHours) (*openinghours.Schedule, error) {
+	intervals := make([]openinghours.Interval, len(wh.Hours))
+	for i, h := range wh.Hours {
+		opens, err := openinghours.ParseClock(h.Open)
+		if err != nil {
+			return nil, err
+		}
+		closes, err := openinghours.ParseClock(h.Close)
+		if err != nil {
+			return nil, err
+		}
+		intervals[i] = openinghours.Interval{Weekday: time.Weekday(h.Weekday), Open: opens, Close: closes}
+	}
+
+	dates := make([]string, len(wh.Closures))
+	for i, c := range wh.Closures {
+		dates[i] = c.ClosureDate
+	}
+
+	return openinghours.New(wh.Timezone, intervals, dates)
+}
+
+// validateWartegHours checks the schedule can be evaluated and writes times and dates back in their stored format
+func validateWartegHours(req *request.WartegHours) error {
+	wh := response.WartegHours{Timezone: req.Timezone}
+
+	seenHours := map[response.WartegHour]bool{}
+	for i, h := range req.Hours {
+		opens, err := openinghours.ParseClock(h.Open)
+		if err != nil {
+			return constant.ErrInvalidWartegHours
+		}
+		closes, err := openinghours.ParseClock(h.Close)
+		if err != nil {
+			return constant.ErrInvalidWartegHours
+		}
+		req.Hours[i].Open, req.Hours[i].Close = formatClock(opens), formatClock(closes)
+
+		key := response.WartegHour{Weekday: h.Weekday, Open: req.Hours[i].Open}
+		if seenHours[key] {
+			return constant.ErrInvalidWartegHours
+		}
+		seenHours[key] = true
+		wh.Hours = append(wh.Hours, response.WartegHour{Weekday: h.Weekday, Open: req.Hours[i].Open, Close: req.Hours[i].Close})
+	}
+
+	seenDates := map[string]bool{}
+	for i, c := range req.Closures {
+		date, err := time.Parse(openinghours.DateLayout, c.ClosureDate)
+		if err != nil {
+			return constant.ErrInvalidWartegHours
+		}
+		req.Closures[i].ClosureDate = date.Format(openinghours.DateLayout)
+		if seenDates[req.Closures[i].ClosureDate] {
+			return constant.ErrInvalidWartegHours
+		}
+		seenDates[req.Closures[i].ClosureDate] = true
+		wh.Closures = append(wh.Closures, response.WartegClosure{ClosureDate: req.Closures[i].ClosureDate})
+	}
+
+	_, err := wartegSchedule(wh)
+	if err != nil {
+		return constant.ErrInvalidWartegHours
+	}
+
+	return nil
+}
+
+func formatClock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
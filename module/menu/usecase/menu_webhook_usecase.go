@@ -0,0 +1,310 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/event"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+	"github.com/google/uuid"
+	log "go.uber.org/zap"
+)
+
+const (
+	webhookRetryMin = 10 * time.Second
+	webhookRetryMax = time.Hour
+)
+
+// WebhookAdd subscribes an endpoint to menu events of a warteg, a secret is generated when none is given and it is
+// shown only in this response
+func (u *MenuUsecase) WebhookAdd(ctx context.Context, req request.Webhook) (w response.Webhook, err error) {
+	resp := response.Webhook{
+		WartegId:   req.WartegId,
+		Url:        req.Url,
+		EventTypes: []string{},
+	}
+
+	if req.Secret == "" {
+		req.Secret, err = webhookSecret()
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	err = validateWebhook(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	req.WebhookId = uuid.New().String()
+
+	err = u.inTx(ctx, "", "", func(ctx context.Context) error {
+		err := u.menuRepo.WebhookAdd(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		w, err = u.auditWebhook(ctx, req.WebhookId, constant.AuditCreated, nil)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	w.Secret = req.Secret
+	return w, nil
+}
+
+// WebhookUpdate changes endpoint, event types or state of a webhook, an empty secret keeps the current one
+func (u *MenuUsecase) WebhookUpdate(ctx context.Context, webhook_id string, req request.Webhook) (w response.Webhook, err error) {
+	resp := response.Webhook{
+		WebhookId:  webhook_id,
+		WartegId:   req.WartegId,
+		Url:        req.Url,
+		EventTypes: []string{},
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityWebhook, webhook_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.WebhookDetail(ctx, webhook_id)
+		if err != nil {
+			return err
+		}
+
+		if req.Secret == "" {
+			req.Secret = before.Secret
+		}
+
+		err = validateWebhook(ctx, req)
+		if err != nil {
+			return err
+		}
+		req.WebhookId = webhook_id
+
+		err = u.menuRepo.WebhookUpdate(ctx, req)
+		if err != nil {
+			return err
+		}
+
+		before.Secret = ""
+		w, err = u.auditWebhook(ctx, webhook_id, constant.AuditUpdated, &before)
+		return err
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return w, nil
+}
+
+func (u *MenuUsecase) WebhookDelete(ctx context.Context, webhook_id string) (err error) {
+	return u.inTx(ctx, constant.AuditEntityWebhook, webhook_id, func(ctx context.Context) error {
+		before, err := u.WebhookDetail(ctx, webhook_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.WebhookDelete(ctx, webhook_id)
+		if err != nil {
+			return err
+		}
+
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityWebhook,
+			EntityId:   webhook_id,
+			WartegId:   before.WartegId,
+			Action:     constant.AuditDeleted,
+			Before:     before,
+		})
+	})
+}
+
+func (u *MenuUsecase) WebhookDetail(ctx context.Context, webhook_id string) (w response.Webhook, err error) {
+	w, err = u.menuRepo.WebhookDetail(ctx, webhook_id)
+	w.Secret = ""
+	return w, err
+}
+
+func (u *MenuUsecase) WebhookList(ctx context.Context, warteg_id string) (list []response.Webhook, err error) {
+	resp := []response.Webhook{}
+
+	webhooks, err := u.menuRepo.WebhookList(ctx, warteg_id)
+	if err != nil {
+		return resp, err
+	}
+
+	for i := range webhooks {
+		webhooks[i].Secret = ""
+	}
+
+	return webhooks, nil
+}
+
+func (u *MenuUsecase) WebhookDeliveryList(ctx context.Context, webhook_id string, filter request.WebhookDeliveryFilter) (list []response.WebhookDelivery, err error) {
+	resp := []response.WebhookDelivery{}
+
+	_, err = u.menuRepo.WebhookDetail(ctx, webhook_id)
+	if err != nil {
+		return resp, err
+	}
+
+	if filter.Limit <= 0 {
+		filter.Limit = constant.WebhookDeliveryLimit
+	}
+	if filter.Limit > constant.WebhookDeliveryMaxLimit {
+		filter.Limit = constant.WebhookDeliveryMaxLimit
+	}
+
+	deliveries, err := u.menuRepo.WebhookDeliveryList(ctx, webhook_id, filter)
+	if err != nil {
+		return resp, err
+	}
+
+	return deliveries, nil
+}
+
+// WebhookRedeliver sends a delivery again right away whatever its status, the attempt is recorded like automatic ones
+func (u *MenuUsecase) WebhookRedeliver(ctx context.Context, webhook_id string, delivery_id int64) (d response.WebhookDelivery, err error) {
+	w, err := u.menuRepo.WebhookDetail(ctx, webhook_id)
+	if err != nil {
+		return d, err
+	}
+
+	d, err = u.menuRepo.WebhookDeliveryDetail(ctx, webhook_id, delivery_id)
+	if err != nil {
+		return d, err
+	}
+
+	err = u.deliverWebhook(ctx, w, d)
+	if err != nil {
+		return d, err
+	}
+
+	return u.menuRepo.WebhookDeliveryDetail(ctx, webhook_id, delivery_id)
+}
+
+// WebhookDeliveryRun sends every due delivery of active webhooks, run periodically by the scheduler. Due deliveries
+// are claimed for a lease first so instances running side by side do not post the same delivery. Deliveries are
+// not ordered, receivers use event_id to drop duplicates and occurred_at to drop stale events
+func (u *MenuUsecase) WebhookDeliveryRun(ctx context.Context) (err error) {
+	for {
+		now := time.Now()
+		due, err := u.menuRepo.WebhookDeliveryClaim(ctx, now, now.Add(constant.WebhookDeliveryLeaseSeconds*time.Second), constant.WebhookDeliveryBatch)
+		if err != nil {
+			return err
+		}
+
+		webhooks := map[string]*response.Webhook{}
+		for _, d := range due {
+			w, ok := webhooks[d.WebhookId]
+			if !ok {
+				detail, err := u.menuRepo.WebhookDetail(ctx, d.WebhookId)
+				if err != nil && err != constant.ErrNotFound {
+					return err
+				}
+				if err == nil {
+					w = &detail
+				}
+				webhooks[d.WebhookId] = w
+			}
+			if w == nil {
+				// deleted meanwhile
+				continue
+			}
+
+			err = u.deliverWebhook(ctx, *w, d)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(due) < constant.WebhookDeliveryBatch {
+			return nil
+		}
+	}
+}
+
+// deliverWebhook posts delivery to the webhook endpoint and records the attempt, a failed delivery waits with
+// exponential backoff and is given up after WebhookMaxAttempts
+func (u *MenuUsecase) deliverWebhook(ctx context.Context, w response.Webhook, d response.WebhookDelivery) error {
+	status := constant.WebhookDeliverySuccess
+	next := time.Now()
+	lastError := ""
+
+	code, err := u.webhookPoster.Post(ctx, w.Url, w.Secret, event.Event{
+		EventId:     d.EventId,
+		EventType:   d.EventType,
+		AggregateId: d.MenuId,
+		WartegId:    w.WartegId,
+		OccurredAt:  d.OccurredDate,
+		Data:        d.Payload,
+	})
+	if err != nil {
+		lastError = err.Error()
+		status = constant.WebhookDeliveryPending
+		next = next.Add(backoff(d.Attempts, webhookRetryMin, webhookRetryMax))
+		if d.Attempts+1 >= constant.WebhookMaxAttempts {
+			status = constant.WebhookDeliveryFailed
+		}
+		log.S().Warn("webhook ", w.WebhookId, " delivery ", d.DeliveryId, " failed, attempt ", d.Attempts+1, ", status ", status, " : ", err)
+	}
+
+	return u.menuRepo.WebhookDeliveryResult(ctx, d.DeliveryId, status, next, code, lastError)
+}
+
+// auditWebhook records webhook before and after a change in the audit trail and returns the saved webhook without
+// its secret
+func (u *MenuUsecase) auditWebhook(ctx context.Context, webhook_id, action string, before *response.Webhook) (w response.Webhook, err error) {
+	after, err := u.WebhookDetail(ctx, webhook_id)
+	if err != nil {
+		return after, err
+	}
+
+	err = u.audit(ctx, request.AuditLog{
+		EntityType: constant.AuditEntityWebhook,
+		EntityId:   webhook_id,
+		WartegId:   after.WartegId,
+		Action:     action,
+		Before:     before,
+		After:      after,
+	})
+
+	return after, err
+}
+
+// validateWebhook checks url and secret of webhook, the host must resolve to public addresses only so a webhook can
+// not reach internal services. The poster checks the dialed address again at every delivery
+func validateWebhook(ctx context.Context, req request.Webhook) error {
+	endpoint, err := url.Parse(req.Url)
+	if err != nil || endpoint.Scheme != "http" && endpoint.Scheme != "https" || endpoint.Hostname() == "" {
+		return constant.ErrInvalidWebhook
+	}
+
+	if len(req.Secret) < 16 {
+		return constant.ErrInvalidWebhook
+	}
+
+	err = event.CheckHost(ctx, endpoint.Hostname())
+	if err == event.ErrForbiddenAddress {
+		return constant.ErrWebhookAddress
+	}
+	if err != nil {
+		return constant.ErrInvalidWebhook
+	}
+
+	return nil
+}
+
+func webhookSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,197 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/cpartogi/foodmenu/constant"
+	"github.com/cpartogi/foodmenu/pkg/openinghours"
+	"github.com/cpartogi/foodmenu/schema/request"
+	"github.com/cpartogi/foodmenu/schema/response"
+)
+
+// MenuWindowSet replaces serving windows of menu, a menu without windows is served whenever its warteg is. Times are
+// local to the timezone of the warteg, or of the business day when the warteg has no opening hours
+func (u *MenuUsecase) MenuWindowSet(ctx context.Context, menu_id string, req request.MenuWindows) (mw response.MenuWindows, err error) {
+	resp := response.MenuWindows{
+		MenuId:  menu_id,
+		Windows: []response.MenuWindow{},
+	}
+
+	err = validateMenuWindows(&req)
+	if err != nil {
+		return resp, err
+	}
+
+	err = u.inTx(ctx, constant.AuditEntityMenu, menu_id, func(ctx context.Context) error {
+		before, err := u.menuRepo.MenuWindows(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		err = u.menuRepo.MenuWindowSet(ctx, menu_id, req.Windows)
+		if err != nil {
+			return err
+		}
+
+		after, err := u.menuWindows(ctx, menu_id)
+		if err != nil {
+			return err
+		}
+
+		mw = after
+		return u.audit(ctx, request.AuditLog{
+			EntityType: constant.AuditEntityMenuWindow,
+			EntityId:   menu_id,
+			MenuId:     menu_id,
+			WartegId:   after.WartegId,
+			Action:     constant.AuditUpdated,
+			Before:     before.Windows,
+			After:      after.Windows,
+		})
+	})
+
+	if err != nil {
+		return resp, err
+	}
+
+	return mw, nil
+}
+
+// MenuWindows returns serving windows of published menu with the timezone they are evaluated in
+func (u *MenuUsecase) MenuWindows(ctx context.Context, menu_id string) (mw response.MenuWindows, err error) {
+	err = u.publishedMenu(ctx, menu_id)
+	if err != nil {
+		return response.MenuWindows{MenuId: menu_id, Windows: []response.MenuWindow{}}, err
+	}
+
+	return u.menuWindows(ctx, menu_id)
+}
+
+// menuWindows returns serving windows of menu of any status
+func (u *MenuUsecase) menuWindows(ctx context.Context, menu_id string) (mw response.MenuWindows, err error) {
+	mw, err = u.menuRepo.MenuWindows(ctx, menu_id)
+	if err != nil {
+		return mw, err
+	}
+
+	if mw.Timezone == "" {
+		mw.Timezone = u.clock.Location().String()
+	}
+	return mw, nil
+}
+
+// offScheduleMenus returns menus matching filter having windows none of which includes at
+func (u *MenuUsecase) offScheduleMenus(ctx context.Context, filter request.MenuList, at time.Time) (ids []string, err error) {
+	list, err := u.menuRepo.MenuWindowsList(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ids = []string{}
+	for _, mw := range list {
+		if !u.menuServedAt(mw, at) {
+			ids = append(ids, mw.MenuId)
+		}
+	}
+
+	return ids, nil
+}
+
+// windowsChanged returns the latest start or end of a serving window of menus matching filter at or before at, zero
+// when none of them has windows
+func (u *MenuUsecase) windowsChanged(ctx context.Context, filter request.MenuList, at time.Time) (changed time.Time, err error) {
+	list, err := u.menuRepo.MenuWindowsList(ctx, filter)
+	if err != nil {
+		return changed, err
+	}
+
+	for _, mw := range list {
+		schedule, err := u.windowSchedule(mw)
+		if err != nil {
+			continue
+		}
+		if last, ok := schedule.LastChange(at); ok && last.After(changed) {
+			changed = last
+		}
+	}
+
+	return changed, nil
+}
+
+// menuServedAt tells whether at falls in a serving window of menu, windows that cannot be evaluated are ignored
+func (u *MenuUsecase) menuServedAt(mw response.MenuWindows, at time.Time) bool {
+	if len(mw.Windows) == 0 {
+		return true
+	}
+
+	schedule, err := u.windowSchedule(mw)
+	if err != nil {
+		return true
+	}
+
+	return schedule.OpenAt(at)
+}
+
+// windowSchedule turns serving windows of menu into a schedule in the timezone of its warteg, or of the business day
+// when the warteg has no opening hours
+func (u *MenuUsecase) windowSchedule(mw response.MenuWindows) (*openinghours.Schedule, error) {
+	timezone := mw.Timezone
+	if timezone == "" {
+		timezone = u.clock.Location().String()
+	}
+
+	return menuSchedule(timezone, mw.Windows)
+}
+
+// menuSchedule turns windows into weekly intervals, one for every weekday of a window
+func menuSchedule(timezone string, windows []response.MenuWindow) (*openinghours.Schedule, error) {
+	intervals := []openinghours.Interval{}
+	for _, w := range windows {
+		start, err := openinghours.ParseClock(w.Start)
+		if err != nil {
+			return nil, err
+		}
+		end, err := openinghours.ParseClock(w.End)
+		if err != nil {
+			return nil, err
+		}
+		for _, d := range w.Weekdays {
+			intervals = append(intervals, openinghours.Interval{Weekday: time.Weekday(d), Open: start, Close: end})
+		}
+	}
+
+	return openinghours.New(timezone, intervals, nil)
+}
+
+// validateMenuWindows checks windows can be evaluated and writes times back in their stored format
+func validateMenuWindows(req *request.MenuWindows) error {
+	windows := []response.MenuWindow{}
+	for i, w := range req.Windows {
+		start, err := openinghours.ParseClock(w.Start)
+		if err != nil {
+			return constant.ErrInvalidMenuWindow
+		}
+		end, err := openinghours.ParseClock(w.End)
+		if err != nil {
+			return constant.ErrInvalidMenuWindow
+		}
+		req.Windows[i].Start, req.Windows[i].End = formatClock(start), formatClock(end)
+
+		seen := map[int]bool{}
+		for _, d := range w.Weekdays {
+			if seen[d] {
+				return constant.ErrInvalidMenuWindow
+			}
+			seen[d] = true
+		}
+		windows = append(windows, response.MenuWindow{Weekdays: w.Weekdays, Start: req.Windows[i].Start, End: req.Windows[i].End})
+	}
+
+	_, err := menuSchedule("UTC", windows)
+	if err != nil {
+		return constant.ErrInvalidMenuWindow
+	}
+
+	return nil
+}
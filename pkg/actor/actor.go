@@ -0,0 +1,67 @@
+package actor
+
+import (
+	"context"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Header carries id of the user or service calling the api, set by the gateway in front of this service
+const Header = "X-Actor-Id"
+
+// Anonymous is actor of requests without actor header
+const Anonymous = "anonymous"
+
+type contextKey struct{}
+
+type requestKey struct{}
+
+type request struct {
+	id string
+	ip string
+}
+
+// NewContext returns ctx carrying actor id
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, contextKey{}, id)
+}
+
+// FromContext returns actor id carried by ctx, anonymous when there is none
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(contextKey{}).(string)
+	if id == "" {
+		return Anonymous
+	}
+	return id
+}
+
+// WithRequest returns ctx carrying id and source ip of the request made by the actor
+func WithRequest(ctx context.Context, request_id, ip string) context.Context {
+	return context.WithValue(ctx, requestKey{}, request{id: request_id, ip: ip})
+}
+
+// Request returns id and source ip of the request carried by ctx, both are empty outside of a request
+func Request(ctx context.Context) (request_id, ip string) {
+	r, _ := ctx.Value(requestKey{}).(request)
+	return r.id, r.ip
+}
+
+// Middleware puts actor header, request id and source ip of every request into its context,
+// it must run after the request id middleware
+func Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			ctx := WithRequest(req.Context(), c.Response().Header().Get(echo.HeaderXRequestID), c.RealIP())
+
+			id := strings.TrimSpace(req.Header.Get(Header))
+			if id != "" {
+				ctx = NewContext(ctx, id)
+			}
+
+			c.SetRequest(req.WithContext(ctx))
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package businessday
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateLayout is layout of business date, matches mysql DATE column
+const DateLayout = "2006-01-02"
+
+// Clock tells which business day a moment belongs to, a business day starts at opening time in local timezone
+type Clock struct {
+	location *time.Location
+	opening  time.Duration
+}
+
+// New creates clock from IANA timezone name and opening time in HH:MM format
+func New(timezone, opening string) (*Clock, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := time.Parse("15:04", opening)
+	if err != nil {
+		return nil, fmt.Errorf("invalid opening time %q, use HH:MM", opening)
+	}
+
+	return &Clock{
+		location: loc,
+		opening:  time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute,
+	}, nil
+}
+
+// ZoneStep is the step of utc offsets of every timezone, opening times of all timezones fall on a grid of this step
+const ZoneStep = 15 * time.Minute
+
+// In returns clock with the same opening time in another timezone
+func (c *Clock) In(loc *time.Location) *Clock {
+	return &Clock{location: loc, opening: c.opening}
+}
+
+// Location returns timezone of the clock
+func (c *Clock) Location() *time.Location {
+	return c.location
+}
+
+// Date returns business date of t, moments before opening time belong to the previous day
+func (c *Clock) Date(t time.Time) string {
+	return t.In(c.location).Add(-c.opening).Format(DateLayout)
+}
+
+// NextOpening returns the first opening time after t
+func (c *Clock) NextOpening(t time.Time) time.Time {
+	local := t.In(c.location)
+	next := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location).Add(c.opening)
+	for !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+// NextOpeningAnywhere returns the first moment after t at which the opening time is reached in some timezone
+func (c *Clock) NextOpeningAnywhere(t time.Time) time.Time {
+	next := c.NextOpening(t)
+	steps := (next.Sub(t) - 1) / ZoneStep
+	return next.Add(-steps * ZoneStep)
+}
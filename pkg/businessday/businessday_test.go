@@ -0,0 +1,48 @@
+package businessday
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextOpeningAnywhere(t *testing.T) {
+	clock, err := New("Asia/Jakarta", "06:00")
+	assert.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		now      string
+		expected string
+	}{
+		{"#1 next quarter of an hour", "2026-10-19T05:50:00+07:00", "2026-10-19T06:00:00+07:00"},
+		{"#2 opening of another timezone", "2026-10-19T06:00:00+07:00", "2026-10-19T06:15:00+07:00"},
+		{"#3 between quarters", "2026-10-19T13:07:30+07:00", "2026-10-19T13:15:00+07:00"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			now, _ := time.Parse(time.RFC3339, testCase.now)
+			expected, _ := time.Parse(time.RFC3339, testCase.expected)
+
+			assert.True(t, expected.Equal(clock.NextOpeningAnywhere(now)))
+		})
+	}
+}
+
+func TestClockIn(t *testing.T) {
+	clock, err := New("Asia/Jakarta", "06:00")
+	assert.NoError(t, err)
+
+	loc, err := time.LoadLocation("Asia/Jayapura")
+	assert.NoError(t, err)
+
+	// 06:30 in Jayapura is still 04:30 of the previous business day in Jakarta
+	now, _ := time.Parse(time.RFC3339, "2026-10-19T06:30:00+09:00")
+	assert.Equal(t, "2026-10-18", clock.Date(now))
+	assert.Equal(t, "2026-10-19", clock.In(loc).Date(now))
+
+	next := clock.In(loc).NextOpening(now)
+	assert.Equal(t, "2026-10-20T06:00:00+09:00", next.Format(time.RFC3339))
+}
@@ -0,0 +1,67 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+)
+
+// BatchFunc loads values of several keys at once, keys missing from the returned map resolve to nil
+type BatchFunc func(ctx context.Context, keys []string) (map[string]interface{}, error)
+
+// Loader collects keys requested through Load and fetches them with a single BatchFunc call once the first returned
+// thunk is called. It fits resolvers that return thunks, every field of one level is resolved before the thunks run,
+// so sibling lookups end up in the same batch. Results are cached for the life of the loader, create one per request.
+type Loader struct {
+	mu      sync.Mutex
+	batch   BatchFunc
+	pending []string
+	results map[string]*result
+}
+
+type result struct {
+	value interface{}
+	err   error
+	done  bool
+}
+
+// New creates loader backed by batch
+func New(batch BatchFunc) *Loader {
+	return &Loader{
+		batch:   batch,
+		results: map[string]*result{},
+	}
+}
+
+// Load queues key for the next batch and returns thunk resolving its value
+func (l *Loader) Load(ctx context.Context, key string) func() (interface{}, error) {
+	l.mu.Lock()
+	if _, ok := l.results[key]; !ok {
+		l.results[key] = &result{}
+		l.pending = append(l.pending, key)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		r := l.results[key]
+		if !r.done {
+			l.dispatch(ctx)
+		}
+
+		return r.value, r.err
+	}
+}
+
+// dispatch fetches every pending key, the caller holds the lock
+func (l *Loader) dispatch(ctx context.Context) {
+	keys := l.pending
+	l.pending = nil
+
+	values, err := l.batch(ctx, keys)
+	for _, key := range keys {
+		r := l.results[key]
+		r.value, r.err, r.done = values[key], err, true
+	}
+}
@@ -0,0 +1,51 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrUnknownSink is returned when event sink in config is not supported
+var ErrUnknownSink = fmt.Errorf("unknown event sink, use stdout, webhook, nats or kafka")
+
+// Event is a domain event published to downstream services, consumers deduplicate redelivered events by EventId
+type Event struct {
+	EventId     string          `json:"event_id"`
+	EventType   string          `json:"event_type"`
+	AggregateId string          `json:"aggregate_id"`
+	WartegId    string          `json:"warteg_id"`
+	OccurredAt  time.Time       `json:"occurred_at"`
+	Data        json.RawMessage `json:"data"`
+}
+
+// Sink delivers events to a downstream transport, an error means the event must be published again later
+type Sink interface {
+	Publish(ctx context.Context, e Event) error
+}
+
+type multi []Sink
+
+// Multi publishes every event to all sinks, an event failing on one sink is published to all of them again
+func Multi(sinks ...Sink) Sink {
+	if len(sinks) == 1 {
+		return sinks[0]
+	}
+	return multi(sinks)
+}
+
+func (m multi) Publish(ctx context.Context, e Event) error {
+	errs := []string{}
+	for _, s := range m {
+		if err := s.Publish(ctx, e); err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%s", strings.Join(errs, "; "))
+	}
+	return nil
+}
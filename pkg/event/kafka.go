@@ -0,0 +1,82 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type kafkaRest struct {
+	url    string
+	client *http.Client
+}
+
+type kafkaRecords struct {
+	Records []kafkaRecord `json:"records"`
+}
+
+type kafkaRecord struct {
+	Key   string `json:"key"`
+	Value Event  `json:"value"`
+}
+
+// NewKafkaRest creates sink producing every event to topic through a Kafka REST proxy (Confluent REST proxy,
+// Redpanda pandaproxy), events are keyed by aggregate id so events of one menu stay in one partition
+func NewKafkaRest(restURL, topic string, timeout time.Duration) (Sink, error) {
+	if restURL == "" || topic == "" {
+		return nil, fmt.Errorf("kafka rest url and topic are mandatory")
+	}
+
+	return &kafkaRest{
+		url:    strings.TrimRight(restURL, "/") + "/topics/" + topic,
+		client: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (k *kafkaRest) Publish(ctx context.Context, e Event) error {
+	b, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: e.AggregateId, Value: e}}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, k.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("kafka rest proxy responded %s", resp.Status)
+	}
+
+	// the proxy answers 200 with an error per record when producing fails
+	var result struct {
+		Offsets []struct {
+			ErrorCode *int   `json:"error_code"`
+			Error     string `json:"error"`
+		} `json:"offsets"`
+	}
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range result.Offsets {
+		if o.ErrorCode != nil || o.Error != "" {
+			return fmt.Errorf("kafka rest proxy failed to produce : %s", o.Error)
+		}
+	}
+
+	return nil
+}
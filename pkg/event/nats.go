@@ -0,0 +1,128 @@
+package event
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// nats speaks the text protocol of NATS core directly, every publish is followed by PING so the event counts as
+// delivered only after the server has processed it
+type nats struct {
+	mu      sync.Mutex
+	address string
+	prefix  string
+	timeout time.Duration
+	conn    net.Conn
+	reader  *bufio.Reader
+}
+
+// NewNATS creates sink publishing every event to subject prefix.event_type on a NATS server, for example
+// foodmenu.menu.updated
+func NewNATS(address, prefix string, timeout time.Duration) (Sink, error) {
+	if address == "" {
+		return nil, fmt.Errorf("nats address is mandatory")
+	}
+
+	return &nats{address: address, prefix: prefix, timeout: timeout}, nil
+}
+
+func (n *nats) Publish(ctx context.Context, e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	subject := e.EventType
+	if n.prefix != "" {
+		subject = n.prefix + "." + subject
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	err = n.publish(ctx, subject, b)
+	if err != nil && n.conn != nil {
+		// the connection state is unknown after a failure, the next publish reconnects
+		n.conn.Close()
+		n.conn = nil
+	}
+
+	return err
+}
+
+func (n *nats) publish(ctx context.Context, subject string, payload []byte) error {
+	if n.conn == nil {
+		err := n.connect(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	deadline := time.Now().Add(n.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	n.conn.SetDeadline(deadline)
+
+	_, err := fmt.Fprintf(n.conn, "PUB %s %d\r\n%s\r\nPING\r\n", subject, len(payload), payload)
+	if err != nil {
+		return err
+	}
+
+	for {
+		line, err := n.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			_, err = n.conn.Write([]byte("PONG\r\n"))
+			if err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return fmt.Errorf("nats error : %s", strings.TrimSpace(strings.TrimPrefix(line, "-ERR")))
+		}
+	}
+}
+
+func (n *nats) connect(ctx context.Context) error {
+	dialer := net.Dialer{Timeout: n.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", n.address)
+	if err != nil {
+		return err
+	}
+
+	conn.SetDeadline(time.Now().Add(n.timeout))
+	reader := bufio.NewReader(conn)
+
+	info, err := reader.ReadString('\n')
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	if !strings.HasPrefix(info, "INFO") {
+		conn.Close()
+		return fmt.Errorf("unexpected nats greeting %q", strings.TrimSpace(info))
+	}
+
+	_, err = conn.Write([]byte(`CONNECT {"verbose":false,"pedantic":false,"name":"foodmenu"}` + "\r\n"))
+	if err != nil {
+		conn.Close()
+		return err
+	}
+
+	n.conn = conn
+	n.reader = reader
+	return nil
+}
@@ -0,0 +1,140 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader carries sha256=<hex hmac of timestamp.body> keyed with the subscription secret
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader carries unix time the request was signed, receivers should reject old timestamps
+	TimestampHeader = "X-Webhook-Timestamp"
+)
+
+// Poster posts events to subscriber endpoints signed with the secret of the subscription
+type Poster interface {
+	Post(ctx context.Context, url, secret string, e Event) (status int, err error)
+}
+
+// ErrForbiddenAddress is returned for a subscriber endpoint resolving to a private, loopback or link local address
+var ErrForbiddenAddress = errors.New("webhook address is not public")
+
+type poster struct {
+	client *http.Client
+}
+
+// NewPoster creates poster waiting at most timeout for every subscriber response. It only connects to public
+// addresses, checked on the address actually dialed so a name resolving differently later is still refused, and
+// it does not follow redirects
+func NewPoster(timeout time.Duration) Poster {
+	return newPoster(timeout, PublicIP)
+}
+
+func newPoster(timeout time.Duration, allow func(ip net.IP) bool) *poster {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !allow(ip) {
+				return ErrForbiddenAddress
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &poster{client: &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// PublicIP tells whether ip may receive webhooks, private, loopback, link local, multicast and unspecified
+// addresses are refused
+func PublicIP(ip net.IP) bool {
+	return !(ip.IsPrivate() || ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
+
+// CheckHost resolves host and returns ErrForbiddenAddress when any of its addresses is not public
+func CheckHost(ctx context.Context, host string) error {
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range addrs {
+		if !PublicIP(a.IP) {
+			return ErrForbiddenAddress
+		}
+	}
+
+	return nil
+}
+
+// Sign returns signature of body sent at timestamp, receivers compute it the same way and compare
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Post sends event as json and returns the response status, any response other than 2xx including a redirect is a
+// failed delivery
+func (p *poster) Post(ctx context.Context, url, secret string, e Event) (int, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", e.EventId)
+	req.Header.Set("X-Event-Type", e.EventType)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, b))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// drain a little of the body so the connection can be reused
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,135 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func allowAll(ip net.IP) bool {
+	return true
+}
+
+func TestPosterPost(t *testing.T) {
+	type output struct {
+		status int
+		err    bool
+	}
+
+	secret := "0123456789abcdef"
+	e := Event{
+		EventId:     "ev-1",
+		EventType:   "menu.updated",
+		AggregateId: "menu-1",
+		WartegId:    "abc",
+		OccurredAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Data:        json.RawMessage(`{"menu_price":15000}`),
+	}
+
+	cases := []struct {
+		name           string
+		handler        http.HandlerFunc
+		expectedOutput output
+	}{
+		{
+			name: "#1 success signed delivery",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+				if err != nil || time.Since(time.Unix(timestamp, 0)) > time.Minute {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.Header.Get(SignatureHeader) != Sign(secret, timestamp, body) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.Header.Get("X-Event-Id") != e.EventId || r.Header.Get("X-Event-Type") != e.EventType {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedOutput: output{http.StatusNoContent, false},
+		},
+		{
+			name: "#2 failed server error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			expectedOutput: output{http.StatusInternalServerError, true},
+		},
+		{
+			name: "#3 failed client error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusGone)
+			},
+			expectedOutput: output{http.StatusGone, true},
+		},
+		{
+			name: "#4 failed redirect is not followed",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+			},
+			expectedOutput: output{http.StatusFound, true},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			server := httptest.NewServer(testCase.handler)
+			defer server.Close()
+
+			p := newPoster(time.Second, allowAll)
+			status, err := p.Post(context.Background(), server.URL, secret, e)
+
+			assert.Equal(t, testCase.expectedOutput.status, status)
+			assert.Equal(t, testCase.expectedOutput.err, err != nil)
+		})
+	}
+}
+
+func TestPosterPostForbiddenAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	p := NewPoster(time.Second)
+	status, err := p.Post(context.Background(), server.URL, "0123456789abcdef", Event{EventId: "ev-1"})
+
+	assert.Equal(t, 0, status)
+	assert.ErrorIs(t, err, ErrForbiddenAddress)
+}
+
+func TestPublicIP(t *testing.T) {
+	cases := []struct {
+		name     string
+		ip       string
+		expected bool
+	}{
+		{"#1 public v4", "93.184.216.34", true},
+		{"#2 public v6", "2606:2800:220:1:248:1893:25c8:1946", true},
+		{"#3 loopback", "127.0.0.1", false},
+		{"#4 loopback v6", "::1", false},
+		{"#5 private", "10.1.2.3", false},
+		{"#6 private 192.168", "192.168.0.10", false},
+		{"#7 link local metadata", "169.254.169.254", false},
+		{"#8 unspecified", "0.0.0.0", false},
+		{"#9 unique local v6", "fd00::1", false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, PublicIP(net.ParseIP(testCase.ip)))
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package event
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+)
+
+type writer struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+// NewWriter creates sink writing every event as one json line, used with os.Stdout for local development
+// and log based pipelines
+func NewWriter(out io.Writer) Sink {
+	return &writer{out: out}
+}
+
+func (w *writer) Publish(ctx context.Context, e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err = w.out.Write(append(b, '\n'))
+	return err
+}
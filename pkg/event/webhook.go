@@ -0,0 +1,51 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+type webhook struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhook creates sink posting every event as json to url, any response other than 2xx is a failed delivery
+func NewWebhook(url string, timeout time.Duration) (Sink, error) {
+	if url == "" {
+		return nil, fmt.Errorf("webhook url is mandatory")
+	}
+
+	return &webhook{url: url, client: &http.Client{Timeout: timeout}}, nil
+}
+
+func (w *webhook) Publish(ctx context.Context, e Event) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", e.EventId)
+	req.Header.Set("X-Event-Type", e.EventType)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// EarthRadius is radius in meters used for distances, the same sphere mysql ST_Distance_Sphere uses by default
+const EarthRadius = 6370986.0
+
+// Point is a location in degrees
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+// WKT returns point as well known text in longitude latitude order, read it with axis-order=long-lat
+func (p Point) WKT() string {
+	return fmt.Sprintf("POINT(%s %s)", formatDegrees(p.Lng), formatDegrees(p.Lat))
+}
+
+// Box is an area between two latitudes and two longitudes
+type Box struct {
+	Min Point
+	Max Point
+}
+
+// BoundingBox returns the smallest box holding every point within radius meters of p, so an index on the box can
+// narrow candidates before exact distances are computed. There is no such box when the circle reaches a pole or
+// crosses the antimeridian, ok is false then.
+func BoundingBox(p Point, radius float64) (box Box, ok bool) {
+	dLat := radius / EarthRadius * 180 / math.Pi
+	if p.Lat-dLat <= -90 || p.Lat+dLat >= 90 {
+		return box, false
+	}
+
+	dLng := dLat / math.Cos(math.Max(math.Abs(p.Lat-dLat), math.Abs(p.Lat+dLat))*math.Pi/180)
+	if p.Lng-dLng < -180 || p.Lng+dLng > 180 {
+		return box, false
+	}
+
+	box = Box{
+		Min: Point{Lat: p.Lat - dLat, Lng: p.Lng - dLng},
+		Max: Point{Lat: p.Lat + dLat, Lng: p.Lng + dLng},
+	}
+	return box, true
+}
+
+// WKT returns box as well known text polygon in longitude latitude order, read it with axis-order=long-lat
+func (b Box) WKT() string {
+	minLng, minLat := formatDegrees(b.Min.Lng), formatDegrees(b.Min.Lat)
+	maxLng, maxLat := formatDegrees(b.Max.Lng), formatDegrees(b.Max.Lat)
+	return fmt.Sprintf("POLYGON((%[1]s %[2]s, %[3]s %[2]s, %[3]s %[4]s, %[1]s %[4]s, %[1]s %[2]s))", minLng, minLat, maxLng, maxLat)
+}
+
+func formatDegrees(d float64) string {
+	return strconv.FormatFloat(d, 'f', -1, 64)
+}
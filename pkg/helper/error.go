@@ -8,8 +8,34 @@ import (
 )
 
 var commonErrorMap = map[error]int{
-	constant.ErrNotFound: http.StatusNotFound,
-	constant.ErrConflict: http.StatusConflict,
+	constant.ErrNotFound:                 http.StatusNotFound,
+	constant.ErrConflict:                 http.StatusConflict,
+	constant.ErrInvalidSyncToken:         http.StatusBadRequest,
+	constant.ErrInvalidImportFile:        http.StatusBadRequest,
+	constant.ErrImportRowInvalid:         http.StatusBadRequest,
+	constant.ErrOutOfStock:               http.StatusConflict,
+	constant.ErrImageMandatory:           http.StatusBadRequest,
+	constant.ErrImageTooLarge:            http.StatusBadRequest,
+	constant.ErrImageTooManyPixels:       http.StatusBadRequest,
+	constant.ErrInvalidImageType:         http.StatusBadRequest,
+	constant.ErrInvalidImageOrder:        http.StatusBadRequest,
+	constant.ErrInvalidVariantPrice:      http.StatusBadRequest,
+	constant.ErrInvalidModifierGroup:     http.StatusBadRequest,
+	constant.ErrInvalidModifierLink:      http.StatusBadRequest,
+	constant.ErrInvalidModifierSelection: http.StatusBadRequest,
+	constant.ErrInvalidBundleItem:        http.StatusBadRequest,
+	constant.ErrMenuInBundle:             http.StatusConflict,
+	constant.ErrInvalidPromotion:         http.StatusBadRequest,
+	constant.ErrInvalidPriceSchedule:     http.StatusBadRequest,
+	constant.ErrInvalidWebhook:           http.StatusBadRequest,
+	constant.ErrWebhookAddress:           http.StatusBadRequest,
+	constant.ErrInvalidSearchQuery:       http.StatusBadRequest,
+	constant.ErrInvalidWartegHours:       http.StatusBadRequest,
+	constant.ErrInvalidMenuWindow:        http.StatusBadRequest,
+	constant.ErrInvalidMenuStatus:        http.StatusBadRequest,
+	constant.ErrPriceChangePending:       http.StatusConflict,
+	constant.ErrPriceChangeReviewer:      http.StatusConflict,
+	constant.ErrActorRequired:            http.StatusUnauthorized,
 }
 
 // CommonError is
@@ -19,6 +45,58 @@ func CommonError(err error) (int, error) {
 		return commonErrorMap[constant.ErrNotFound], constant.ErrNotFound
 	case constant.ErrConflict:
 		return commonErrorMap[constant.ErrConflict], constant.ErrConflict
+	case constant.ErrInvalidSyncToken:
+		return commonErrorMap[constant.ErrInvalidSyncToken], constant.ErrInvalidSyncToken
+	case constant.ErrInvalidImportFile:
+		return commonErrorMap[constant.ErrInvalidImportFile], constant.ErrInvalidImportFile
+	case constant.ErrImportRowInvalid:
+		return commonErrorMap[constant.ErrImportRowInvalid], constant.ErrImportRowInvalid
+	case constant.ErrOutOfStock:
+		return commonErrorMap[constant.ErrOutOfStock], constant.ErrOutOfStock
+	case constant.ErrImageMandatory:
+		return commonErrorMap[constant.ErrImageMandatory], constant.ErrImageMandatory
+	case constant.ErrImageTooLarge:
+		return commonErrorMap[constant.ErrImageTooLarge], constant.ErrImageTooLarge
+	case constant.ErrImageTooManyPixels:
+		return commonErrorMap[constant.ErrImageTooManyPixels], constant.ErrImageTooManyPixels
+	case constant.ErrInvalidImageType:
+		return commonErrorMap[constant.ErrInvalidImageType], constant.ErrInvalidImageType
+	case constant.ErrInvalidImageOrder:
+		return commonErrorMap[constant.ErrInvalidImageOrder], constant.ErrInvalidImageOrder
+	case constant.ErrInvalidVariantPrice:
+		return commonErrorMap[constant.ErrInvalidVariantPrice], constant.ErrInvalidVariantPrice
+	case constant.ErrInvalidModifierGroup:
+		return commonErrorMap[constant.ErrInvalidModifierGroup], constant.ErrInvalidModifierGroup
+	case constant.ErrInvalidModifierLink:
+		return commonErrorMap[constant.ErrInvalidModifierLink], constant.ErrInvalidModifierLink
+	case constant.ErrInvalidModifierSelection:
+		return commonErrorMap[constant.ErrInvalidModifierSelection], constant.ErrInvalidModifierSelection
+	case constant.ErrInvalidBundleItem:
+		return commonErrorMap[constant.ErrInvalidBundleItem], constant.ErrInvalidBundleItem
+	case constant.ErrMenuInBundle:
+		return commonErrorMap[constant.ErrMenuInBundle], constant.ErrMenuInBundle
+	case constant.ErrInvalidPromotion:
+		return commonErrorMap[constant.ErrInvalidPromotion], constant.ErrInvalidPromotion
+	case constant.ErrInvalidPriceSchedule:
+		return commonErrorMap[constant.ErrInvalidPriceSchedule], constant.ErrInvalidPriceSchedule
+	case constant.ErrInvalidWebhook:
+		return commonErrorMap[constant.ErrInvalidWebhook], constant.ErrInvalidWebhook
+	case constant.ErrWebhookAddress:
+		return commonErrorMap[constant.ErrWebhookAddress], constant.ErrWebhookAddress
+	case constant.ErrInvalidSearchQuery:
+		return commonErrorMap[constant.ErrInvalidSearchQuery], constant.ErrInvalidSearchQuery
+	case constant.ErrInvalidWartegHours:
+		return commonErrorMap[constant.ErrInvalidWartegHours], constant.ErrInvalidWartegHours
+	case constant.ErrInvalidMenuWindow:
+		return commonErrorMap[constant.ErrInvalidMenuWindow], constant.ErrInvalidMenuWindow
+	case constant.ErrInvalidMenuStatus:
+		return commonErrorMap[constant.ErrInvalidMenuStatus], constant.ErrInvalidMenuStatus
+	case constant.ErrPriceChangePending:
+		return commonErrorMap[constant.ErrPriceChangePending], constant.ErrPriceChangePending
+	case constant.ErrPriceChangeReviewer:
+		return commonErrorMap[constant.ErrPriceChangeReviewer], constant.ErrPriceChangeReviewer
+	case constant.ErrActorRequired:
+		return commonErrorMap[constant.ErrActorRequired], constant.ErrActorRequired
 	}
 	return http.StatusInternalServerError, fmt.Errorf(err.Error())
 }
@@ -0,0 +1,157 @@
+package openinghours
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// DateLayout is layout of closure dates, matches mysql DATE column
+const DateLayout = "2006-01-02"
+
+// searchDays is how far ahead the next opening is looked for, long enough to pass any closure period
+const searchDays = 400
+
+// Interval is a weekly opening in minutes after local midnight, a close at or before open ends on the next day
+type Interval struct {
+	Weekday time.Weekday
+	Open    int
+	Close   int
+}
+
+// Schedule tells when a place is open from its weekly intervals in its own timezone, an interval starting on a
+// closure date does not open
+type Schedule struct {
+	location  *time.Location
+	intervals []Interval
+	closures  map[string]bool
+}
+
+type span struct {
+	start time.Time
+	end   time.Time
+}
+
+// New creates schedule from IANA timezone name, weekly intervals and closure dates in YYYY-MM-DD format
+func New(timezone string, intervals []Interval, closures []string) (*Schedule, error) {
+	loc, err := time.LoadLocation(timezone)
+	if err != nil || timezone == "" || timezone == "Local" {
+		return nil, fmt.Errorf("invalid timezone %q, use IANA name like Asia/Jakarta", timezone)
+	}
+
+	for _, i := range intervals {
+		if i.Weekday < time.Sunday || i.Weekday > time.Saturday || i.Open < 0 || i.Open >= 24*60 || i.Close < 0 || i.Close > 24*60 {
+			return nil, fmt.Errorf("invalid interval %v", i)
+		}
+	}
+
+	s := &Schedule{location: loc, intervals: intervals, closures: map[string]bool{}}
+	for _, c := range closures {
+		d, err := time.Parse(DateLayout, c)
+		if err != nil {
+			return nil, fmt.Errorf("invalid closure date %q, use YYYY-MM-DD", c)
+		}
+		s.closures[d.Format(DateLayout)] = true
+	}
+
+	return s, nil
+}
+
+// ParseClock reads HH:MM into minutes after midnight, 24:00 is accepted as the end of a day
+func ParseClock(clock string) (int, error) {
+	if clock == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, use HH:MM", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// Location returns timezone of the schedule
+func (s *Schedule) Location() *time.Location {
+	return s.location
+}
+
+// OpenAt tells whether t falls in an opening, openings past midnight started the day before are included
+func (s *Schedule) OpenAt(t time.Time) bool {
+	local := t.In(s.location)
+	for _, day := range []int{-1, 0} {
+		for _, sp := range s.spans(local.AddDate(0, 0, day)) {
+			if !local.Before(sp.start) && local.Before(sp.end) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NextOpening returns start of the first opening after t in the timezone of the schedule, ok is false when nothing
+// opens within a year
+func (s *Schedule) NextOpening(t time.Time) (next time.Time, ok bool) {
+	local := t.In(s.location)
+	for day := 0; day <= searchDays; day++ {
+		for _, sp := range s.spans(local.AddDate(0, 0, day)) {
+			if sp.start.After(local) {
+				return sp.start, true
+			}
+		}
+	}
+	return next, false
+}
+
+// LastChange returns the latest start or end of an opening at or before t in the timezone of the schedule, ok is
+// false when nothing opened or closed within a year
+func (s *Schedule) LastChange(t time.Time) (last time.Time, ok bool) {
+	if len(s.intervals) == 0 {
+		return last, false
+	}
+
+	local := t.In(s.location)
+	found := 0
+	for day := 0; day >= -searchDays; day-- {
+		// an opening past midnight of the day before may still end after the ones found
+		if ok && day < found-1 {
+			break
+		}
+		for _, sp := range s.spans(local.AddDate(0, 0, day)) {
+			for _, c := range []time.Time{sp.start, sp.end} {
+				if c.After(local) || (ok && !c.After(last)) {
+					continue
+				}
+				if !ok {
+					found = day
+				}
+				last, ok = c, true
+			}
+		}
+	}
+	return last, ok
+}
+
+// spans returns openings starting on the local date of day ordered by start
+func (s *Schedule) spans(day time.Time) []span {
+	y, m, d := day.Date()
+	if s.closures[time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Format(DateLayout)] {
+		return nil
+	}
+
+	list := []span{}
+	for _, i := range s.intervals {
+		if i.Weekday != day.Weekday() {
+			continue
+		}
+		closeDay := d
+		if i.Close <= i.Open {
+			closeDay++
+		}
+		list = append(list, span{
+			start: time.Date(y, m, d, 0, i.Open, 0, 0, s.location),
+			end:   time.Date(y, m, closeDay, 0, i.Close, 0, 0, s.location),
+		})
+	}
+	sort.Slice(list, func(a, b int) bool { return list[a].start.Before(list[b].start) })
+
+	return list
+}
@@ -0,0 +1,143 @@
+package picture
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/HugoSmits86/nativewebp"
+	"github.com/disintegration/imaging"
+	_ "golang.org/x/image/webp" // webp decoder
+)
+
+const (
+	// VariantOriginal is uploaded image as is
+	VariantOriginal = "original"
+	// VariantThumbnail is image resized to thumbnail width
+	VariantThumbnail = "thumbnail"
+	// VariantWebp is original image encoded as webp
+	VariantWebp = "webp"
+	// VariantThumbnailWebp is thumbnail encoded as webp
+	VariantThumbnailWebp = "thumbnail_webp"
+)
+
+// ErrUnsupportedType is returned when uploaded file is not jpeg, png, gif or webp image
+var ErrUnsupportedType = fmt.Errorf("unsupported image type, use jpeg, png, gif or webp")
+
+// ErrTooManyPixels is returned when image declares more pixels than allowed, a small file can declare a huge image
+var ErrTooManyPixels = fmt.Errorf("image has too many pixels")
+
+var extensions = map[string]string{
+	"image/jpeg": "jpg",
+	"image/png":  "png",
+	"image/gif":  "gif",
+	"image/webp": "webp",
+}
+
+// Variant is one stored rendition of an uploaded image
+type Variant struct {
+	Name        string
+	Ext         string
+	ContentType string
+	Data        []byte
+	Width       int
+	Height      int
+}
+
+// DetectType returns content type of image data, only supported image types are accepted
+func DetectType(data []byte) (string, error) {
+	contentType := http.DetectContentType(data)
+	if _, ok := extensions[contentType]; !ok {
+		return "", ErrUnsupportedType
+	}
+	return contentType, nil
+}
+
+// Process decodes image and returns original, thumbnail and their webp variants. The header is read first so an
+// image above maxPixels is rejected before it is decoded into memory
+func Process(data []byte, thumbnailWidth int, maxPixels int64) ([]Variant, error) {
+	contentType, err := DetectType(data)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > maxPixels {
+		return nil, ErrTooManyPixels
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrUnsupportedType
+	}
+
+	bounds := img.Bounds()
+	original := Variant{
+		Name:        VariantOriginal,
+		Ext:         extensions[contentType],
+		ContentType: contentType,
+		Data:        data,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}
+
+	thumb := img
+	if bounds.Dx() > thumbnailWidth {
+		thumb = imaging.Resize(img, thumbnailWidth, 0, imaging.Lanczos)
+	}
+
+	thumbnail, err := encode(VariantThumbnail, thumb, contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	webp, err := encode(VariantWebp, img, "image/webp")
+	if err != nil {
+		return nil, err
+	}
+
+	thumbnailWebp, err := encode(VariantThumbnailWebp, thumb, "image/webp")
+	if err != nil {
+		return nil, err
+	}
+
+	return []Variant{original, thumbnail, webp, thumbnailWebp}, nil
+}
+
+func encode(name string, img image.Image, contentType string) (Variant, error) {
+	var buf bytes.Buffer
+	var err error
+
+	switch contentType {
+	case "image/jpeg":
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+	case "image/gif":
+		err = gif.Encode(&buf, img, nil)
+	case "image/webp":
+		err = nativewebp.Encode(&buf, img, nil)
+	default:
+		contentType = "image/png"
+		err = png.Encode(&buf, img)
+	}
+
+	if err != nil {
+		return Variant{}, err
+	}
+
+	bounds := img.Bounds()
+	return Variant{
+		Name:        name,
+		Ext:         extensions[contentType],
+		ContentType: contentType,
+		Data:        buf.Bytes(),
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package pubsub
+
+import "sync"
+
+// Hub notifies subscribers of a topic within the process. A notification only tells that something changed, the
+// subscriber reads what changed from storage, so notifications to a busy subscriber are merged instead of blocking
+// the publisher
+type Hub struct {
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]bool
+}
+
+// NewHub creates hub without subscribers
+func NewHub() *Hub {
+	return &Hub{subs: map[string]map[chan struct{}]bool{}}
+}
+
+// Subscribe returns channel receiving a notification after every publish to topic, unsubscribe must be called when
+// the subscriber leaves
+func (h *Hub) Subscribe(topic string) (notify <-chan struct{}, unsubscribe func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	if h.subs[topic] == nil {
+		h.subs[topic] = map[chan struct{}]bool{}
+	}
+	h.subs[topic][ch] = true
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subs[topic], ch)
+		if len(h.subs[topic]) == 0 {
+			delete(h.subs, topic)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// Publish notifies subscribers of topic, an empty topic notifies every subscriber
+func (h *Hub) Publish(topic string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for t, subs := range h.subs {
+		if topic != "" && t != topic {
+			continue
+		}
+		for ch := range subs {
+			select {
+			case ch <- struct{}{}:
+			default:
+				// a notification is already waiting
+			}
+		}
+	}
+}
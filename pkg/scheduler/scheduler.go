@@ -0,0 +1,38 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	log "go.uber.org/zap"
+)
+
+// Job is work run by the scheduler, error is logged and the schedule continues
+type Job func(ctx context.Context) error
+
+// At runs job immediately and then at every time returned by next until ctx is done
+func At(ctx context.Context, name string, next func(time.Time) time.Time, job Job) {
+	run(ctx, name, job)
+
+	for {
+		timer := time.NewTimer(time.Until(next(time.Now())))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+			run(ctx, name, job)
+		}
+	}
+}
+
+// Every runs job immediately and then every interval until ctx is done
+func Every(ctx context.Context, name string, interval time.Duration, job Job) {
+	At(ctx, name, func(now time.Time) time.Time { return now.Add(interval) }, job)
+}
+
+func run(ctx context.Context, name string, job Job) {
+	if err := job(ctx); err != nil {
+		log.S().Errorf("scheduled job %s error : %s ", name, err.Error())
+	}
+}
@@ -0,0 +1,190 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// token is a normalized word of a text, start and end are byte offsets of the original word so matches can be
+// highlighted, tokens produced by a synonym share the span of the words they replace
+type token struct {
+	term  string
+	start int
+	end   int
+}
+
+// Analyzer turns text into normalized terms, the same analyzer is used for documents and queries
+type Analyzer struct {
+	// synonyms maps a normalized phrase to the terms of the canonical phrase of its group
+	synonyms  map[string][]string
+	maxPhrase int
+}
+
+// NewAnalyzer creates analyzer with synonym groups, every group is a list of equivalent phrases and the first phrase is
+// the one they are indexed as, for example {"nasi goreng", "nasgor"} or {"es teh", "ice tea"}
+func NewAnalyzer(synonyms [][]string) *Analyzer {
+	a := &Analyzer{synonyms: map[string][]string{}, maxPhrase: 1}
+
+	for _, group := range synonyms {
+		if len(group) == 0 {
+			continue
+		}
+
+		canonical := terms(tokenize(group[0]))
+		for _, phrase := range group {
+			words := terms(tokenize(phrase))
+			if len(words) == 0 {
+				continue
+			}
+			a.synonyms[strings.Join(words, " ")] = canonical
+			if len(words) > a.maxPhrase {
+				a.maxPhrase = len(words)
+			}
+		}
+	}
+
+	return a
+}
+
+// analyze tokenizes text and replaces synonym phrases by their canonical terms, the longest phrase wins
+func (a *Analyzer) analyze(text string) []token {
+	words := tokenize(text)
+	if len(a.synonyms) == 0 {
+		return words
+	}
+
+	tokens := make([]token, 0, len(words))
+	for i := 0; i < len(words); {
+		n := a.maxPhrase
+		if len(words)-i < n {
+			n = len(words) - i
+		}
+
+		replaced := false
+		for ; n > 0; n-- {
+			canonical, ok := a.synonyms[strings.Join(terms(words[i:i+n]), " ")]
+			if !ok {
+				continue
+			}
+			for _, term := range canonical {
+				tokens = append(tokens, token{term: term, start: words[i].start, end: words[i+n-1].end})
+			}
+			i += n
+			replaced = true
+			break
+		}
+
+		if !replaced {
+			tokens = append(tokens, words[i])
+			i++
+		}
+	}
+
+	return tokens
+}
+
+// tokenize splits text on anything but letters and digits and lowercases the words, "Ayam-Goreng" becomes ayam and
+// goreng, reduplication written with 2 such as "sayur2" becomes sayur
+func tokenize(text string) []token {
+	tokens := []token{}
+	start := -1
+
+	flush := func(end int) {
+		if start < 0 {
+			return
+		}
+		term := strings.ToLower(text[start:end])
+		if len(term) > 2 && strings.HasSuffix(term, "2") && isLetters(term[:len(term)-1]) {
+			term = term[:len(term)-1]
+		}
+		tokens = append(tokens, token{term: term, start: start, end: end})
+		start = -1
+	}
+
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		flush(i)
+	}
+	flush(len(text))
+
+	return tokens
+}
+
+func terms(tokens []token) []string {
+	list := make([]string, len(tokens))
+	for i, t := range tokens {
+		list[i] = t.term
+	}
+	return list
+}
+
+func isLetters(s string) bool {
+	for _, r := range s {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+var (
+	particles   = []string{"lah", "kah", "tah", "pun"}
+	possessives = []string{"nya", "ku", "mu"}
+	suffixes    = []string{"kan", "an"}
+	prefixes    = []string{"meng", "meny", "peng", "peny", "mem", "men", "pem", "pen", "ber", "ter", "per", "me", "pe", "di", "ke", "se"}
+)
+
+// minStem is shortest stem kept, food names are mostly short nouns and dictionary free stemming must not eat them
+const minStem = 4
+
+// stem removes Indonesian inflectional and derivational affixes in the order of Nazief and Adriani without a root
+// dictionary, so an affix is only removed when a stem of at least minStem letters is left. Gorengan becomes goreng,
+// sayurannya becomes sayur and dibakar becomes bakar.
+func stem(term string) string {
+	if !isLetters(term) {
+		return term
+	}
+
+	term = trimSuffix(term, particles)
+	term = trimSuffix(term, possessives)
+	term = trimSuffix(term, suffixes)
+
+	// only the longest matching prefix is tried, a shorter one would cut the stem in the wrong place
+	for _, p := range prefixes {
+		if !strings.HasPrefix(term, p) {
+			continue
+		}
+		if len(term)-len(p) >= minStem {
+			rest := term[len(p):]
+			// nasal prefixes replace the first letter of the stem, peny+et comes from s, pem+anggang from p
+			switch {
+			case (p == "meny" || p == "peny") && isVowel(rest[0]):
+				rest = "s" + rest
+			case (p == "mem" || p == "pem") && isVowel(rest[0]):
+				rest = "p" + rest
+			}
+			return rest
+		}
+		break
+	}
+
+	return term
+}
+
+func trimSuffix(term string, suffixes []string) string {
+	for _, s := range suffixes {
+		if strings.HasSuffix(term, s) && len(term)-len(s) >= minStem {
+			return term[:len(term)-len(s)]
+		}
+	}
+	return term
+}
+
+func isVowel(c byte) bool {
+	return strings.IndexByte("aiueo", c) >= 0
+}
@@ -0,0 +1,130 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	cases := []struct {
+		name     string
+		text     string
+		expected []token
+	}{
+		{
+			name: "#1 split on punctuation and lowercase",
+			text: "Ayam-Goreng, PEDAS",
+			expected: []token{
+				{term: "ayam", start: 0, end: 4},
+				{term: "goreng", start: 5, end: 11},
+				{term: "pedas", start: 13, end: 18},
+			},
+		},
+		{
+			name: "#2 reduplication with 2",
+			text: "sayur2 kue2",
+			expected: []token{
+				{term: "sayur", start: 0, end: 6},
+				{term: "kue", start: 7, end: 11},
+			},
+		},
+		{
+			name: "#3 number is kept",
+			text: "es teh 2",
+			expected: []token{
+				{term: "es", start: 0, end: 2},
+				{term: "teh", start: 3, end: 6},
+				{term: "2", start: 7, end: 8},
+			},
+		},
+		{
+			name:     "#4 no words",
+			text:     " - !! ",
+			expected: []token{},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, tokenize(testCase.text))
+		})
+	}
+}
+
+func TestStem(t *testing.T) {
+	cases := []struct {
+		name     string
+		term     string
+		expected string
+	}{
+		{"#1 suffix an", "gorengan", "goreng"},
+		{"#2 possessive and suffix", "sayurannya", "sayur"},
+		{"#3 prefix di", "dibakar", "bakar"},
+		{"#4 nasal prefix meny from s", "menyambal", "sambal"},
+		{"#5 particle", "bakarlah", "bakar"},
+		{"#6 short stem is kept", "penyet", "penyet"},
+		{"#7 plain noun", "ayam", "ayam"},
+		{"#8 digits are not stemmed", "2000an", "2000an"},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, stem(testCase.term))
+		})
+	}
+}
+
+func TestAnalyzerSynonyms(t *testing.T) {
+	analyzer := NewAnalyzer([][]string{
+		{"nasi goreng", "nasgor"},
+		{"es teh", "ice tea"},
+	})
+
+	cases := []struct {
+		name     string
+		text     string
+		expected []token
+	}{
+		{
+			name: "#1 synonym replaced by canonical terms sharing its span",
+			text: "Nasgor pedas",
+			expected: []token{
+				{term: "nasi", start: 0, end: 6},
+				{term: "goreng", start: 0, end: 6},
+				{term: "pedas", start: 7, end: 12},
+			},
+		},
+		{
+			name: "#2 phrase synonym",
+			text: "ice tea manis",
+			expected: []token{
+				{term: "es", start: 0, end: 7},
+				{term: "teh", start: 0, end: 7},
+				{term: "manis", start: 8, end: 13},
+			},
+		},
+		{
+			name: "#3 canonical phrase stays",
+			text: "nasi goreng",
+			expected: []token{
+				{term: "nasi", start: 0, end: 11},
+				{term: "goreng", start: 0, end: 11},
+			},
+		},
+		{
+			name: "#4 no synonym",
+			text: "soto ayam",
+			expected: []token{
+				{term: "soto", start: 0, end: 4},
+				{term: "ayam", start: 5, end: 9},
+			},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, analyzer.analyze(testCase.text))
+		})
+	}
+}
@@ -0,0 +1,351 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Field is searchable text of a document, weight scales the score of matches in the field
+type Field struct {
+	Name   string
+	Text   string
+	Weight float64
+}
+
+// Document is an indexed item, the first field is the primary one used for phrase and length ranking, tags are exact
+// values a query can filter on
+type Document struct {
+	Id     string
+	Fields []Field
+	Tags   map[string]string
+}
+
+// Query searches text in documents carrying every tag
+type Query struct {
+	Text string
+	Tags map[string]string
+}
+
+// Hit is a matching document, highlights hold html escaped text of the matching fields with matched words wrapped
+// in <em> tags
+type Hit struct {
+	Id         string
+	Score      float64
+	Highlights map[string]string
+}
+
+// Index is an in memory inverted index safe for concurrent use, it also keeps the position of the source it is in
+// sync with so it can be updated incrementally
+type Index struct {
+	mu       sync.RWMutex
+	analyzer *Analyzer
+	docs     map[string]*document
+	terms    map[string]*term
+	position int64
+	ready    bool
+}
+
+type document struct {
+	Document
+	tokens [][]token
+}
+
+type term struct {
+	stem string
+	docs map[string]int
+}
+
+// NewIndex creates empty index analyzing text with analyzer
+func NewIndex(analyzer *Analyzer) *Index {
+	return &Index{
+		analyzer: analyzer,
+		docs:     map[string]*document{},
+		terms:    map[string]*term{},
+	}
+}
+
+// Reset replaces every document and marks the index ready at position of its source
+func (ix *Index) Reset(docs []Document, position int64) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.docs = map[string]*document{}
+	ix.terms = map[string]*term{}
+	for _, d := range docs {
+		ix.put(d)
+	}
+	ix.position = position
+	ix.ready = true
+}
+
+// Position returns position of the source the index is in sync with, ready is false until the first Reset
+func (ix *Index) Position() (position int64, ready bool) {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return ix.position, ix.ready
+}
+
+// SetPosition records that changes of the source up to position are applied
+func (ix *Index) SetPosition(position int64) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.position = position
+}
+
+// Put adds document or replaces document with the same id
+func (ix *Index) Put(d Document) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(d.Id)
+	ix.put(d)
+}
+
+// Delete removes document, unknown id is ignored
+func (ix *Index) Delete(id string) {
+	ix.mu.Lock()
+	defer ix.mu.Unlock()
+
+	ix.remove(id)
+}
+
+// Len returns number of documents
+func (ix *Index) Len() int {
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	return len(ix.docs)
+}
+
+func (ix *Index) put(d Document) {
+	doc := &document{Document: d, tokens: make([][]token, len(d.Fields))}
+	for i, f := range d.Fields {
+		doc.tokens[i] = ix.analyzer.analyze(f.Text)
+		for _, tk := range doc.tokens[i] {
+			t, ok := ix.terms[tk.term]
+			if !ok {
+				t = &term{stem: stem(tk.term), docs: map[string]int{}}
+				ix.terms[tk.term] = t
+			}
+			t.docs[d.Id]++
+		}
+	}
+	ix.docs[d.Id] = doc
+}
+
+func (ix *Index) remove(id string) {
+	doc, ok := ix.docs[id]
+	if !ok {
+		return
+	}
+
+	for _, tokens := range doc.tokens {
+		for _, tk := range tokens {
+			t := ix.terms[tk.term]
+			t.docs[id]--
+			if t.docs[id] == 0 {
+				delete(t.docs, id)
+			}
+			if len(t.docs) == 0 {
+				delete(ix.terms, tk.term)
+			}
+		}
+	}
+	delete(ix.docs, id)
+}
+
+// Search returns documents matching every word of the query ordered by relevance, nil when the query has no words
+func (ix *Index) Search(q Query) []Hit {
+	words := uniqueTerms(ix.analyzer.analyze(q.Text))
+	if len(words) == 0 {
+		return nil
+	}
+
+	ix.mu.RLock()
+	defer ix.mu.RUnlock()
+
+	// indexed terms matching every query word with their weight
+	matches := make([]map[string]float64, len(words))
+	for i, w := range words {
+		matches[i] = map[string]float64{}
+		wStem := stem(w)
+		for name, t := range ix.terms {
+			if weight := termMatch(w, wStem, name, t.stem); weight > 0 {
+				matches[i][name] = weight
+			}
+		}
+		if len(matches[i]) == 0 {
+			return []Hit{}
+		}
+	}
+
+	hits := []Hit{}
+	for id := range ix.candidates(matches) {
+		doc := ix.docs[id]
+		if !hasTags(doc.Tags, q.Tags) {
+			continue
+		}
+		if hit, ok := score(doc, matches); ok {
+			hits = append(hits, hit)
+		}
+	}
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		li, lj := primaryLength(ix.docs[hits[i].Id]), primaryLength(ix.docs[hits[j].Id])
+		if li != lj {
+			return li < lj
+		}
+		return hits[i].Id < hits[j].Id
+	})
+
+	return hits
+}
+
+// candidates returns ids of documents containing a match of every query word
+func (ix *Index) candidates(matches []map[string]float64) map[string]bool {
+	var ids map[string]bool
+	for _, m := range matches {
+		found := map[string]bool{}
+		for name := range m {
+			for id := range ix.terms[name].docs {
+				if ids == nil || ids[id] {
+					found[id] = true
+				}
+			}
+		}
+		ids = found
+	}
+	return ids
+}
+
+// score adds up best weighted match of every query word, matches in the primary field get a bonus when the query
+// words are exactly the whole field and for every pair of query words found next to each other in the same order
+func score(doc *document, matches []map[string]float64) (Hit, bool) {
+	hit := Hit{Id: doc.Id, Highlights: map[string]string{}}
+	spans := make([][][2]int, len(doc.Fields))
+
+	for _, m := range matches {
+		best := 0.0
+		for f, tokens := range doc.tokens {
+			for _, tk := range tokens {
+				weight, ok := m[tk.term]
+				if !ok {
+					continue
+				}
+				spans[f] = append(spans[f], [2]int{tk.start, tk.end})
+				if weight*doc.Fields[f].Weight > best {
+					best = weight * doc.Fields[f].Weight
+				}
+			}
+		}
+		if best == 0 {
+			return hit, false
+		}
+		hit.Score += best
+	}
+
+	if len(doc.tokens) > 0 {
+		primary := doc.tokens[0]
+		if len(primary) == len(matches) && coversAll(primary, matches) {
+			hit.Score += 0.5
+		}
+		for i := 0; i+1 < len(matches); i++ {
+			for p := 0; p+1 < len(primary); p++ {
+				_, first := matches[i][primary[p].term]
+				_, second := matches[i+1][primary[p+1].term]
+				if first && second {
+					hit.Score += 0.1
+					break
+				}
+			}
+		}
+	}
+
+	for f, s := range spans {
+		if len(s) > 0 {
+			hit.Highlights[doc.Fields[f].Name] = highlight(doc.Fields[f].Text, s)
+		}
+	}
+
+	return hit, true
+}
+
+// coversAll tells whether every token of the field is exactly one of the query words
+func coversAll(tokens []token, matches []map[string]float64) bool {
+	for _, tk := range tokens {
+		found := false
+		for _, m := range matches {
+			if m[tk.term] == weightExact {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// highlight wraps spans of text in <em> tags, overlapping and touching spans are merged
+func highlight(text string, spans [][2]int) string {
+	sort.Slice(spans, func(i, j int) bool { return spans[i][0] < spans[j][0] })
+
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(spans); i++ {
+		start, end := spans[i][0], spans[i][1]
+		for i+1 < len(spans) && spans[i+1][0] <= end {
+			if spans[i+1][1] > end {
+				end = spans[i+1][1]
+			}
+			i++
+		}
+		if start < last {
+			start = last
+		}
+		b.WriteString(html.EscapeString(text[last:start]))
+		b.WriteString("<em>")
+		b.WriteString(html.EscapeString(text[start:end]))
+		b.WriteString("</em>")
+		last = end
+	}
+	b.WriteString(html.EscapeString(text[last:]))
+
+	return b.String()
+}
+
+func hasTags(tags, want map[string]string) bool {
+	for k, v := range want {
+		if v != "" && tags[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func primaryLength(doc *document) int {
+	if len(doc.Fields) == 0 {
+		return 0
+	}
+	return len(doc.Fields[0].Text)
+}
+
+func uniqueTerms(tokens []token) []string {
+	seen := map[string]bool{}
+	list := []string{}
+	for _, tk := range tokens {
+		if !seen[tk.term] {
+			seen[tk.term] = true
+			list = append(list, tk.term)
+		}
+	}
+	return list
+}
@@ -0,0 +1,103 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func menuDoc(id, name, wartegId string) Document {
+	return Document{
+		Id:     id,
+		Fields: []Field{{Name: "menu_name", Text: name, Weight: 1}},
+		Tags:   map[string]string{"warteg_id": wartegId},
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	ix := NewIndex(NewAnalyzer([][]string{{"nasi goreng", "nasgor"}}))
+	ix.Reset([]Document{
+		menuDoc("1", "Nasi Goreng", "abc"),
+		menuDoc("2", "Nasi Goreng Ayam Spesial", "abc"),
+		menuDoc("3", "Ayam Goreng", "abc"),
+		menuDoc("4", "Ayam Bakar", "xyz"),
+		menuDoc("5", "Soto Ayam", "abc"),
+	}, 10)
+
+	cases := []struct {
+		name     string
+		query    Query
+		expected []string
+	}{
+		{
+			name:     "#1 whole name ranks first",
+			query:    Query{Text: "nasi goreng"},
+			expected: []string{"1", "2"},
+		},
+		{
+			name:     "#2 every word must match",
+			query:    Query{Text: "goreng ayam"},
+			expected: []string{"3", "2"},
+		},
+		{
+			name:     "#3 synonym",
+			query:    Query{Text: "nasgor"},
+			expected: []string{"1", "2"},
+		},
+		{
+			name:     "#4 typo",
+			query:    Query{Text: "bakr"},
+			expected: []string{"4"},
+		},
+		{
+			name:     "#5 tag filter, shorter name first on equal score",
+			query:    Query{Text: "ayam", Tags: map[string]string{"warteg_id": "abc"}},
+			expected: []string{"5", "3", "2"},
+		},
+		{
+			name:     "#6 no match",
+			query:    Query{Text: "rendang"},
+			expected: []string{},
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ids := []string{}
+			for _, h := range ix.Search(testCase.query) {
+				ids = append(ids, h.Id)
+			}
+			assert.Equal(t, testCase.expected, ids)
+		})
+	}
+
+	assert.Nil(t, ix.Search(Query{Text: "  !! "}))
+}
+
+func TestIndexHighlight(t *testing.T) {
+	ix := NewIndex(NewAnalyzer(nil))
+	ix.Reset([]Document{menuDoc("1", "Ayam <Goreng> Kremes", "abc")}, 1)
+
+	hits := ix.Search(Query{Text: "goreng kremes"})
+
+	assert.Len(t, hits, 1)
+	assert.Equal(t, "Ayam &lt;<em>Goreng</em>&gt; <em>Kremes</em>", hits[0].Highlights["menu_name"])
+}
+
+func TestIndexPutDelete(t *testing.T) {
+	ix := NewIndex(NewAnalyzer(nil))
+	ix.Reset([]Document{menuDoc("1", "Soto Ayam", "abc")}, 1)
+
+	ix.Put(menuDoc("1", "Soto Betawi", "abc"))
+	ix.Put(menuDoc("2", "Soto Ayam", "abc"))
+	assert.Equal(t, 2, ix.Len())
+	assert.Equal(t, "2", ix.Search(Query{Text: "ayam"})[0].Id)
+
+	ix.Delete("2")
+	assert.Empty(t, ix.Search(Query{Text: "ayam"}))
+
+	ix.SetPosition(5)
+	position, ready := ix.Position()
+	assert.Equal(t, int64(5), position)
+	assert.True(t, ready)
+}
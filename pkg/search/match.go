@@ -0,0 +1,122 @@
+package search
+
+import "strings"
+
+// weights of the ways a query word can match an indexed term, every query word takes its best match
+const (
+	weightExact        = 1.0
+	weightStem         = 0.9
+	weightPrefix       = 0.8
+	weightAbbreviation = 0.7
+	weightTypo         = 0.6
+	weightInfix        = 0.5
+	weightTypo2        = 0.4
+)
+
+// termMatch scores how well query word q matches indexed term t, zero means no match
+func termMatch(q, qStem, t, tStem string) float64 {
+	switch {
+	case q == t:
+		return weightExact
+	case qStem == tStem:
+		return weightStem
+	case len(q) >= 2 && strings.HasPrefix(t, q):
+		return weightPrefix
+	case isAbbreviation(q, t):
+		return weightAbbreviation
+	}
+
+	// typing mistakes, one edit is allowed from four letters and two from eight
+	if len(q) >= 4 {
+		limit := 1
+		if len(q) >= 8 {
+			limit = 2
+		}
+		if d := distance(q, t, limit); d == 1 {
+			return weightTypo
+		} else if d == 2 && limit == 2 {
+			return weightTypo2
+		}
+	}
+
+	if len(q) >= 3 && strings.Contains(t, q) {
+		return weightInfix
+	}
+
+	return 0
+}
+
+// isAbbreviation tells whether q is written without vowels the way menu names are shortened in chats, such as grg for
+// goreng or aym for ayam, the letters of q must appear in t in order starting from the first one
+func isAbbreviation(q, t string) bool {
+	if len(q) < 3 || len(q) >= len(t) || q[0] != t[0] || !isLetters(q) {
+		return false
+	}
+
+	for i := 1; i < len(q); i++ {
+		if isVowel(q[i]) {
+			return false
+		}
+	}
+
+	j := 0
+	for i := 0; i < len(t) && j < len(q); i++ {
+		if t[i] == q[j] {
+			j++
+		}
+	}
+
+	return j == len(q)
+}
+
+// distance returns Damerau-Levenshtein distance of a and b with adjacent transpositions, or limit+1 once the distance
+// is known to exceed limit
+func distance(a, b string, limit int) int {
+	if d := len(a) - len(b); d > limit || -d > limit {
+		return limit + 1
+	}
+
+	prev2 := make([]int, len(b)+1)
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		best := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min3(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] && prev2[j-2]+1 < curr[j] {
+				curr[j] = prev2[j-2] + 1
+			}
+			if curr[j] < best {
+				best = curr[j]
+			}
+		}
+		if best > limit {
+			return limit + 1
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	if prev[len(b)] > limit {
+		return limit + 1
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
@@ -0,0 +1,59 @@
+package search
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTermMatch(t *testing.T) {
+	cases := []struct {
+		name     string
+		query    string
+		term     string
+		expected float64
+	}{
+		{"#1 exact", "ayam", "ayam", weightExact},
+		{"#2 same stem", "gorengan", "goreng", weightStem},
+		{"#3 prefix", "gor", "goreng", weightPrefix},
+		{"#4 abbreviation", "grg", "goreng", weightAbbreviation},
+		{"#5 one typo", "gorebg", "goreng", weightTypo},
+		{"#6 transposed letters", "ayma", "ayam", weightTypo},
+		{"#7 one typo below eight letters", "kerupkk", "kerupuk", weightTypo},
+		{"#8 two typos from eight letters", "perkadal", "perkedel", weightTypo2},
+		{"#9 one typo from four letters", "sato", "soto", weightTypo},
+		{"#10 infix", "ren", "goreng", weightInfix},
+		{"#11 no typo below four letters", "ayn", "ayam", 0},
+		{"#12 no match", "bakso", "goreng", 0},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := termMatch(testCase.query, stem(testCase.query), testCase.term, stem(testCase.term))
+			assert.Equal(t, testCase.expected, got)
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	cases := []struct {
+		name     string
+		a        string
+		b        string
+		limit    int
+		expected int
+	}{
+		{"#1 same", "soto", "soto", 2, 0},
+		{"#2 substitution", "soto", "sato", 2, 1},
+		{"#3 insertion", "sate", "satte", 2, 1},
+		{"#4 transposition", "ayam", "ayma", 2, 1},
+		{"#5 beyond limit", "bakso", "tempe", 2, 3},
+		{"#6 length difference beyond limit", "es", "esteh", 2, 3},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, distance(testCase.a, testCase.b, testCase.limit))
+		})
+	}
+}
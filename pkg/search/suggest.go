@@ -0,0 +1,312 @@
+package search
+
+import (
+	"html"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Suggestion is a completion of a typed text, count is the number of documents carrying the text in the queried
+// scope and highlight holds the html escaped text with the typed part wrapped in <em> tags
+type Suggestion struct {
+	Text      string
+	Field     string
+	Highlight string
+	Count     int
+}
+
+// Suggester completes typed text with whole field values of documents, such as menu names, matching from the start
+// of the value, from the start of any of its words or anywhere inside it. It is updated document by document and
+// safe for concurrent use.
+type Suggester struct {
+	mu     sync.RWMutex
+	scope  string
+	fields map[string]bool
+	// entries by field name and normalized value
+	entries map[string]*entry
+	// suffixes of every entry starting at a word, sorted so completions are found by binary search
+	suffixes []suffix
+	docs     map[string][]docEntry
+}
+
+type entry struct {
+	field  string
+	text   string
+	norm   string
+	words  []token
+	counts map[string]int
+	total  int
+}
+
+type suffix struct {
+	key   string
+	entry *entry
+}
+
+type docEntry struct {
+	key   string
+	scope string
+}
+
+// NewSuggester creates empty suggester completing values of fields, counts are kept per value of the scope tag
+func NewSuggester(scope string, fields ...string) *Suggester {
+	s := &Suggester{
+		scope:   scope,
+		fields:  map[string]bool{},
+		entries: map[string]*entry{},
+		docs:    map[string][]docEntry{},
+	}
+	for _, f := range fields {
+		s.fields[f] = true
+	}
+	return s
+}
+
+// Reset replaces every document
+func (s *Suggester) Reset(docs []Document) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = map[string]*entry{}
+	s.suffixes = nil
+	s.docs = map[string][]docEntry{}
+	for _, d := range docs {
+		s.put(d, false)
+	}
+	sort.Slice(s.suffixes, func(i, j int) bool { return s.suffixes[i].key < s.suffixes[j].key })
+}
+
+// Put adds document or replaces document with the same id
+func (s *Suggester) Put(d Document) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(d.Id)
+	s.put(d, true)
+}
+
+// Delete removes document, unknown id is ignored
+func (s *Suggester) Delete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.remove(id)
+}
+
+func (s *Suggester) put(d Document, sorted bool) {
+	refs := []docEntry{}
+	for _, f := range d.Fields {
+		if !s.fields[f.Name] {
+			continue
+		}
+
+		words := tokenize(f.Text)
+		if len(words) == 0 {
+			continue
+		}
+		norm := strings.Join(terms(words), " ")
+		key := f.Name + "\x00" + norm
+
+		e, ok := s.entries[key]
+		if !ok {
+			e = &entry{field: f.Name, norm: norm, words: words, counts: map[string]int{}}
+			s.entries[key] = e
+			for _, at := range wordStarts(words) {
+				s.addSuffix(suffix{key: norm[at:], entry: e}, sorted)
+			}
+		}
+		// the latest spelling of a value is shown
+		e.text = f.Text
+		e.words = words
+
+		scope := d.Tags[s.scope]
+		e.counts[scope]++
+		e.total++
+		refs = append(refs, docEntry{key: key, scope: scope})
+	}
+	s.docs[d.Id] = refs
+}
+
+func (s *Suggester) remove(id string) {
+	for _, ref := range s.docs[id] {
+		e := s.entries[ref.key]
+		e.counts[ref.scope]--
+		if e.counts[ref.scope] == 0 {
+			delete(e.counts, ref.scope)
+		}
+		e.total--
+		if e.total == 0 {
+			for _, at := range wordStarts(e.words) {
+				s.removeSuffix(suffix{key: e.norm[at:], entry: e})
+			}
+			delete(s.entries, ref.key)
+		}
+	}
+	delete(s.docs, id)
+}
+
+func (s *Suggester) addSuffix(sf suffix, sorted bool) {
+	if !sorted {
+		s.suffixes = append(s.suffixes, sf)
+		return
+	}
+	i := sort.Search(len(s.suffixes), func(i int) bool { return s.suffixes[i].key >= sf.key })
+	s.suffixes = append(s.suffixes, suffix{})
+	copy(s.suffixes[i+1:], s.suffixes[i:])
+	s.suffixes[i] = sf
+}
+
+func (s *Suggester) removeSuffix(sf suffix) {
+	i := sort.Search(len(s.suffixes), func(i int) bool { return s.suffixes[i].key >= sf.key })
+	for ; i < len(s.suffixes) && s.suffixes[i].key == sf.key; i++ {
+		if s.suffixes[i].entry == sf.entry {
+			s.suffixes = append(s.suffixes[:i], s.suffixes[i+1:]...)
+			return
+		}
+	}
+}
+
+// Suggest returns at most limit completions of text carried by documents of the scope, every scope when empty.
+// Values starting with the text come first, then values with a word starting with it, then values containing it,
+// each ordered by count and length.
+func (s *Suggester) Suggest(text, scope string, limit int) []Suggestion {
+	query := strings.Join(terms(tokenize(text)), " ")
+	if query == "" || limit <= 0 {
+		return []Suggestion{}
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	type candidate struct {
+		entry *entry
+		rank  int
+		at    int
+		count int
+	}
+
+	found := map[*entry]bool{}
+	list := []candidate{}
+
+	i := sort.Search(len(s.suffixes), func(i int) bool { return s.suffixes[i].key >= query })
+	for ; i < len(s.suffixes) && strings.HasPrefix(s.suffixes[i].key, query); i++ {
+		e := s.suffixes[i].entry
+		if found[e] {
+			continue
+		}
+		count := s.count(e, scope)
+		if count == 0 {
+			continue
+		}
+		// a value may have several words starting with the query, the earliest one decides the rank
+		at := strings.Index(" "+e.norm, " "+query)
+		rank := 1
+		if at == 0 {
+			rank = 0
+		}
+		found[e] = true
+		list = append(list, candidate{entry: e, rank: rank, at: at, count: count})
+	}
+
+	if len(list) < limit {
+		for _, e := range s.entries {
+			if found[e] {
+				continue
+			}
+			at := strings.Index(e.norm, query)
+			if at < 0 {
+				continue
+			}
+			count := s.count(e, scope)
+			if count == 0 {
+				continue
+			}
+			list = append(list, candidate{entry: e, rank: 2, at: at, count: count})
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.rank != b.rank {
+			return a.rank < b.rank
+		}
+		if a.count != b.count {
+			return a.count > b.count
+		}
+		if len(a.entry.norm) != len(b.entry.norm) {
+			return len(a.entry.norm) < len(b.entry.norm)
+		}
+		if a.entry.norm != b.entry.norm {
+			return a.entry.norm < b.entry.norm
+		}
+		return a.entry.field < b.entry.field
+	})
+
+	if len(list) > limit {
+		list = list[:limit]
+	}
+
+	resp := make([]Suggestion, len(list))
+	for i, c := range list {
+		resp[i] = Suggestion{
+			Text:      c.entry.text,
+			Field:     c.entry.field,
+			Highlight: c.entry.highlight(c.at, c.at+len(query)),
+			Count:     c.count,
+		}
+	}
+
+	return resp
+}
+
+func (s *Suggester) count(e *entry, scope string) int {
+	if scope == "" {
+		return e.total
+	}
+	return e.counts[scope]
+}
+
+// highlight wraps the part of the original text matching normalized bytes from start to end in <em> tags
+func (e *entry) highlight(start, end int) string {
+	spans := [][2]int{{e.original(start, false), e.original(end, true)}}
+	if spans[0][0] >= spans[0][1] {
+		return html.EscapeString(e.text)
+	}
+	return highlight(e.text, spans)
+}
+
+// original maps a byte offset of the normalized value to the offset in the text it was made of, offsets inside a
+// word are kept as long as the word still starts with its normalized form, otherwise the whole word is taken
+func (e *entry) original(offset int, end bool) int {
+	at := 0
+	for _, w := range e.words {
+		wEnd := at + len(w.term)
+		if offset < wEnd || (end && offset == wEnd) {
+			if offset <= at {
+				return w.start
+			}
+			if !strings.HasPrefix(strings.ToLower(e.text[w.start:w.end]), w.term) {
+				if end {
+					return w.end
+				}
+				return w.start
+			}
+			return w.start + offset - at
+		}
+		// skip the space joining words
+		at = wEnd + 1
+	}
+	return e.words[len(e.words)-1].end
+}
+
+// wordStarts returns offsets of every word in the normalized value joined by single spaces
+func wordStarts(words []token) []int {
+	starts := make([]int, len(words))
+	at := 0
+	for i, w := range words {
+		starts[i] = at
+		at += len(w.term) + 1
+	}
+	return starts
+}
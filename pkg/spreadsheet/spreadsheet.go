@@ -0,0 +1,167 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	// FormatCSV is comma separated values format
+	FormatCSV = "csv"
+	// FormatXLSX is office open xml workbook format
+	FormatXLSX = "xlsx"
+)
+
+// ErrUnsupportedFormat is returned when format is neither csv nor xlsx
+var ErrUnsupportedFormat = fmt.Errorf("unsupported file format, use csv or xlsx")
+
+// FormatFromFilename returns file format based on file extension
+func FormatFromFilename(name string) string {
+	return strings.ToLower(strings.TrimPrefix(filepath.Ext(name), "."))
+}
+
+// ReadAll reads every row of csv file or first sheet of xlsx file
+func ReadAll(r io.Reader, format string) (records [][]string, err error) {
+	switch format {
+	case FormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+		records, err = reader.ReadAll()
+	case FormatXLSX:
+		records, err = readXLSX(r)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+
+	return records, err
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, nil
+	}
+
+	return f.GetRows(sheets[0])
+}
+
+// Writer writes rows one by one so large data does not need to be held in memory. A cell is a string or a number,
+// numbers are written as number cells. Close releases the writer on every path, also after Flush
+type Writer interface {
+	Write(record []interface{}) error
+	Flush() error
+	Close() error
+}
+
+// NewWriter returns writer for csv or xlsx format
+func NewWriter(w io.Writer, format string) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+// formulaPrefixes start a formula when a spreadsheet application opens the file
+const formulaPrefixes = "=+-@\t\r"
+
+// escapeFormula prefixes text that would be read as a formula with a quote so it stays text
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune(formulaPrefixes, rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// cellValue escapes text cells and keeps numbers as they are
+func cellValue(value interface{}) interface{} {
+	if s, ok := value.(string); ok {
+		return escapeFormula(s)
+	}
+	return value
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (c *csvWriter) Write(record []interface{}) error {
+	values := make([]string, len(record))
+	for i := range record {
+		values[i] = fmt.Sprint(cellValue(record[i]))
+	}
+
+	return c.writer.Write(values)
+}
+
+func (c *csvWriter) Flush() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func (c *csvWriter) Close() error {
+	return nil
+}
+
+// xlsxWriter uses excelize stream writer, rows above its memory threshold are kept in a temporary file
+type xlsxWriter struct {
+	out    io.Writer
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	row    int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+	stream, err := f.NewStreamWriter(f.GetSheetName(0))
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return &xlsxWriter{out: w, file: f, stream: stream}, nil
+}
+
+func (x *xlsxWriter) Write(record []interface{}) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+
+	values := make([]interface{}, len(record))
+	for i := range record {
+		values[i] = cellValue(record[i])
+	}
+
+	return x.stream.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Flush() error {
+	err := x.stream.Flush()
+	if err != nil {
+		return err
+	}
+
+	_, err = x.file.WriteTo(x.out)
+	return err
+}
+
+// Close removes the temporary files of the workbook
+func (x *xlsxWriter) Close() error {
+	return x.file.Close()
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3 stores files in S3 compatible object storage such as AWS S3 or MinIO
+type S3 struct {
+	client  *minio.Client
+	bucket  string
+	baseURL string
+}
+
+// NewS3 creates S3 storage, baseURL is public address of the bucket or CDN in front of it
+func NewS3(endpoint, accessKey, secretKey, bucket, baseURL string, useSSL bool) (*S3, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: useSSL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if baseURL == "" {
+		scheme := "http://"
+		if useSSL {
+			scheme = "https://"
+		}
+		baseURL = scheme + endpoint + "/" + bucket
+	}
+
+	return &S3{
+		client:  client,
+		bucket:  bucket,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}, nil
+}
+
+// Put uploads object with public cache header, objects are immutable because keys are never reused
+func (s *S3) Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error {
+	_, err := s.client.PutObject(ctx, s.bucket, key, r, size, minio.PutObjectOptions{
+		ContentType:  contentType,
+		CacheControl: "public, max-age=31536000, immutable",
+	})
+	return err
+}
+
+// Delete removes object
+func (s *S3) Delete(ctx context.Context, key string) error {
+	return s.client.RemoveObject(ctx, s.bucket, key, minio.RemoveObjectOptions{})
+}
+
+// URL returns public address of the object
+func (s *S3) URL(key string) string {
+	return s.baseURL + "/" + key
+}
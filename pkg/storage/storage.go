@@ -0,0 +1,22 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// IsLocal tells whether storage driver in config stores files on local disk, it is the default driver
+func IsLocal(driver string) bool {
+	return driver == "" || driver == "local"
+}
+
+// ErrUnknownDriver is returned when storage driver in config is not supported
+var ErrUnknownDriver = fmt.Errorf("unknown storage driver, use local or s3")
+
+// Storage saves uploaded files and tells where they are served from
+type Storage interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	Delete(ctx context.Context, key string) error
+	URL(key string) string
+}
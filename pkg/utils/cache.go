@@ -0,0 +1,96 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	log "go.uber.org/zap"
+)
+
+// CacheControl returns middleware that sets the Cache-Control header on successful responses of a route, a 304
+// carries it as well since it refreshes the cached 200. Error responses are never cached
+func CacheControl(value string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			if value != "" {
+				res := ctx.Response()
+				res.Before(func() {
+					if (res.Status >= 200 && res.Status < 300) || res.Status == http.StatusNotModified {
+						res.Header().Set(echo.HeaderCacheControl, value)
+					}
+				})
+			}
+			return next(ctx)
+		}
+	}
+}
+
+// CacheableResponse returns success response with ETag and Last-Modified, or 304 when client copy is still fresh
+func CacheableResponse(ctx echo.Context, message string, data interface{}, lastModified time.Time) error {
+	etag, err := generateETag(data)
+	if err != nil {
+		return ErrorInternalServerResponse(ctx, err, map[string]interface{}{})
+	}
+
+	header := ctx.Response().Header()
+	header.Set("ETag", etag)
+	if !lastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if isNotModified(ctx.Request(), etag, lastModified) {
+		log.S().Info("not modified response")
+		return ctx.NoContent(http.StatusNotModified)
+	}
+
+	return SuccessResponse(ctx, message, data)
+}
+
+// LatestUpdate returns the most recent time from the given list
+func LatestUpdate(times ...time.Time) (latest time.Time) {
+	for _, t := range times {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+	return
+}
+
+// generateETag hashes the response data, the envelope timestamp is left out so the tag is stable
+func generateETag(data interface{}) (string, error) {
+	body, err := json.Marshal(data)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(body)
+	return `"` + hex.EncodeToString(sum[:]) + `"`, nil
+}
+
+// isNotModified evaluates If-None-Match first and falls back to If-Modified-Since as described in RFC 7232
+func isNotModified(r *http.Request, etag string, lastModified time.Time) bool {
+	if inm := r.Header.Get("If-None-Match"); inm != "" {
+		for _, tag := range strings.Split(inm, ",") {
+			tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+			if tag == "*" || tag == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ims := r.Header.Get(echo.HeaderIfModifiedSince); ims != "" && !lastModified.IsZero() {
+		since, err := http.ParseTime(ims)
+		if err != nil {
+			return false
+		}
+		return !lastModified.Truncate(time.Second).After(since)
+	}
+
+	return false
+}
@@ -14,28 +14,46 @@ func errorType(err error) (int, error) {
 func switchErrorValidation(err error) (message string) {
 	if castedObject, ok := err.(validator.ValidationErrors); ok {
 		for _, err := range castedObject {
-			field := SetLowerAndAddSpace(err.Field())
-
-			// Check Error Type
-			switch err.Tag() {
-			case "required":
-				message = fmt.Sprintf("%s is mandatory",
-					field)
-			case "number":
-				message = fmt.Sprintf("%s must be numbers only",
-					field)
-			case "gte":
-				message = fmt.Sprintf("%s value must be greater than %s",
-					field, err.Param())
-			case "lte":
-				message = fmt.Sprintf("%s value must be lower than %s",
-					field, err.Param())
-			default:
-				message = err.Error()
-			}
+			message = validationMessage(err)
 
 			break
 		}
 	}
 	return
 }
+
+// ValidationMessages returns readable message for every failed field
+func ValidationMessages(err error) (messages []string) {
+	castedObject, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return []string{err.Error()}
+	}
+
+	for _, err := range castedObject {
+		messages = append(messages, validationMessage(err))
+	}
+	return
+}
+
+func validationMessage(err validator.FieldError) (message string) {
+	field := SetLowerAndAddSpace(err.Field())
+
+	// Check Error Type
+	switch err.Tag() {
+	case "required":
+		message = fmt.Sprintf("%s is mandatory",
+			field)
+	case "number":
+		message = fmt.Sprintf("%s must be numbers only",
+			field)
+	case "gte":
+		message = fmt.Sprintf("%s value must be greater than %s",
+			field, err.Param())
+	case "lte":
+		message = fmt.Sprintf("%s value must be lower than %s",
+			field, err.Param())
+	default:
+		message = err.Error()
+	}
+	return
+}
@@ -58,6 +58,8 @@ func ErrorResponse(ctx echo.Context, err error, data interface{}) error {
 		return ErrorBadRequest(ctx, err, data)
 	case http.StatusNotFound:
 		return ErrorNotFound(ctx, err, data)
+	case http.StatusUnauthorized:
+		return ErrorUnauthorized(ctx, err, data)
 	}
 	return ErrorInternalServerResponse(ctx, err, data)
 }
@@ -124,6 +126,21 @@ func ErrorNotFound(ctx echo.Context, err error, data interface{}) error {
 	return ctx.JSON(http.StatusNotFound, responseData)
 }
 
+// ErrorUnauthorized returns
+func ErrorUnauthorized(ctx echo.Context, err error, data interface{}) error {
+	responseData := response.Base{
+		Status:     "unauthorized",
+		StatusCode: http.StatusUnauthorized,
+		Message:    err.Error(),
+		Timestamp:  time.Now().UTC(),
+		Data:       data,
+	}
+
+	log.S().Errorf("unauthorized error : %s ", err.Error())
+
+	return ctx.JSON(http.StatusUnauthorized, responseData)
+}
+
 // ErrorParsing returns
 func ErrorParsing(ctx echo.Context, err error, data interface{}) error {
 
@@ -0,0 +1,1643 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: menu.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MenuTypeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MenuTypeRequest) Reset() {
+	*x = MenuTypeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuTypeRequest) ProtoMessage() {}
+
+func (x *MenuTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuTypeRequest.ProtoReflect.Descriptor instead.
+func (*MenuTypeRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{0}
+}
+
+type MenuType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuTypeId   int32                  `protobuf:"varint,1,opt,name=menu_type_id,json=menuTypeId,proto3" json:"menu_type_id,omitempty"`
+	MenuTypeName string                 `protobuf:"bytes,2,opt,name=menu_type_name,json=menuTypeName,proto3" json:"menu_type_name,omitempty"`
+	UpdatedDate  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+}
+
+func (x *MenuType) Reset() {
+	*x = MenuType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuType) ProtoMessage() {}
+
+func (x *MenuType) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuType.ProtoReflect.Descriptor instead.
+func (*MenuType) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MenuType) GetMenuTypeId() int32 {
+	if x != nil {
+		return x.MenuTypeId
+	}
+	return 0
+}
+
+func (x *MenuType) GetMenuTypeName() string {
+	if x != nil {
+		return x.MenuTypeName
+	}
+	return ""
+}
+
+func (x *MenuType) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type MenuTypeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuTypes []*MenuType `protobuf:"bytes,1,rep,name=menu_types,json=menuTypes,proto3" json:"menu_types,omitempty"`
+}
+
+func (x *MenuTypeResponse) Reset() {
+	*x = MenuTypeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuTypeResponse) ProtoMessage() {}
+
+func (x *MenuTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuTypeResponse.ProtoReflect.Descriptor instead.
+func (*MenuTypeResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MenuTypeResponse) GetMenuTypes() []*MenuType {
+	if x != nil {
+		return x.MenuTypes
+	}
+	return nil
+}
+
+type MenuAddRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuTypeId  int32  `protobuf:"varint,1,opt,name=menu_type_id,json=menuTypeId,proto3" json:"menu_type_id,omitempty"`
+	WartegId    string `protobuf:"bytes,2,opt,name=warteg_id,json=wartegId,proto3" json:"warteg_id,omitempty"`
+	MenuName    string `protobuf:"bytes,3,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	MenuDetail  string `protobuf:"bytes,4,opt,name=menu_detail,json=menuDetail,proto3" json:"menu_detail,omitempty"`
+	MenuPicture string `protobuf:"bytes,5,opt,name=menu_picture,json=menuPicture,proto3" json:"menu_picture,omitempty"`
+	MenuPrice   int64  `protobuf:"varint,6,opt,name=menu_price,json=menuPrice,proto3" json:"menu_price,omitempty"`
+}
+
+func (x *MenuAddRequest) Reset() {
+	*x = MenuAddRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuAddRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuAddRequest) ProtoMessage() {}
+
+func (x *MenuAddRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuAddRequest.ProtoReflect.Descriptor instead.
+func (*MenuAddRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MenuAddRequest) GetMenuTypeId() int32 {
+	if x != nil {
+		return x.MenuTypeId
+	}
+	return 0
+}
+
+func (x *MenuAddRequest) GetWartegId() string {
+	if x != nil {
+		return x.WartegId
+	}
+	return ""
+}
+
+func (x *MenuAddRequest) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *MenuAddRequest) GetMenuDetail() string {
+	if x != nil {
+		return x.MenuDetail
+	}
+	return ""
+}
+
+func (x *MenuAddRequest) GetMenuPicture() string {
+	if x != nil {
+		return x.MenuPicture
+	}
+	return ""
+}
+
+func (x *MenuAddRequest) GetMenuPrice() int64 {
+	if x != nil {
+		return x.MenuPrice
+	}
+	return 0
+}
+
+type MenuUpdateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuId      string `protobuf:"bytes,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	MenuTypeId  int32  `protobuf:"varint,2,opt,name=menu_type_id,json=menuTypeId,proto3" json:"menu_type_id,omitempty"`
+	WartegId    string `protobuf:"bytes,3,opt,name=warteg_id,json=wartegId,proto3" json:"warteg_id,omitempty"`
+	MenuName    string `protobuf:"bytes,4,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	MenuDetail  string `protobuf:"bytes,5,opt,name=menu_detail,json=menuDetail,proto3" json:"menu_detail,omitempty"`
+	MenuPicture string `protobuf:"bytes,6,opt,name=menu_picture,json=menuPicture,proto3" json:"menu_picture,omitempty"`
+	MenuPrice   int64  `protobuf:"varint,7,opt,name=menu_price,json=menuPrice,proto3" json:"menu_price,omitempty"`
+}
+
+func (x *MenuUpdateRequest) Reset() {
+	*x = MenuUpdateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuUpdateRequest) ProtoMessage() {}
+
+func (x *MenuUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuUpdateRequest.ProtoReflect.Descriptor instead.
+func (*MenuUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MenuUpdateRequest) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
+func (x *MenuUpdateRequest) GetMenuTypeId() int32 {
+	if x != nil {
+		return x.MenuTypeId
+	}
+	return 0
+}
+
+func (x *MenuUpdateRequest) GetWartegId() string {
+	if x != nil {
+		return x.WartegId
+	}
+	return ""
+}
+
+func (x *MenuUpdateRequest) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *MenuUpdateRequest) GetMenuDetail() string {
+	if x != nil {
+		return x.MenuDetail
+	}
+	return ""
+}
+
+func (x *MenuUpdateRequest) GetMenuPicture() string {
+	if x != nil {
+		return x.MenuPicture
+	}
+	return ""
+}
+
+func (x *MenuUpdateRequest) GetMenuPrice() int64 {
+	if x != nil {
+		return x.MenuPrice
+	}
+	return 0
+}
+
+// PriceChange is a menu price change waiting for approval, the menu keeps its price until it is approved
+type PriceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChangeId      string                 `protobuf:"bytes,1,opt,name=change_id,json=changeId,proto3" json:"change_id,omitempty"`
+	OldPrice      int64                  `protobuf:"varint,2,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      int64                  `protobuf:"varint,3,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	RequestedBy   string                 `protobuf:"bytes,5,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	RequestedDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=requested_date,json=requestedDate,proto3" json:"requested_date,omitempty"`
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PriceChange) GetChangeId() string {
+	if x != nil {
+		return x.ChangeId
+	}
+	return ""
+}
+
+func (x *PriceChange) GetOldPrice() int64 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *PriceChange) GetNewPrice() int64 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *PriceChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PriceChange) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *PriceChange) GetRequestedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedDate
+	}
+	return nil
+}
+
+type Menu struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuId      string `protobuf:"bytes,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	MenuTypeId  int32  `protobuf:"varint,2,opt,name=menu_type_id,json=menuTypeId,proto3" json:"menu_type_id,omitempty"`
+	WartegId    string `protobuf:"bytes,3,opt,name=warteg_id,json=wartegId,proto3" json:"warteg_id,omitempty"`
+	MenuName    string `protobuf:"bytes,4,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	MenuDetail  string `protobuf:"bytes,5,opt,name=menu_detail,json=menuDetail,proto3" json:"menu_detail,omitempty"`
+	MenuPicture string `protobuf:"bytes,6,opt,name=menu_picture,json=menuPicture,proto3" json:"menu_picture,omitempty"`
+	MenuPrice   int64  `protobuf:"varint,7,opt,name=menu_price,json=menuPrice,proto3" json:"menu_price,omitempty"`
+	// price_change is set when an update asked for a price beyond the approval threshold
+	PriceChange *PriceChange `protobuf:"bytes,8,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"`
+}
+
+func (x *Menu) Reset() {
+	*x = Menu{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Menu) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Menu) ProtoMessage() {}
+
+func (x *Menu) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Menu.ProtoReflect.Descriptor instead.
+func (*Menu) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Menu) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
+func (x *Menu) GetMenuTypeId() int32 {
+	if x != nil {
+		return x.MenuTypeId
+	}
+	return 0
+}
+
+func (x *Menu) GetWartegId() string {
+	if x != nil {
+		return x.WartegId
+	}
+	return ""
+}
+
+func (x *Menu) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *Menu) GetMenuDetail() string {
+	if x != nil {
+		return x.MenuDetail
+	}
+	return ""
+}
+
+func (x *Menu) GetMenuPicture() string {
+	if x != nil {
+		return x.MenuPicture
+	}
+	return ""
+}
+
+func (x *Menu) GetMenuPrice() int64 {
+	if x != nil {
+		return x.MenuPrice
+	}
+	return 0
+}
+
+func (x *Menu) GetPriceChange() *PriceChange {
+	if x != nil {
+		return x.PriceChange
+	}
+	return nil
+}
+
+type MenuDeleteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuId string `protobuf:"bytes,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+}
+
+func (x *MenuDeleteRequest) Reset() {
+	*x = MenuDeleteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuDeleteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuDeleteRequest) ProtoMessage() {}
+
+func (x *MenuDeleteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuDeleteRequest.ProtoReflect.Descriptor instead.
+func (*MenuDeleteRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MenuDeleteRequest) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
+type MenuDeleteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuId string `protobuf:"bytes,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+}
+
+func (x *MenuDeleteResponse) Reset() {
+	*x = MenuDeleteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuDeleteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuDeleteResponse) ProtoMessage() {}
+
+func (x *MenuDeleteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuDeleteResponse.ProtoReflect.Descriptor instead.
+func (*MenuDeleteResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MenuDeleteResponse) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
+type MenuListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WartegId      string `protobuf:"bytes,1,opt,name=warteg_id,json=wartegId,proto3" json:"warteg_id,omitempty"`
+	MenuTypeId    string `protobuf:"bytes,2,opt,name=menu_type_id,json=menuTypeId,proto3" json:"menu_type_id,omitempty"`
+	MenuName      string `protobuf:"bytes,3,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	AvailableOnly bool   `protobuf:"varint,4,opt,name=available_only,json=availableOnly,proto3" json:"available_only,omitempty"`
+}
+
+func (x *MenuListRequest) Reset() {
+	*x = MenuListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuListRequest) ProtoMessage() {}
+
+func (x *MenuListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuListRequest.ProtoReflect.Descriptor instead.
+func (*MenuListRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MenuListRequest) GetWartegId() string {
+	if x != nil {
+		return x.WartegId
+	}
+	return ""
+}
+
+func (x *MenuListRequest) GetMenuTypeId() string {
+	if x != nil {
+		return x.MenuTypeId
+	}
+	return ""
+}
+
+func (x *MenuListRequest) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *MenuListRequest) GetAvailableOnly() bool {
+	if x != nil {
+		return x.AvailableOnly
+	}
+	return false
+}
+
+type AppliedPromotion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromotionId   string                 `protobuf:"bytes,1,opt,name=promotion_id,json=promotionId,proto3" json:"promotion_id,omitempty"`
+	PromotionName string                 `protobuf:"bytes,2,opt,name=promotion_name,json=promotionName,proto3" json:"promotion_name,omitempty"`
+	PromotionType string                 `protobuf:"bytes,3,opt,name=promotion_type,json=promotionType,proto3" json:"promotion_type,omitempty"`
+	Value         int64                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	BuyQuantity   int32                  `protobuf:"varint,5,opt,name=buy_quantity,json=buyQuantity,proto3" json:"buy_quantity,omitempty"`
+	GetQuantity   int32                  `protobuf:"varint,6,opt,name=get_quantity,json=getQuantity,proto3" json:"get_quantity,omitempty"`
+	Discount      int64                  `protobuf:"varint,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+}
+
+func (x *AppliedPromotion) Reset() {
+	*x = AppliedPromotion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppliedPromotion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedPromotion) ProtoMessage() {}
+
+func (x *AppliedPromotion) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedPromotion.ProtoReflect.Descriptor instead.
+func (*AppliedPromotion) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AppliedPromotion) GetPromotionId() string {
+	if x != nil {
+		return x.PromotionId
+	}
+	return ""
+}
+
+func (x *AppliedPromotion) GetPromotionName() string {
+	if x != nil {
+		return x.PromotionName
+	}
+	return ""
+}
+
+func (x *AppliedPromotion) GetPromotionType() string {
+	if x != nil {
+		return x.PromotionType
+	}
+	return ""
+}
+
+func (x *AppliedPromotion) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *AppliedPromotion) GetBuyQuantity() int32 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *AppliedPromotion) GetGetQuantity() int32 {
+	if x != nil {
+		return x.GetQuantity
+	}
+	return 0
+}
+
+func (x *AppliedPromotion) GetDiscount() int64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *AppliedPromotion) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+type MenuListItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuId         string            `protobuf:"bytes,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	MenuTypeId     int32             `protobuf:"varint,2,opt,name=menu_type_id,json=menuTypeId,proto3" json:"menu_type_id,omitempty"`
+	MenuTypeName   string            `protobuf:"bytes,3,opt,name=menu_type_name,json=menuTypeName,proto3" json:"menu_type_name,omitempty"`
+	WartegId       string            `protobuf:"bytes,4,opt,name=warteg_id,json=wartegId,proto3" json:"warteg_id,omitempty"`
+	MenuName       string            `protobuf:"bytes,5,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	MenuPrice      int64             `protobuf:"varint,6,opt,name=menu_price,json=menuPrice,proto3" json:"menu_price,omitempty"`
+	EffectivePrice int64             `protobuf:"varint,7,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	Promotion      *AppliedPromotion `protobuf:"bytes,8,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	MinPrice       int64             `protobuf:"varint,9,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice       int64             `protobuf:"varint,10,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	IsSoldOut      bool              `protobuf:"varint,11,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`
+	// stock is unset when the menu has no stock limit
+	Stock       *int32                 `protobuf:"varint,12,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	IsBundle    bool                   `protobuf:"varint,13,opt,name=is_bundle,json=isBundle,proto3" json:"is_bundle,omitempty"`
+	UpdatedDate *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+}
+
+func (x *MenuListItem) Reset() {
+	*x = MenuListItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuListItem) ProtoMessage() {}
+
+func (x *MenuListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuListItem.ProtoReflect.Descriptor instead.
+func (*MenuListItem) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MenuListItem) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
+func (x *MenuListItem) GetMenuTypeId() int32 {
+	if x != nil {
+		return x.MenuTypeId
+	}
+	return 0
+}
+
+func (x *MenuListItem) GetMenuTypeName() string {
+	if x != nil {
+		return x.MenuTypeName
+	}
+	return ""
+}
+
+func (x *MenuListItem) GetWartegId() string {
+	if x != nil {
+		return x.WartegId
+	}
+	return ""
+}
+
+func (x *MenuListItem) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *MenuListItem) GetMenuPrice() int64 {
+	if x != nil {
+		return x.MenuPrice
+	}
+	return 0
+}
+
+func (x *MenuListItem) GetEffectivePrice() int64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *MenuListItem) GetPromotion() *AppliedPromotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+func (x *MenuListItem) GetMinPrice() int64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *MenuListItem) GetMaxPrice() int64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *MenuListItem) GetIsSoldOut() bool {
+	if x != nil {
+		return x.IsSoldOut
+	}
+	return false
+}
+
+func (x *MenuListItem) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *MenuListItem) GetIsBundle() bool {
+	if x != nil {
+		return x.IsBundle
+	}
+	return false
+}
+
+func (x *MenuListItem) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+type MenuDetailRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuId string `protobuf:"bytes,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+}
+
+func (x *MenuDetailRequest) Reset() {
+	*x = MenuDetailRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuDetailRequest) ProtoMessage() {}
+
+func (x *MenuDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuDetailRequest.ProtoReflect.Descriptor instead.
+func (*MenuDetailRequest) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MenuDetailRequest) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
+type MenuDetailResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MenuId         string            `protobuf:"bytes,1,opt,name=menu_id,json=menuId,proto3" json:"menu_id,omitempty"`
+	MenuTypeId     int32             `protobuf:"varint,2,opt,name=menu_type_id,json=menuTypeId,proto3" json:"menu_type_id,omitempty"`
+	MenuTypeName   string            `protobuf:"bytes,3,opt,name=menu_type_name,json=menuTypeName,proto3" json:"menu_type_name,omitempty"`
+	WartegId       string            `protobuf:"bytes,4,opt,name=warteg_id,json=wartegId,proto3" json:"warteg_id,omitempty"`
+	MenuName       string            `protobuf:"bytes,5,opt,name=menu_name,json=menuName,proto3" json:"menu_name,omitempty"`
+	MenuDetail     string            `protobuf:"bytes,6,opt,name=menu_detail,json=menuDetail,proto3" json:"menu_detail,omitempty"`
+	MenuPicture    string            `protobuf:"bytes,7,opt,name=menu_picture,json=menuPicture,proto3" json:"menu_picture,omitempty"`
+	MenuPrice      int64             `protobuf:"varint,8,opt,name=menu_price,json=menuPrice,proto3" json:"menu_price,omitempty"`
+	EffectivePrice int64             `protobuf:"varint,9,opt,name=effective_price,json=effectivePrice,proto3" json:"effective_price,omitempty"`
+	Promotion      *AppliedPromotion `protobuf:"bytes,10,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	IsSoldOut      bool              `protobuf:"varint,11,opt,name=is_sold_out,json=isSoldOut,proto3" json:"is_sold_out,omitempty"`
+	// stock is unset when the menu has no stock limit
+	Stock       *int32                 `protobuf:"varint,12,opt,name=stock,proto3,oneof" json:"stock,omitempty"`
+	IsBundle    bool                   `protobuf:"varint,13,opt,name=is_bundle,json=isBundle,proto3" json:"is_bundle,omitempty"`
+	UpdatedDate *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_date,json=updatedDate,proto3" json:"updated_date,omitempty"`
+}
+
+func (x *MenuDetailResponse) Reset() {
+	*x = MenuDetailResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_menu_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MenuDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MenuDetailResponse) ProtoMessage() {}
+
+func (x *MenuDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_menu_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MenuDetailResponse.ProtoReflect.Descriptor instead.
+func (*MenuDetailResponse) Descriptor() ([]byte, []int) {
+	return file_menu_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MenuDetailResponse) GetMenuId() string {
+	if x != nil {
+		return x.MenuId
+	}
+	return ""
+}
+
+func (x *MenuDetailResponse) GetMenuTypeId() int32 {
+	if x != nil {
+		return x.MenuTypeId
+	}
+	return 0
+}
+
+func (x *MenuDetailResponse) GetMenuTypeName() string {
+	if x != nil {
+		return x.MenuTypeName
+	}
+	return ""
+}
+
+func (x *MenuDetailResponse) GetWartegId() string {
+	if x != nil {
+		return x.WartegId
+	}
+	return ""
+}
+
+func (x *MenuDetailResponse) GetMenuName() string {
+	if x != nil {
+		return x.MenuName
+	}
+	return ""
+}
+
+func (x *MenuDetailResponse) GetMenuDetail() string {
+	if x != nil {
+		return x.MenuDetail
+	}
+	return ""
+}
+
+func (x *MenuDetailResponse) GetMenuPicture() string {
+	if x != nil {
+		return x.MenuPicture
+	}
+	return ""
+}
+
+func (x *MenuDetailResponse) GetMenuPrice() int64 {
+	if x != nil {
+		return x.MenuPrice
+	}
+	return 0
+}
+
+func (x *MenuDetailResponse) GetEffectivePrice() int64 {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return 0
+}
+
+func (x *MenuDetailResponse) GetPromotion() *AppliedPromotion {
+	if x != nil {
+		return x.Promotion
+	}
+	return nil
+}
+
+func (x *MenuDetailResponse) GetIsSoldOut() bool {
+	if x != nil {
+		return x.IsSoldOut
+	}
+	return false
+}
+
+func (x *MenuDetailResponse) GetStock() int32 {
+	if x != nil && x.Stock != nil {
+		return *x.Stock
+	}
+	return 0
+}
+
+func (x *MenuDetailResponse) GetIsBundle() bool {
+	if x != nil {
+		return x.IsBundle
+	}
+	return false
+}
+
+func (x *MenuDetailResponse) GetUpdatedDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedDate
+	}
+	return nil
+}
+
+var File_menu_proto protoreflect.FileDescriptor
+
+var file_menu_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x66, 0x6f,
+	0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x11, 0x0a, 0x0f, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x54,
+	0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x4d, 0x0a, 0x10, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x6d, 0x65,
+	0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61,
+	0x72, 0x74, 0x65, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77,
+	0x61, 0x72, 0x74, 0x65, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x70, 0x69,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6e,
+	0x75, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65,
+	0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xeb, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x6e, 0x75,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x6e, 0x75, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x65,
+	0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x72, 0x74,
+	0x65, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72,
+	0x74, 0x65, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x70, 0x69, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x50,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0xa0, 0x02, 0x0a, 0x04, 0x4d,
+	0x65, 0x6e, 0x75, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c,
+	0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x61, 0x72, 0x74, 0x65, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x74, 0x65, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x65, 0x6e, 0x75, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6e,
+	0x75, 0x5f, 0x70, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0c, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e,
+	0x75, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x2c, 0x0a,
+	0x11, 0x4d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x64, 0x22, 0x2d, 0x0a, 0x12, 0x4d,
+	0x65, 0x6e, 0x75, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0f, 0x4d,
+	0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x61, 0x72, 0x74, 0x65, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x74, 0x65, 0x67, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d,
+	0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x76,
+	0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0d, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x6e, 0x6c,
+	0x79, 0x22, 0xb0, 0x02, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
+	0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x75, 0x79, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x62, 0x75, 0x79, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e,
+	0x64, 0x73, 0x41, 0x74, 0x22, 0x8e, 0x04, 0x0a, 0x0c, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6e, 0x75, 0x49, 0x64, 0x12, 0x20,
+	0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64,
+	0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x54, 0x79,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x72, 0x74, 0x65, 0x67,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x74, 0x65,
+	0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e, 0x75, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x65, 0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d,
+	0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x6f,
+	0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d,
+	0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x6f, 0x6c,
+	0x64, 0x4f, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0c,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f,
+	0x73, 0x74, 0x6f, 0x63, 0x6b, 0x22, 0x2c, 0x0a, 0x11, 0x4d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65,
+	0x6e, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6e,
+	0x75, 0x49, 0x64, 0x22, 0x9e, 0x04, 0x0a, 0x12, 0x4d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65,
+	0x6e, 0x75, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x6e,
+	0x75, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
+	0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77,
+	0x61, 0x72, 0x74, 0x65, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x77, 0x61, 0x72, 0x74, 0x65, 0x67, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x6e, 0x75,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x6e,
+	0x75, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x6e, 0x75,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x6e, 0x75, 0x5f, 0x70,
+	0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65,
+	0x6e, 0x75, 0x50, 0x69, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6e,
+	0x75, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d,
+	0x65, 0x6e, 0x75, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x40, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e,
+	0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50,
+	0x72, 0x6f, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x73, 0x6f, 0x6c, 0x64, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x53, 0x6f, 0x6c, 0x64,
+	0x4f, 0x75, 0x74, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x63, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x1b,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x3d, 0x0a, 0x0c, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x44, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73,
+	0x74, 0x6f, 0x63, 0x6b, 0x32, 0xf3, 0x03, 0x0a, 0x0b, 0x4d, 0x65, 0x6e, 0x75, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x51, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x21, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d,
+	0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x07, 0x4d, 0x65, 0x6e, 0x75, 0x41,
+	0x64, 0x64, 0x12, 0x20, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65,
+	0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e,
+	0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x49, 0x0a, 0x0a,
+	0x4d, 0x65, 0x6e, 0x75, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x66, 0x6f, 0x6f,
+	0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x12, 0x57, 0x0a, 0x0a, 0x4d, 0x65, 0x6e, 0x75, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75,
+	0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x6f, 0x6f,
+	0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x6e, 0x75, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4f, 0x0a, 0x08, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x2e, 0x66,
+	0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x30,
+	0x01, 0x12, 0x57, 0x0a, 0x0a, 0x4d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12,
+	0x23, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x6d, 0x65, 0x6e, 0x75, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2e,
+	0x6d, 0x65, 0x6e, 0x75, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6e, 0x75, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x70, 0x61, 0x72, 0x74, 0x6f, 0x67,
+	0x69, 0x2f, 0x66, 0x6f, 0x6f, 0x64, 0x6d, 0x65, 0x6e, 0x75, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_menu_proto_rawDescOnce sync.Once
+	file_menu_proto_rawDescData = file_menu_proto_rawDesc
+)
+
+func file_menu_proto_rawDescGZIP() []byte {
+	file_menu_proto_rawDescOnce.Do(func() {
+		file_menu_proto_rawDescData = protoimpl.X.CompressGZIP(file_menu_proto_rawDescData)
+	})
+	return file_menu_proto_rawDescData
+}
+
+var file_menu_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_menu_proto_goTypes = []any{
+	(*MenuTypeRequest)(nil),       // 0: foodmenu.menu.v1.MenuTypeRequest
+	(*MenuType)(nil),              // 1: foodmenu.menu.v1.MenuType
+	(*MenuTypeResponse)(nil),      // 2: foodmenu.menu.v1.MenuTypeResponse
+	(*MenuAddRequest)(nil),        // 3: foodmenu.menu.v1.MenuAddRequest
+	(*MenuUpdateRequest)(nil),     // 4: foodmenu.menu.v1.MenuUpdateRequest
+	(*PriceChange)(nil),           // 5: foodmenu.menu.v1.PriceChange
+	(*Menu)(nil),                  // 6: foodmenu.menu.v1.Menu
+	(*MenuDeleteRequest)(nil),     // 7: foodmenu.menu.v1.MenuDeleteRequest
+	(*MenuDeleteResponse)(nil),    // 8: foodmenu.menu.v1.MenuDeleteResponse
+	(*MenuListRequest)(nil),       // 9: foodmenu.menu.v1.MenuListRequest
+	(*AppliedPromotion)(nil),      // 10: foodmenu.menu.v1.AppliedPromotion
+	(*MenuListItem)(nil),          // 11: foodmenu.menu.v1.MenuListItem
+	(*MenuDetailRequest)(nil),     // 12: foodmenu.menu.v1.MenuDetailRequest
+	(*MenuDetailResponse)(nil),    // 13: foodmenu.menu.v1.MenuDetailResponse
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+}
+var file_menu_proto_depIdxs = []int32{
+	14, // 0: foodmenu.menu.v1.MenuType.updated_date:type_name -> google.protobuf.Timestamp
+	1,  // 1: foodmenu.menu.v1.MenuTypeResponse.menu_types:type_name -> foodmenu.menu.v1.MenuType
+	14, // 2: foodmenu.menu.v1.PriceChange.requested_date:type_name -> google.protobuf.Timestamp
+	5,  // 3: foodmenu.menu.v1.Menu.price_change:type_name -> foodmenu.menu.v1.PriceChange
+	14, // 4: foodmenu.menu.v1.AppliedPromotion.ends_at:type_name -> google.protobuf.Timestamp
+	10, // 5: foodmenu.menu.v1.MenuListItem.promotion:type_name -> foodmenu.menu.v1.AppliedPromotion
+	14, // 6: foodmenu.menu.v1.MenuListItem.updated_date:type_name -> google.protobuf.Timestamp
+	10, // 7: foodmenu.menu.v1.MenuDetailResponse.promotion:type_name -> foodmenu.menu.v1.AppliedPromotion
+	14, // 8: foodmenu.menu.v1.MenuDetailResponse.updated_date:type_name -> google.protobuf.Timestamp
+	0,  // 9: foodmenu.menu.v1.MenuService.MenuType:input_type -> foodmenu.menu.v1.MenuTypeRequest
+	3,  // 10: foodmenu.menu.v1.MenuService.MenuAdd:input_type -> foodmenu.menu.v1.MenuAddRequest
+	4,  // 11: foodmenu.menu.v1.MenuService.MenuUpdate:input_type -> foodmenu.menu.v1.MenuUpdateRequest
+	7,  // 12: foodmenu.menu.v1.MenuService.MenuDelete:input_type -> foodmenu.menu.v1.MenuDeleteRequest
+	9,  // 13: foodmenu.menu.v1.MenuService.MenuList:input_type -> foodmenu.menu.v1.MenuListRequest
+	12, // 14: foodmenu.menu.v1.MenuService.MenuDetail:input_type -> foodmenu.menu.v1.MenuDetailRequest
+	2,  // 15: foodmenu.menu.v1.MenuService.MenuType:output_type -> foodmenu.menu.v1.MenuTypeResponse
+	6,  // 16: foodmenu.menu.v1.MenuService.MenuAdd:output_type -> foodmenu.menu.v1.Menu
+	6,  // 17: foodmenu.menu.v1.MenuService.MenuUpdate:output_type -> foodmenu.menu.v1.Menu
+	8,  // 18: foodmenu.menu.v1.MenuService.MenuDelete:output_type -> foodmenu.menu.v1.MenuDeleteResponse
+	11, // 19: foodmenu.menu.v1.MenuService.MenuList:output_type -> foodmenu.menu.v1.MenuListItem
+	13, // 20: foodmenu.menu.v1.MenuService.MenuDetail:output_type -> foodmenu.menu.v1.MenuDetailResponse
+	15, // [15:21] is the sub-list for method output_type
+	9,  // [9:15] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_menu_proto_init() }
+func file_menu_proto_init() {
+	if File_menu_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_menu_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuTypeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuTypeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuUpdateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*PriceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Menu); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuDeleteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuDeleteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*AppliedPromotion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuListItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuDetailRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_menu_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*MenuDetailResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_menu_proto_msgTypes[11].OneofWrappers = []any{}
+	file_menu_proto_msgTypes[13].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_menu_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_menu_proto_goTypes,
+		DependencyIndexes: file_menu_proto_depIdxs,
+		MessageInfos:      file_menu_proto_msgTypes,
+	}.Build()
+	File_menu_proto = out.File
+	file_menu_proto_rawDesc = nil
+	file_menu_proto_goTypes = nil
+	file_menu_proto_depIdxs = nil
+}
@@ -0,0 +1,324 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: menu.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	MenuService_MenuType_FullMethodName   = "/foodmenu.menu.v1.MenuService/MenuType"
+	MenuService_MenuAdd_FullMethodName    = "/foodmenu.menu.v1.MenuService/MenuAdd"
+	MenuService_MenuUpdate_FullMethodName = "/foodmenu.menu.v1.MenuService/MenuUpdate"
+	MenuService_MenuDelete_FullMethodName = "/foodmenu.menu.v1.MenuService/MenuDelete"
+	MenuService_MenuList_FullMethodName   = "/foodmenu.menu.v1.MenuService/MenuList"
+	MenuService_MenuDetail_FullMethodName = "/foodmenu.menu.v1.MenuService/MenuDetail"
+)
+
+// MenuServiceClient is the client API for MenuService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MenuServiceClient interface {
+	MenuType(ctx context.Context, in *MenuTypeRequest, opts ...grpc.CallOption) (*MenuTypeResponse, error)
+	MenuAdd(ctx context.Context, in *MenuAddRequest, opts ...grpc.CallOption) (*Menu, error)
+	MenuUpdate(ctx context.Context, in *MenuUpdateRequest, opts ...grpc.CallOption) (*Menu, error)
+	MenuDelete(ctx context.Context, in *MenuDeleteRequest, opts ...grpc.CallOption) (*MenuDeleteResponse, error)
+	// MenuList streams menus one by one so large lists are not held in one message
+	MenuList(ctx context.Context, in *MenuListRequest, opts ...grpc.CallOption) (MenuService_MenuListClient, error)
+	MenuDetail(ctx context.Context, in *MenuDetailRequest, opts ...grpc.CallOption) (*MenuDetailResponse, error)
+}
+
+type menuServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMenuServiceClient(cc grpc.ClientConnInterface) MenuServiceClient {
+	return &menuServiceClient{cc}
+}
+
+func (c *menuServiceClient) MenuType(ctx context.Context, in *MenuTypeRequest, opts ...grpc.CallOption) (*MenuTypeResponse, error) {
+	out := new(MenuTypeResponse)
+	err := c.cc.Invoke(ctx, MenuService_MenuType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) MenuAdd(ctx context.Context, in *MenuAddRequest, opts ...grpc.CallOption) (*Menu, error) {
+	out := new(Menu)
+	err := c.cc.Invoke(ctx, MenuService_MenuAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) MenuUpdate(ctx context.Context, in *MenuUpdateRequest, opts ...grpc.CallOption) (*Menu, error) {
+	out := new(Menu)
+	err := c.cc.Invoke(ctx, MenuService_MenuUpdate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) MenuDelete(ctx context.Context, in *MenuDeleteRequest, opts ...grpc.CallOption) (*MenuDeleteResponse, error) {
+	out := new(MenuDeleteResponse)
+	err := c.cc.Invoke(ctx, MenuService_MenuDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *menuServiceClient) MenuList(ctx context.Context, in *MenuListRequest, opts ...grpc.CallOption) (MenuService_MenuListClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MenuService_ServiceDesc.Streams[0], MenuService_MenuList_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &menuServiceMenuListClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MenuService_MenuListClient interface {
+	Recv() (*MenuListItem, error)
+	grpc.ClientStream
+}
+
+type menuServiceMenuListClient struct {
+	grpc.ClientStream
+}
+
+func (x *menuServiceMenuListClient) Recv() (*MenuListItem, error) {
+	m := new(MenuListItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *menuServiceClient) MenuDetail(ctx context.Context, in *MenuDetailRequest, opts ...grpc.CallOption) (*MenuDetailResponse, error) {
+	out := new(MenuDetailResponse)
+	err := c.cc.Invoke(ctx, MenuService_MenuDetail_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MenuServiceServer is the server API for MenuService service.
+// All implementations must embed UnimplementedMenuServiceServer
+// for forward compatibility
+type MenuServiceServer interface {
+	MenuType(context.Context, *MenuTypeRequest) (*MenuTypeResponse, error)
+	MenuAdd(context.Context, *MenuAddRequest) (*Menu, error)
+	MenuUpdate(context.Context, *MenuUpdateRequest) (*Menu, error)
+	MenuDelete(context.Context, *MenuDeleteRequest) (*MenuDeleteResponse, error)
+	// MenuList streams menus one by one so large lists are not held in one message
+	MenuList(*MenuListRequest, MenuService_MenuListServer) error
+	MenuDetail(context.Context, *MenuDetailRequest) (*MenuDetailResponse, error)
+	mustEmbedUnimplementedMenuServiceServer()
+}
+
+// UnimplementedMenuServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMenuServiceServer struct {
+}
+
+func (UnimplementedMenuServiceServer) MenuType(context.Context, *MenuTypeRequest) (*MenuTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MenuType not implemented")
+}
+func (UnimplementedMenuServiceServer) MenuAdd(context.Context, *MenuAddRequest) (*Menu, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MenuAdd not implemented")
+}
+func (UnimplementedMenuServiceServer) MenuUpdate(context.Context, *MenuUpdateRequest) (*Menu, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MenuUpdate not implemented")
+}
+func (UnimplementedMenuServiceServer) MenuDelete(context.Context, *MenuDeleteRequest) (*MenuDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MenuDelete not implemented")
+}
+func (UnimplementedMenuServiceServer) MenuList(*MenuListRequest, MenuService_MenuListServer) error {
+	return status.Errorf(codes.Unimplemented, "method MenuList not implemented")
+}
+func (UnimplementedMenuServiceServer) MenuDetail(context.Context, *MenuDetailRequest) (*MenuDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MenuDetail not implemented")
+}
+func (UnimplementedMenuServiceServer) mustEmbedUnimplementedMenuServiceServer() {}
+
+// UnsafeMenuServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MenuServiceServer will
+// result in compilation errors.
+type UnsafeMenuServiceServer interface {
+	mustEmbedUnimplementedMenuServiceServer()
+}
+
+func RegisterMenuServiceServer(s grpc.ServiceRegistrar, srv MenuServiceServer) {
+	s.RegisterService(&MenuService_ServiceDesc, srv)
+}
+
+func _MenuService_MenuType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).MenuType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_MenuType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).MenuType(ctx, req.(*MenuTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_MenuAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).MenuAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_MenuAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).MenuAdd(ctx, req.(*MenuAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_MenuUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).MenuUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_MenuUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).MenuUpdate(ctx, req.(*MenuUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_MenuDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).MenuDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_MenuDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).MenuDelete(ctx, req.(*MenuDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MenuService_MenuList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MenuListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MenuServiceServer).MenuList(m, &menuServiceMenuListServer{stream})
+}
+
+type MenuService_MenuListServer interface {
+	Send(*MenuListItem) error
+	grpc.ServerStream
+}
+
+type menuServiceMenuListServer struct {
+	grpc.ServerStream
+}
+
+func (x *menuServiceMenuListServer) Send(m *MenuListItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MenuService_MenuDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MenuDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MenuServiceServer).MenuDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MenuService_MenuDetail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MenuServiceServer).MenuDetail(ctx, req.(*MenuDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MenuService_ServiceDesc is the grpc.ServiceDesc for MenuService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MenuService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "foodmenu.menu.v1.MenuService",
+	HandlerType: (*MenuServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "MenuType",
+			Handler:    _MenuService_MenuType_Handler,
+		},
+		{
+			MethodName: "MenuAdd",
+			Handler:    _MenuService_MenuAdd_Handler,
+		},
+		{
+			MethodName: "MenuUpdate",
+			Handler:    _MenuService_MenuUpdate_Handler,
+		},
+		{
+			MethodName: "MenuDelete",
+			Handler:    _MenuService_MenuDelete_Handler,
+		},
+		{
+			MethodName: "MenuDetail",
+			Handler:    _MenuService_MenuDetail_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "MenuList",
+			Handler:       _MenuService_MenuList_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "menu.proto",
+}
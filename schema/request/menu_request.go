@@ -1,6 +1,19 @@
 package request
 
+import "time"
+
 type Menu struct {
+	MenuTypeId  int        `validate:"required,number" json:"menu_type_id"`
+	WartegId    string     `validate:"required" json:"warteg_id"`
+	MenuName    string     `validate:"required" json:"menu_name"`
+	MenuDetail  string     `json:"menu_detail"`
+	MenuPicture string     `json:"menu_picture"`
+	MenuPrice   int        `validate:"required,number" json:"menu_price"`
+	Status      string     `validate:"omitempty,oneof=draft published" json:"status"`
+	PublishAt   *time.Time `json:"publish_at"`
+}
+
+type MenuUpdate struct {
 	MenuTypeId  int    `validate:"required,number" json:"menu_type_id"`
 	WartegId    string `validate:"required" json:"warteg_id"`
 	MenuName    string `validate:"required" json:"menu_name"`
@@ -9,11 +22,258 @@ type Menu struct {
 	MenuPrice   int    `validate:"required,number" json:"menu_price"`
 }
 
-type MenuUpdate struct {
+type MenuImport struct {
+	WartegId string     `validate:"required" json:"warteg_id" form:"warteg_id"`
+	DryRun   bool       `json:"dry_run" form:"dry_run"`
+	Records  [][]string `json:"-" form:"-"`
+}
+
+type MenuImage struct {
+	ImageId          string
+	MenuId           string
+	Position         int
+	OriginalKey      string
+	ThumbnailKey     string
+	WebpKey          string
+	ThumbnailWebpKey string
+	ContentType      string
+	FileSize         int
+	Width            int
+	Height           int
+}
+
+type MenuImageFile struct {
+	Filename string
+	Data     []byte
+}
+
+type MenuImageOrder struct {
+	ImageIds []string `validate:"required" json:"image_ids"`
+}
+
+type MenuList struct {
+	WartegId      string
+	MenuTypeId    string
+	MenuName      string
+	AvailableOnly bool
+	// Lat and Lng limit the list to wartegs within Radius meters, nearest first
+	Lat    *float64
+	Lng    *float64
+	Radius float64
+	// OpenOnly hides menus of wartegs closed at the time of the list, they are looked up into ClosedWartegIds
+	OpenOnly        bool
+	ClosedWartegIds []string
+	// At previews the list at another time than now, menus served outside their windows then are looked up into
+	// OffScheduleMenuIds
+	At                 *time.Time
+	OffScheduleMenuIds []string
+	// Statuses lists menus of these publishing statuses, only published menus when empty
+	Statuses []string
+	// Limit and Offset page the list, the first MenuListLimit menus are listed when Limit is zero
+	Limit  int
+	Offset int
+}
+
+type MenuSearch struct {
+	Query         string
+	WartegId      string
+	MenuTypeId    string
+	AvailableOnly bool
+	Limit         int
+}
+
+type MenuSuggest struct {
+	Query    string
+	WartegId string
+	Limit    int
+}
+
+type MenuRestock struct {
+	Stock      *int `validate:"omitempty,gte=0" json:"stock"`
+	DailyStock *int `validate:"omitempty,gte=0" json:"daily_stock"`
+}
+
+type MenuConsume struct {
+	Quantity int `validate:"required,gte=1" json:"quantity"`
+}
+
+type MenuVariant struct {
+	VariantId   string `json:"-"`
+	MenuId      string `json:"-"`
+	VariantName string `validate:"required" json:"variant_name"`
+	PriceType   string `validate:"required,oneof=delta absolute" json:"price_type"`
+	Price       int    `json:"price"`
+	IsDefault   bool   `json:"is_default"`
+}
+
+type ModifierGroup struct {
+	GroupId    string           `json:"-"`
+	WartegId   string           `validate:"required" json:"warteg_id"`
+	GroupName  string           `validate:"required" json:"group_name"`
+	MinSelect  int              `validate:"gte=0" json:"min_select"`
+	MaxSelect  int              `validate:"required,gtefield=MinSelect" json:"max_select"`
+	IsRequired bool             `json:"is_required"`
+	Options    []ModifierOption `validate:"required,min=1,dive" json:"options"`
+}
+
+type ModifierOption struct {
+	OptionId    string `json:"option_id"`
+	OptionName  string `validate:"required" json:"option_name"`
+	OptionPrice int    `validate:"gte=0" json:"option_price"`
+}
+
+type MenuModifier struct {
+	GroupIds []string `validate:"required" json:"group_ids"`
+}
+
+type MenuModifierSelection struct {
+	Selections []ModifierSelection `validate:"dive" json:"selections"`
+}
+
+type ModifierSelection struct {
+	GroupId   string   `validate:"required" json:"group_id"`
+	OptionIds []string `json:"option_ids"`
+}
+
+type MenuBundle struct {
+	Items []MenuBundleItem `validate:"required,dive" json:"items"`
+}
+
+type MenuBundleItem struct {
+	MenuId   string `validate:"required" json:"menu_id"`
+	Quantity int    `validate:"required,gte=1" json:"quantity"`
+}
+
+type Promotion struct {
+	PromotionId   string     `json:"-"`
+	PromotionName string     `validate:"required" json:"promotion_name"`
+	PromotionType string     `validate:"required,oneof=percentage fixed buy_x_get_y" json:"promotion_type"`
+	Value         int        `validate:"gte=0" json:"value"`
+	BuyQuantity   int        `validate:"gte=0" json:"buy_quantity"`
+	GetQuantity   int        `validate:"gte=0" json:"get_quantity"`
+	ScopeType     string     `validate:"required,oneof=menu menu_type warteg" json:"scope_type"`
+	ScopeId       string     `validate:"required" json:"scope_id"`
+	WartegId      string     `json:"warteg_id"`
+	StartDate     time.Time  `validate:"required" json:"start_date"`
+	EndDate       *time.Time `json:"end_date"`
+	DailyStart    string     `json:"daily_start"`
+	DailyEnd      string     `json:"daily_end"`
+	DaysOfWeek    []int      `validate:"dive,gte=0,lte=6" json:"days_of_week"`
+}
+
+type MenuPriceSchedule struct {
+	MenuPrice     int       `validate:"required,number" json:"menu_price"`
+	EffectiveDate time.Time `validate:"required" json:"effective_date"`
+}
+
+type MenuPriceChangeReview struct {
+	Note string `validate:"max=255" json:"note"`
+}
+
+type MenuPriceChangeFilter struct {
+	Status   string
+	MenuId   string
+	WartegId string
+}
+
+type Brand struct {
+	BrandId   string `json:"-"`
+	BrandName string `validate:"required,max=255" json:"brand_name"`
+}
+
+type BrandMenu struct {
+	TemplateId  string `json:"-"`
+	BrandId     string `json:"-"`
 	MenuTypeId  int    `validate:"required,number" json:"menu_type_id"`
-	WartegId    string `validate:"required" json:"warteg_id"`
 	MenuName    string `validate:"required" json:"menu_name"`
 	MenuDetail  string `json:"menu_detail"`
 	MenuPicture string `json:"menu_picture"`
 	MenuPrice   int    `validate:"required,number" json:"menu_price"`
 }
+
+type BrandWarteg struct {
+	WartegId string `json:"-"`
+	BrandId  string `validate:"required" json:"brand_id"`
+}
+
+type AuditLog struct {
+	EntityType string      `json:"entity_type"`
+	EntityId   string      `json:"entity_id"`
+	MenuId     string      `json:"menu_id"`
+	WartegId   string      `json:"warteg_id"`
+	Action     string      `json:"action"`
+	Before     interface{} `json:"before"`
+	After      interface{} `json:"after"`
+}
+
+type AuditFilter struct {
+	MenuId     string
+	WartegId   string
+	Actor      string
+	EntityType string
+	From       *time.Time
+	To         *time.Time
+	BeforeId   int64
+	Limit      int
+}
+
+type Webhook struct {
+	WebhookId  string   `json:"-"`
+	WartegId   string   `validate:"required" json:"warteg_id"`
+	Url        string   `validate:"required,url" json:"url"`
+	EventTypes []string `validate:"dive,oneof=menu.created menu.updated menu.deleted menu.price_change_requested menu.price_change_approved menu.price_change_rejected" json:"event_types"`
+	Secret     string   `json:"secret"`
+	IsActive   *bool    `json:"is_active"`
+}
+
+type WartegLocation struct {
+	WartegId  string   `json:"-"`
+	Latitude  *float64 `validate:"required,gte=-90,lte=90" json:"latitude"`
+	Longitude *float64 `validate:"required,gte=-180,lte=180" json:"longitude"`
+}
+
+type WartegHours struct {
+	WartegId string          `json:"-"`
+	Timezone string          `validate:"required" json:"timezone"`
+	Hours    []WartegHour    `validate:"dive" json:"hours"`
+	Closures []WartegClosure `validate:"dive" json:"closures"`
+}
+
+type WartegHour struct {
+	Weekday int    `validate:"gte=0,lte=6" json:"weekday"`
+	Open    string `validate:"required" json:"open"`
+	Close   string `validate:"required" json:"close"`
+}
+
+type WartegClosure struct {
+	ClosureDate string `validate:"required" json:"closure_date"`
+	Note        string `json:"note"`
+}
+
+type MenuStatus struct {
+	MenuId    string     `json:"-"`
+	Status    string     `validate:"required,oneof=draft published archived" json:"status"`
+	PublishAt *time.Time `json:"publish_at"`
+}
+
+type MenuWindows struct {
+	Windows []MenuWindow `validate:"dive" json:"windows"`
+}
+
+type MenuWindow struct {
+	Weekdays []int  `validate:"required,min=1,dive,gte=0,lte=6" json:"weekdays"`
+	Start    string `validate:"required" json:"start"`
+	End      string `validate:"required" json:"end"`
+}
+
+type WebhookDeliveryFilter struct {
+	Status   string
+	BeforeId int64
+	Limit    int
+}
+
+type Graphql struct {
+	Query         string                 `validate:"required" json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
@@ -1,17 +1,26 @@
 package response
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type MenuType struct {
-	MenuTypeId   int    `json:"menu_type_id"`
-	MenuTypeName string `json:"menu_type_name"`
+	MenuTypeId   int       `json:"menu_type_id"`
+	MenuTypeName string    `json:"menu_type_name"`
+	UpdatedDate  time.Time `json:"updated_date"`
 }
 
 type MenuAdd struct {
-	MenuTypeId  int    `json:"menu_type_id"`
-	WartegId    string `json:"warteg_id"`
-	MenuName    string `json:"menu_name"`
-	MenuDetail  string `json:"menu_detail"`
-	MenuPicture string `json:"menu_picture"`
-	MenuPrice   int    `json:"menu_price"`
+	MenuId      string     `json:"menu_id"`
+	MenuTypeId  int        `json:"menu_type_id"`
+	WartegId    string     `json:"warteg_id"`
+	MenuName    string     `json:"menu_name"`
+	MenuDetail  string     `json:"menu_detail"`
+	MenuPicture string     `json:"menu_picture"`
+	MenuPrice   int        `json:"menu_price"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at"`
 }
 
 type MenuDelete struct {
@@ -19,29 +28,492 @@ type MenuDelete struct {
 }
 
 type MenuUpdate struct {
-	MenuId      string `json:"menu_id"`
-	MenuTypeId  int    `json:"menu_type_id"`
-	WartegId    string `json:"warteg_id"`
-	MenuName    string `json:"menu_name"`
-	MenuDetail  string `json:"menu_detail"`
-	MenuPicture string `json:"menu_picture"`
-	MenuPrice   int    `json:"menu_price"`
+	MenuId      string           `json:"menu_id"`
+	MenuTypeId  int              `json:"menu_type_id"`
+	WartegId    string           `json:"warteg_id"`
+	MenuName    string           `json:"menu_name"`
+	MenuDetail  string           `json:"menu_detail"`
+	MenuPicture string           `json:"menu_picture"`
+	MenuPrice   int              `json:"menu_price"`
+	PriceChange *MenuPriceChange `json:"price_change,omitempty"`
 }
 
 type MenuList struct {
-	MenuId       string `json:"menu_id"`
-	MenuTypeName string `json:"menu_type_name"`
-	WartegId     string `json:"warteg_id"`
-	MenuName     string `json:"menu_name"`
-	MenuPrice    int    `json:"menu_price"`
+	MenuId         string            `json:"menu_id"`
+	MenuTypeId     int               `json:"menu_type_id"`
+	MenuTypeName   string            `json:"menu_type_name"`
+	WartegId       string            `json:"warteg_id"`
+	MenuName       string            `json:"menu_name"`
+	MenuPrice      int               `json:"menu_price"`
+	EffectivePrice int               `json:"effective_price"`
+	Promotion      *AppliedPromotion `json:"promotion"`
+	MinPrice       int               `json:"min_price"`
+	MaxPrice       int               `json:"max_price"`
+	IsSoldOut      bool              `json:"is_sold_out"`
+	Stock          *int              `json:"stock"`
+	IsBundle       bool              `json:"is_bundle"`
+	Distance       *int              `json:"distance"`
+	Status         string            `json:"status"`
+	PublishAt      *time.Time        `json:"publish_at,omitempty"`
+	UpdatedDate    time.Time         `json:"updated_date"`
 }
 
 type MenuDetail struct {
-	MenuId       string `json:"menu_id"`
-	MenuTypeName string `json:"menu_type_name"`
-	WartegId     string `json:"warteg_id"`
-	MenuName     string `json:"menu_name"`
-	MenuDetail   string `json:"menu_detail"`
-	MenuPicture  string `json:"menu_picture"`
-	MenuPrice    int    `json:"menu_price"`
+	MenuId         string            `json:"menu_id"`
+	MenuTypeId     int               `json:"menu_type_id"`
+	MenuTypeName   string            `json:"menu_type_name"`
+	WartegId       string            `json:"warteg_id"`
+	MenuName       string            `json:"menu_name"`
+	MenuDetail     string            `json:"menu_detail"`
+	MenuPicture    string            `json:"menu_picture"`
+	MenuPrice      int               `json:"menu_price"`
+	EffectivePrice int               `json:"effective_price"`
+	Promotion      *AppliedPromotion `json:"promotion"`
+	IsSoldOut      bool              `json:"is_sold_out"`
+	Stock          *int              `json:"stock"`
+	IsBundle       bool              `json:"is_bundle"`
+	IsOffSchedule  bool              `json:"is_off_schedule"`
+	Status         string            `json:"status,omitempty"`
+	PublishAt      *time.Time        `json:"publish_at,omitempty"`
+	UpdatedDate    time.Time         `json:"updated_date"`
+	Images         []MenuImage       `json:"images,omitempty"`
+	Variants       []MenuVariant     `json:"variants,omitempty"`
+	Modifiers      []ModifierGroup   `json:"modifiers,omitempty"`
+	Bundle         *MenuBundle       `json:"bundle,omitempty"`
+	Windows        []MenuWindow      `json:"windows,omitempty"`
+}
+
+type MenuSearch struct {
+	MenuId         string            `json:"menu_id"`
+	MenuTypeId     int               `json:"menu_type_id"`
+	MenuTypeName   string            `json:"menu_type_name"`
+	WartegId       string            `json:"warteg_id"`
+	MenuName       string            `json:"menu_name"`
+	MenuPrice      int               `json:"menu_price"`
+	EffectivePrice int               `json:"effective_price"`
+	Promotion      *AppliedPromotion `json:"promotion"`
+	IsSoldOut      bool              `json:"is_sold_out"`
+	Score          float64           `json:"score"`
+	Highlights     map[string]string `json:"highlights"`
+}
+
+type MenuSuggest struct {
+	Text      string `json:"text"`
+	Type      string `json:"type"`
+	Highlight string `json:"highlight"`
+	MenuCount int    `json:"menu_count"`
+}
+
+type MenuChange struct {
+	ChangeId    int64       `json:"change_id"`
+	MenuId      string      `json:"menu_id"`
+	WartegId    string      `json:"warteg_id"`
+	ChangeType  string      `json:"change_type"`
+	ChangedDate time.Time   `json:"changed_date"`
+	Menu        *MenuDetail `json:"menu"`
+}
+
+type MenuTombstone struct {
+	MenuId      string    `json:"menu_id"`
+	WartegId    string    `json:"warteg_id"`
+	DeletedDate time.Time `json:"deleted_date"`
+}
+
+type MenuChanges struct {
+	Created   []MenuDetail    `json:"created"`
+	Updated   []MenuDetail    `json:"updated"`
+	Deleted   []MenuTombstone `json:"deleted"`
+	SyncToken string          `json:"sync_token"`
+	HasMore   bool            `json:"has_more"`
+}
+
+type MenuImport struct {
+	DryRun  bool            `json:"dry_run"`
+	Applied bool            `json:"applied"`
+	Total   int             `json:"total"`
+	Valid   int             `json:"valid"`
+	Invalid int             `json:"invalid"`
+	Rows    []MenuImportRow `json:"rows"`
+}
+
+type MenuImportRow struct {
+	Row      int      `json:"row"`
+	MenuId   string   `json:"menu_id,omitempty"`
+	MenuName string   `json:"menu_name"`
+	Status   string   `json:"status"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+type MenuExport struct {
+	MenuId         string    `json:"menu_id"`
+	MenuTypeId     int       `json:"menu_type_id"`
+	MenuTypeName   string    `json:"menu_type_name"`
+	WartegId       string    `json:"warteg_id"`
+	MenuName       string    `json:"menu_name"`
+	MenuDetail     string    `json:"menu_detail"`
+	MenuPicture    string    `json:"menu_picture"`
+	MenuPrice      int       `json:"menu_price"`
+	EffectivePrice int       `json:"effective_price"`
+	UpdatedDate    time.Time `json:"updated_date"`
+}
+
+type MenuImage struct {
+	ImageId          string    `json:"image_id"`
+	MenuId           string    `json:"menu_id"`
+	Position         int       `json:"position"`
+	Url              string    `json:"url"`
+	ThumbnailUrl     string    `json:"thumbnail_url"`
+	WebpUrl          string    `json:"webp_url"`
+	ThumbnailWebpUrl string    `json:"thumbnail_webp_url"`
+	ContentType      string    `json:"content_type"`
+	FileSize         int       `json:"file_size"`
+	Width            int       `json:"width"`
+	Height           int       `json:"height"`
+	CreatedDate      time.Time `json:"created_date"`
+	OriginalKey      string    `json:"-"`
+	ThumbnailKey     string    `json:"-"`
+	WebpKey          string    `json:"-"`
+	ThumbnailWebpKey string    `json:"-"`
+}
+
+type MenuAvailability struct {
+	MenuId       string    `json:"menu_id"`
+	BusinessDate string    `json:"business_date"`
+	IsSoldOut    bool      `json:"is_sold_out"`
+	Stock        *int      `json:"stock"`
+	DailyStock   *int      `json:"daily_stock"`
+	UpdatedDate  time.Time `json:"updated_date"`
+}
+
+type MenuVariant struct {
+	VariantId      string    `json:"variant_id"`
+	MenuId         string    `json:"menu_id"`
+	VariantName    string    `json:"variant_name"`
+	PriceType      string    `json:"price_type"`
+	Price          int       `json:"price"`
+	VariantPrice   int       `json:"variant_price"`
+	EffectivePrice int       `json:"effective_price"`
+	IsDefault      bool      `json:"is_default"`
+	UpdatedDate    time.Time `json:"updated_date"`
+}
+
+type ModifierGroup struct {
+	GroupId     string           `json:"group_id"`
+	WartegId    string           `json:"warteg_id"`
+	GroupName   string           `json:"group_name"`
+	MinSelect   int              `json:"min_select"`
+	MaxSelect   int              `json:"max_select"`
+	IsRequired  bool             `json:"is_required"`
+	Options     []ModifierOption `json:"options"`
+	UpdatedDate time.Time        `json:"updated_date"`
+}
+
+type ModifierOption struct {
+	OptionId    string `json:"option_id"`
+	OptionName  string `json:"option_name"`
+	OptionPrice int    `json:"option_price"`
+}
+
+type MenuModifierSelection struct {
+	MenuId        string                    `json:"menu_id"`
+	Valid         bool                      `json:"valid"`
+	ModifierPrice int                       `json:"modifier_price"`
+	Selections    []ModifierSelectionResult `json:"selections"`
+	Errors        []string                  `json:"errors,omitempty"`
+}
+
+type ModifierSelectionResult struct {
+	GroupId   string           `json:"group_id"`
+	GroupName string           `json:"group_name"`
+	Options   []ModifierOption `json:"options"`
+}
+
+type MenuBundle struct {
+	MenuId        string           `json:"menu_id"`
+	BundlePrice   int              `json:"bundle_price"`
+	ALaCartePrice int              `json:"a_la_carte_price"`
+	Savings       int              `json:"savings"`
+	IsSoldOut     bool             `json:"is_sold_out"`
+	Items         []MenuBundleItem `json:"items"`
+}
+
+type MenuBundleItem struct {
+	MenuId    string `json:"menu_id"`
+	MenuName  string `json:"menu_name"`
+	MenuPrice int    `json:"menu_price"`
+	Quantity  int    `json:"quantity"`
+	Subtotal  int    `json:"subtotal"`
+	IsSoldOut bool   `json:"is_sold_out"`
+}
+
+type Promotion struct {
+	PromotionId   string     `json:"promotion_id"`
+	PromotionName string     `json:"promotion_name"`
+	PromotionType string     `json:"promotion_type"`
+	Value         int        `json:"value"`
+	BuyQuantity   int        `json:"buy_quantity"`
+	GetQuantity   int        `json:"get_quantity"`
+	ScopeType     string     `json:"scope_type"`
+	ScopeId       string     `json:"scope_id"`
+	WartegId      string     `json:"warteg_id"`
+	StartDate     time.Time  `json:"start_date"`
+	EndDate       *time.Time `json:"end_date"`
+	DailyStart    string     `json:"daily_start"`
+	DailyEnd      string     `json:"daily_end"`
+	DaysOfWeek    []int      `json:"days_of_week"`
+	UpdatedDate   time.Time  `json:"updated_date"`
+}
+
+type AppliedPromotion struct {
+	PromotionId   string     `json:"promotion_id"`
+	PromotionName string     `json:"promotion_name"`
+	PromotionType string     `json:"promotion_type"`
+	Value         int        `json:"value"`
+	BuyQuantity   int        `json:"buy_quantity"`
+	GetQuantity   int        `json:"get_quantity"`
+	Discount      int        `json:"discount"`
+	EndsAt        *time.Time `json:"ends_at"`
+}
+
+type MenuPriceHistory struct {
+	HistoryId   int64     `json:"history_id"`
+	MenuId      string    `json:"menu_id"`
+	OldPrice    *int      `json:"old_price"`
+	NewPrice    int       `json:"new_price"`
+	ScheduleId  *string   `json:"schedule_id"`
+	ChangedBy   string    `json:"changed_by"`
+	ChangedDate time.Time `json:"changed_date"`
+}
+
+type MenuPriceSchedule struct {
+	ScheduleId    string     `json:"schedule_id"`
+	MenuId        string     `json:"menu_id"`
+	MenuPrice     int        `json:"menu_price"`
+	EffectiveDate time.Time  `json:"effective_date"`
+	Status        string     `json:"status"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedDate   time.Time  `json:"created_date"`
+	AppliedDate   *time.Time `json:"applied_date"`
+	ChangeId      *string    `json:"change_id"`
+}
+
+type MenuPriceChange struct {
+	ChangeId      string     `json:"change_id"`
+	MenuId        string     `json:"menu_id"`
+	WartegId      string     `json:"warteg_id"`
+	OldPrice      int        `json:"old_price"`
+	NewPrice      int        `json:"new_price"`
+	Status        string     `json:"status"`
+	RequestedBy   string     `json:"requested_by"`
+	RequestedDate time.Time  `json:"requested_date"`
+	ReviewedBy    *string    `json:"reviewed_by"`
+	ReviewNote    string     `json:"review_note"`
+	ReviewedDate  *time.Time `json:"reviewed_date"`
+}
+
+type Brand struct {
+	BrandId     string    `json:"brand_id"`
+	BrandName   string    `json:"brand_name"`
+	Templates   int       `json:"templates"`
+	Branches    int       `json:"branches"`
+	UpdatedDate time.Time `json:"updated_date"`
+}
+
+type BrandMenu struct {
+	TemplateId  string            `json:"template_id"`
+	BrandId     string            `json:"brand_id"`
+	MenuTypeId  int               `json:"menu_type_id"`
+	MenuName    string            `json:"menu_name"`
+	MenuDetail  string            `json:"menu_detail"`
+	MenuPicture string            `json:"menu_picture"`
+	MenuPrice   int               `json:"menu_price"`
+	UpdatedDate time.Time         `json:"updated_date"`
+	Propagation *BrandPropagation `json:"propagation,omitempty"`
+}
+
+type BrandWarteg struct {
+	WartegId     string            `json:"warteg_id"`
+	BrandId      string            `json:"brand_id"`
+	AssignedDate time.Time         `json:"assigned_date"`
+	Propagation  *BrandPropagation `json:"propagation,omitempty"`
+}
+
+// BrandBranchMenu is a template of a brand with the menu created from it at a branch, menu is nil when the branch
+// has no menu of the template
+type BrandBranchMenu struct {
+	WartegId    string
+	Template    BrandMenu
+	Menu        *MenuUpdate
+	SyncedPrice int
+	Status      string
+}
+
+type BrandPropagation struct {
+	BrandId        string          `json:"brand_id"`
+	Created        int             `json:"created"`
+	Updated        int             `json:"updated"`
+	PriceOverrides int             `json:"price_overrides"`
+	PriceChanges   int             `json:"price_changes"`
+	PricePending   int             `json:"price_pending"`
+	Menus          []BrandMenuSync `json:"menus"`
+}
+
+type BrandMenuSync struct {
+	TemplateId  string           `json:"template_id"`
+	WartegId    string           `json:"warteg_id"`
+	MenuId      string           `json:"menu_id"`
+	Action      string           `json:"action"`
+	PriceKept   bool             `json:"price_kept"`
+	PriceChange *MenuPriceChange `json:"price_change,omitempty"`
+	Before      *MenuUpdate      `json:"-"`
+	After       MenuUpdate       `json:"-"`
+}
+
+type BrandDivergence struct {
+	BrandId   string             `json:"brand_id"`
+	Templates int                `json:"templates"`
+	Branches  []BranchDivergence `json:"branches"`
+}
+
+type BranchDivergence struct {
+	WartegId string           `json:"warteg_id"`
+	InSync   int              `json:"in_sync"`
+	Diverged []MenuDivergence `json:"diverged"`
+}
+
+type MenuDivergence struct {
+	TemplateId    string   `json:"template_id"`
+	MenuId        *string  `json:"menu_id"`
+	MenuName      string   `json:"menu_name"`
+	Fields        []string `json:"fields"`
+	TemplatePrice int      `json:"template_price"`
+	BranchPrice   *int     `json:"branch_price"`
+	Status        string   `json:"status"`
+}
+
+type MenuPrices struct {
+	MenuId    string              `json:"menu_id"`
+	MenuPrice int                 `json:"menu_price"`
+	Scheduled []MenuPriceSchedule `json:"scheduled"`
+	History   []MenuPriceHistory  `json:"history"`
+}
+
+type AuditLog struct {
+	AuditId     int64           `json:"audit_id"`
+	EntityType  string          `json:"entity_type"`
+	EntityId    string          `json:"entity_id"`
+	MenuId      string          `json:"menu_id"`
+	WartegId    string          `json:"warteg_id"`
+	Action      string          `json:"action"`
+	Actor       string          `json:"actor"`
+	RequestId   string          `json:"request_id"`
+	SourceIp    string          `json:"source_ip"`
+	Before      json.RawMessage `json:"before"`
+	After       json.RawMessage `json:"after"`
+	CreatedDate time.Time       `json:"created_date"`
+}
+
+type OutboxEvent struct {
+	OutboxId        int64           `json:"outbox_id"`
+	EventId         string          `json:"event_id"`
+	EventType       string          `json:"event_type"`
+	MenuId          string          `json:"menu_id"`
+	WartegId        string          `json:"warteg_id"`
+	Payload         json.RawMessage `json:"payload"`
+	Attempts        int             `json:"attempts"`
+	NextAttemptDate time.Time       `json:"next_attempt_date"`
+	CreatedDate     time.Time       `json:"created_date"`
+}
+
+type Webhook struct {
+	WebhookId   string    `json:"webhook_id"`
+	WartegId    string    `json:"warteg_id"`
+	Url         string    `json:"url"`
+	EventTypes  []string  `json:"event_types"`
+	Secret      string    `json:"secret,omitempty"`
+	IsActive    bool      `json:"is_active"`
+	CreatedDate time.Time `json:"created_date"`
+	UpdatedDate time.Time `json:"updated_date"`
+}
+
+type WartegLocation struct {
+	WartegId    string    `json:"warteg_id"`
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	UpdatedDate time.Time `json:"updated_date"`
+}
+
+type WartegHours struct {
+	WartegId    string          `json:"warteg_id"`
+	Timezone    string          `json:"timezone"`
+	Hours       []WartegHour    `json:"hours"`
+	Closures    []WartegClosure `json:"closures"`
+	OpenNow     bool            `json:"open_now"`
+	NextOpening *time.Time      `json:"next_opening"`
+	UpdatedDate time.Time       `json:"updated_date"`
+}
+
+type WartegHour struct {
+	Weekday int    `json:"weekday"`
+	Open    string `json:"open"`
+	Close   string `json:"close"`
+}
+
+type WartegClosure struct {
+	ClosureDate string `json:"closure_date"`
+	Note        string `json:"note"`
+}
+
+type MenuStatus struct {
+	MenuId      string     `json:"menu_id"`
+	WartegId    string     `json:"warteg_id"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UpdatedBy   string     `json:"updated_by"`
+	UpdatedDate *time.Time `json:"updated_date"`
+}
+
+type MenuWindows struct {
+	MenuId   string       `json:"menu_id"`
+	WartegId string       `json:"warteg_id"`
+	Timezone string       `json:"timezone"`
+	Windows  []MenuWindow `json:"windows"`
+}
+
+type MenuWindow struct {
+	Weekdays []int  `json:"weekdays"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+}
+
+type WebhookDelivery struct {
+	DeliveryId      int64           `json:"delivery_id"`
+	WebhookId       string          `json:"webhook_id"`
+	EventId         string          `json:"event_id"`
+	EventType       string          `json:"event_type"`
+	MenuId          string          `json:"menu_id"`
+	Payload         json.RawMessage `json:"payload"`
+	OccurredDate    time.Time       `json:"occurred_date"`
+	Status          string          `json:"status"`
+	Attempts        int             `json:"attempts"`
+	NextAttemptDate time.Time       `json:"next_attempt_date"`
+	ResponseCode    int             `json:"response_code"`
+	LastError       string          `json:"last_error"`
+	CreatedDate     time.Time       `json:"created_date"`
+	UpdatedDate     time.Time       `json:"updated_date"`
+}
+
+type MenuStreamEvent struct {
+	EventId     string      `json:"event_id"`
+	EventType   string      `json:"event_type"`
+	MenuId      string      `json:"menu_id,omitempty"`
+	WartegId    string      `json:"warteg_id,omitempty"`
+	Menu        *MenuDetail `json:"menu,omitempty"`
+	ChangedDate *time.Time  `json:"changed_date,omitempty"`
+}
+
+type MenuStream struct {
+	Events      []MenuStreamEvent `json:"events"`
+	LastEventId string            `json:"last_event_id"`
+	HasMore     bool              `json:"has_more"`
 }
@@ -1,13 +1,16 @@
 package response
 
+import "time"
+
 type SwaggerMenuType struct {
 	Base
 	Data []DataMenuType `json:"data"`
 }
 
 type DataMenuType struct {
-	MenuTypeId   int    `json:"menu_type_id"`
-	MenuTypeName string `json:"menu_type_name"`
+	MenuTypeId   int       `json:"menu_type_id"`
+	MenuTypeName string    `json:"menu_type_name"`
+	UpdatedDate  time.Time `json:"updated_date"`
 }
 
 type SwaggerMenuAdd struct {
@@ -16,12 +19,15 @@ type SwaggerMenuAdd struct {
 }
 
 type DataMenu struct {
-	MenuTypeId  int    `json:"menu_type_id"`
-	WartegId    string `json:"warteg_id"`
-	MenuName    string `json:"menu_name"`
-	MenuDetail  string `json:"menu_detail"`
-	MenuPicture string `json:"menu_picture"`
-	MenuPrice   int    `json:"menu_price"`
+	MenuId      string     `json:"menu_id"`
+	MenuTypeId  int        `json:"menu_type_id"`
+	WartegId    string     `json:"warteg_id"`
+	MenuName    string     `json:"menu_name"`
+	MenuDetail  string     `json:"menu_detail"`
+	MenuPicture string     `json:"menu_picture"`
+	MenuPrice   int        `json:"menu_price"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at"`
 }
 
 type SwaggerMenuDetail struct {
@@ -30,13 +36,28 @@ type SwaggerMenuDetail struct {
 }
 
 type DataMenuDetail struct {
-	MenuId       string `json:"menu_id"`
-	MenuTypeName string `json:"menu_type_name"`
-	WartegId     string `json:"warteg_id"`
-	MenuName     string `json:"menu_name"`
-	MenuDetail   string `json:"menu_detail"`
-	MenuPicture  string `json:"menu_picture"`
-	MenuPrice    int    `json:"menu_price"`
+	MenuId         string                `json:"menu_id"`
+	MenuTypeId     int                   `json:"menu_type_id"`
+	MenuTypeName   string                `json:"menu_type_name"`
+	WartegId       string                `json:"warteg_id"`
+	MenuName       string                `json:"menu_name"`
+	MenuDetail     string                `json:"menu_detail"`
+	MenuPicture    string                `json:"menu_picture"`
+	MenuPrice      int                   `json:"menu_price"`
+	EffectivePrice int                   `json:"effective_price"`
+	Promotion      *DataAppliedPromotion `json:"promotion"`
+	IsSoldOut      bool                  `json:"is_sold_out"`
+	Stock          *int                  `json:"stock"`
+	IsBundle       bool                  `json:"is_bundle"`
+	IsOffSchedule  bool                  `json:"is_off_schedule"`
+	Status         string                `json:"status"`
+	PublishAt      *time.Time            `json:"publish_at"`
+	UpdatedDate    time.Time             `json:"updated_date"`
+	Images         []DataMenuImage       `json:"images"`
+	Variants       []DataMenuVariant     `json:"variants"`
+	Modifiers      []DataModifierGroup   `json:"modifiers"`
+	Bundle         *DataMenuBundle       `json:"bundle"`
+	Windows        []DataMenuWindow      `json:"windows"`
 }
 
 type SwaggerMenuList struct {
@@ -45,9 +66,576 @@ type SwaggerMenuList struct {
 }
 
 type DataMenuList struct {
-	MenuId       string `json:"menu_id"`
-	MenuTypeName string `json:"menu_type_name"`
-	WartegId     string `json:"warteg_id"`
-	MenuName     string `json:"menu_name"`
-	MenuPrice    int    `json:"menu_price"`
+	MenuId         string                `json:"menu_id"`
+	MenuTypeId     int                   `json:"menu_type_id"`
+	MenuTypeName   string                `json:"menu_type_name"`
+	WartegId       string                `json:"warteg_id"`
+	MenuName       string                `json:"menu_name"`
+	MenuPrice      int                   `json:"menu_price"`
+	EffectivePrice int                   `json:"effective_price"`
+	Promotion      *DataAppliedPromotion `json:"promotion"`
+	MinPrice       int                   `json:"min_price"`
+	MaxPrice       int                   `json:"max_price"`
+	IsSoldOut      bool                  `json:"is_sold_out"`
+	Stock          *int                  `json:"stock"`
+	IsBundle       bool                  `json:"is_bundle"`
+	Distance       *int                  `json:"distance"`
+	Status         string                `json:"status"`
+	PublishAt      *time.Time            `json:"publish_at"`
+	UpdatedDate    time.Time             `json:"updated_date"`
+}
+
+type SwaggerMenuSearch struct {
+	Base
+	Data []DataMenuSearch `json:"data"`
+}
+
+type DataMenuSearch struct {
+	MenuId         string                `json:"menu_id"`
+	MenuTypeId     int                   `json:"menu_type_id"`
+	MenuTypeName   string                `json:"menu_type_name"`
+	WartegId       string                `json:"warteg_id"`
+	MenuName       string                `json:"menu_name"`
+	MenuPrice      int                   `json:"menu_price"`
+	EffectivePrice int                   `json:"effective_price"`
+	Promotion      *DataAppliedPromotion `json:"promotion"`
+	IsSoldOut      bool                  `json:"is_sold_out"`
+	Score          float64               `json:"score"`
+	Highlights     map[string]string     `json:"highlights"`
+}
+
+type SwaggerMenuSuggest struct {
+	Base
+	Data []DataMenuSuggest `json:"data"`
+}
+
+type DataMenuSuggest struct {
+	Text      string `json:"text"`
+	Type      string `json:"type"`
+	Highlight string `json:"highlight"`
+	MenuCount int    `json:"menu_count"`
+}
+
+type SwaggerMenuChanges struct {
+	Base
+	Data DataMenuChanges `json:"data"`
+}
+
+type DataMenuChanges struct {
+	Created   []DataMenuDetail    `json:"created"`
+	Updated   []DataMenuDetail    `json:"updated"`
+	Deleted   []DataMenuTombstone `json:"deleted"`
+	SyncToken string              `json:"sync_token"`
+	HasMore   bool                `json:"has_more"`
+}
+
+type DataMenuTombstone struct {
+	MenuId      string    `json:"menu_id"`
+	WartegId    string    `json:"warteg_id"`
+	DeletedDate time.Time `json:"deleted_date"`
+}
+
+type SwaggerMenuImport struct {
+	Base
+	Data DataMenuImport `json:"data"`
+}
+
+type DataMenuImport struct {
+	DryRun  bool                `json:"dry_run"`
+	Applied bool                `json:"applied"`
+	Total   int                 `json:"total"`
+	Valid   int                 `json:"valid"`
+	Invalid int                 `json:"invalid"`
+	Rows    []DataMenuImportRow `json:"rows"`
+}
+
+type DataMenuImportRow struct {
+	Row      int      `json:"row"`
+	MenuId   string   `json:"menu_id"`
+	MenuName string   `json:"menu_name"`
+	Status   string   `json:"status"`
+	Errors   []string `json:"errors"`
+}
+
+type SwaggerMenuImage struct {
+	Base
+	Data []DataMenuImage `json:"data"`
+}
+
+type DataMenuImage struct {
+	ImageId          string    `json:"image_id"`
+	MenuId           string    `json:"menu_id"`
+	Position         int       `json:"position"`
+	Url              string    `json:"url"`
+	ThumbnailUrl     string    `json:"thumbnail_url"`
+	WebpUrl          string    `json:"webp_url"`
+	ThumbnailWebpUrl string    `json:"thumbnail_webp_url"`
+	ContentType      string    `json:"content_type"`
+	FileSize         int       `json:"file_size"`
+	Width            int       `json:"width"`
+	Height           int       `json:"height"`
+	CreatedDate      time.Time `json:"created_date"`
+}
+
+type SwaggerMenuAvailability struct {
+	Base
+	Data DataMenuAvailability `json:"data"`
+}
+
+type DataMenuAvailability struct {
+	MenuId       string    `json:"menu_id"`
+	BusinessDate string    `json:"business_date"`
+	IsSoldOut    bool      `json:"is_sold_out"`
+	Stock        *int      `json:"stock"`
+	DailyStock   *int      `json:"daily_stock"`
+	UpdatedDate  time.Time `json:"updated_date"`
+}
+
+type SwaggerMenuVariant struct {
+	Base
+	Data DataMenuVariant `json:"data"`
+}
+
+type SwaggerMenuVariants struct {
+	Base
+	Data []DataMenuVariant `json:"data"`
+}
+
+type DataMenuVariant struct {
+	VariantId      string    `json:"variant_id"`
+	MenuId         string    `json:"menu_id"`
+	VariantName    string    `json:"variant_name"`
+	PriceType      string    `json:"price_type"`
+	Price          int       `json:"price"`
+	VariantPrice   int       `json:"variant_price"`
+	EffectivePrice int       `json:"effective_price"`
+	IsDefault      bool      `json:"is_default"`
+	UpdatedDate    time.Time `json:"updated_date"`
+}
+
+type SwaggerModifierGroup struct {
+	Base
+	Data DataModifierGroup `json:"data"`
+}
+
+type SwaggerModifierGroups struct {
+	Base
+	Data []DataModifierGroup `json:"data"`
+}
+
+type DataModifierGroup struct {
+	GroupId     string               `json:"group_id"`
+	WartegId    string               `json:"warteg_id"`
+	GroupName   string               `json:"group_name"`
+	MinSelect   int                  `json:"min_select"`
+	MaxSelect   int                  `json:"max_select"`
+	IsRequired  bool                 `json:"is_required"`
+	Options     []DataModifierOption `json:"options"`
+	UpdatedDate time.Time            `json:"updated_date"`
+}
+
+type DataModifierOption struct {
+	OptionId    string `json:"option_id"`
+	OptionName  string `json:"option_name"`
+	OptionPrice int    `json:"option_price"`
+}
+
+type SwaggerMenuModifierSelection struct {
+	Base
+	Data DataMenuModifierSelection `json:"data"`
+}
+
+type DataMenuModifierSelection struct {
+	MenuId        string                        `json:"menu_id"`
+	Valid         bool                          `json:"valid"`
+	ModifierPrice int                           `json:"modifier_price"`
+	Selections    []DataModifierSelectionResult `json:"selections"`
+	Errors        []string                      `json:"errors"`
+}
+
+type DataModifierSelectionResult struct {
+	GroupId   string               `json:"group_id"`
+	GroupName string               `json:"group_name"`
+	Options   []DataModifierOption `json:"options"`
+}
+
+type SwaggerMenuBundle struct {
+	Base
+	Data DataMenuBundle `json:"data"`
+}
+
+type DataMenuBundle struct {
+	MenuId        string               `json:"menu_id"`
+	BundlePrice   int                  `json:"bundle_price"`
+	ALaCartePrice int                  `json:"a_la_carte_price"`
+	Savings       int                  `json:"savings"`
+	IsSoldOut     bool                 `json:"is_sold_out"`
+	Items         []DataMenuBundleItem `json:"items"`
+}
+
+type DataMenuBundleItem struct {
+	MenuId    string `json:"menu_id"`
+	MenuName  string `json:"menu_name"`
+	MenuPrice int    `json:"menu_price"`
+	Quantity  int    `json:"quantity"`
+	Subtotal  int    `json:"subtotal"`
+	IsSoldOut bool   `json:"is_sold_out"`
+}
+
+type SwaggerPromotion struct {
+	Base
+	Data DataPromotion `json:"data"`
+}
+
+type SwaggerPromotions struct {
+	Base
+	Data []DataPromotion `json:"data"`
+}
+
+type DataPromotion struct {
+	PromotionId   string     `json:"promotion_id"`
+	PromotionName string     `json:"promotion_name"`
+	PromotionType string     `json:"promotion_type"`
+	Value         int        `json:"value"`
+	BuyQuantity   int        `json:"buy_quantity"`
+	GetQuantity   int        `json:"get_quantity"`
+	ScopeType     string     `json:"scope_type"`
+	ScopeId       string     `json:"scope_id"`
+	WartegId      string     `json:"warteg_id"`
+	StartDate     time.Time  `json:"start_date"`
+	EndDate       *time.Time `json:"end_date"`
+	DailyStart    string     `json:"daily_start"`
+	DailyEnd      string     `json:"daily_end"`
+	DaysOfWeek    []int      `json:"days_of_week"`
+	UpdatedDate   time.Time  `json:"updated_date"`
+}
+
+type DataAppliedPromotion struct {
+	PromotionId   string     `json:"promotion_id"`
+	PromotionName string     `json:"promotion_name"`
+	PromotionType string     `json:"promotion_type"`
+	Value         int        `json:"value"`
+	BuyQuantity   int        `json:"buy_quantity"`
+	GetQuantity   int        `json:"get_quantity"`
+	Discount      int        `json:"discount"`
+	EndsAt        *time.Time `json:"ends_at"`
+}
+
+type SwaggerMenuPrices struct {
+	Base
+	Data DataMenuPrices `json:"data"`
+}
+
+type DataMenuPrices struct {
+	MenuId    string                  `json:"menu_id"`
+	MenuPrice int                     `json:"menu_price"`
+	Scheduled []DataMenuPriceSchedule `json:"scheduled"`
+	History   []DataMenuPriceHistory  `json:"history"`
+}
+
+type SwaggerMenuPriceSchedule struct {
+	Base
+	Data DataMenuPriceSchedule `json:"data"`
+}
+
+type DataMenuPriceSchedule struct {
+	ScheduleId    string     `json:"schedule_id"`
+	MenuId        string     `json:"menu_id"`
+	MenuPrice     int        `json:"menu_price"`
+	EffectiveDate time.Time  `json:"effective_date"`
+	Status        string     `json:"status"`
+	CreatedBy     string     `json:"created_by"`
+	CreatedDate   time.Time  `json:"created_date"`
+	AppliedDate   *time.Time `json:"applied_date"`
+	ChangeId      *string    `json:"change_id"`
+}
+
+type DataMenuPriceHistory struct {
+	HistoryId   int64     `json:"history_id"`
+	MenuId      string    `json:"menu_id"`
+	OldPrice    *int      `json:"old_price"`
+	NewPrice    int       `json:"new_price"`
+	ScheduleId  *string   `json:"schedule_id"`
+	ChangedBy   string    `json:"changed_by"`
+	ChangedDate time.Time `json:"changed_date"`
+}
+
+type SwaggerMenuUpdate struct {
+	Base
+	Data DataMenuUpdate `json:"data"`
+}
+
+type DataMenuUpdate struct {
+	MenuId      string               `json:"menu_id"`
+	MenuTypeId  int                  `json:"menu_type_id"`
+	WartegId    string               `json:"warteg_id"`
+	MenuName    string               `json:"menu_name"`
+	MenuDetail  string               `json:"menu_detail"`
+	MenuPicture string               `json:"menu_picture"`
+	MenuPrice   int                  `json:"menu_price"`
+	PriceChange *DataMenuPriceChange `json:"price_change"`
+}
+
+type SwaggerMenuPriceChange struct {
+	Base
+	Data DataMenuPriceChange `json:"data"`
+}
+
+type SwaggerMenuPriceChanges struct {
+	Base
+	Data []DataMenuPriceChange `json:"data"`
+}
+
+type DataMenuPriceChange struct {
+	ChangeId      string     `json:"change_id"`
+	MenuId        string     `json:"menu_id"`
+	WartegId      string     `json:"warteg_id"`
+	OldPrice      int        `json:"old_price"`
+	NewPrice      int        `json:"new_price"`
+	Status        string     `json:"status"`
+	RequestedBy   string     `json:"requested_by"`
+	RequestedDate time.Time  `json:"requested_date"`
+	ReviewedBy    *string    `json:"reviewed_by"`
+	ReviewNote    string     `json:"review_note"`
+	ReviewedDate  *time.Time `json:"reviewed_date"`
+}
+
+type SwaggerBrand struct {
+	Base
+	Data DataBrand `json:"data"`
+}
+
+type SwaggerBrands struct {
+	Base
+	Data []DataBrand `json:"data"`
+}
+
+type DataBrand struct {
+	BrandId     string    `json:"brand_id"`
+	BrandName   string    `json:"brand_name"`
+	Templates   int       `json:"templates"`
+	Branches    int       `json:"branches"`
+	UpdatedDate time.Time `json:"updated_date"`
+}
+
+type SwaggerBrandMenu struct {
+	Base
+	Data DataBrandMenu `json:"data"`
+}
+
+type SwaggerBrandMenus struct {
+	Base
+	Data []DataBrandMenu `json:"data"`
+}
+
+type DataBrandMenu struct {
+	TemplateId  string                `json:"template_id"`
+	BrandId     string                `json:"brand_id"`
+	MenuTypeId  int                   `json:"menu_type_id"`
+	MenuName    string                `json:"menu_name"`
+	MenuDetail  string                `json:"menu_detail"`
+	MenuPicture string                `json:"menu_picture"`
+	MenuPrice   int                   `json:"menu_price"`
+	UpdatedDate time.Time             `json:"updated_date"`
+	Propagation *DataBrandPropagation `json:"propagation"`
+}
+
+type SwaggerBrandWarteg struct {
+	Base
+	Data DataBrandWarteg `json:"data"`
+}
+
+type DataBrandWarteg struct {
+	WartegId     string                `json:"warteg_id"`
+	BrandId      string                `json:"brand_id"`
+	AssignedDate time.Time             `json:"assigned_date"`
+	Propagation  *DataBrandPropagation `json:"propagation"`
+}
+
+type SwaggerBrandPropagation struct {
+	Base
+	Data DataBrandPropagation `json:"data"`
+}
+
+type DataBrandPropagation struct {
+	BrandId        string              `json:"brand_id"`
+	Created        int                 `json:"created"`
+	Updated        int                 `json:"updated"`
+	PriceOverrides int                 `json:"price_overrides"`
+	PriceChanges   int                 `json:"price_changes"`
+	PricePending   int                 `json:"price_pending"`
+	Menus          []DataBrandMenuSync `json:"menus"`
+}
+
+type DataBrandMenuSync struct {
+	TemplateId string `json:"template_id"`
+	WartegId   string `json:"warteg_id"`
+	MenuId     string `json:"menu_id"`
+	Action     string `json:"action"`
+	PriceKept  bool   `json:"price_kept"`
+}
+
+type SwaggerBrandDivergence struct {
+	Base
+	Data DataBrandDivergence `json:"data"`
+}
+
+type DataBrandDivergence struct {
+	BrandId   string                 `json:"brand_id"`
+	Templates int                    `json:"templates"`
+	Branches  []DataBranchDivergence `json:"branches"`
+}
+
+type DataBranchDivergence struct {
+	WartegId string               `json:"warteg_id"`
+	InSync   int                  `json:"in_sync"`
+	Diverged []DataMenuDivergence `json:"diverged"`
+}
+
+type DataMenuDivergence struct {
+	TemplateId    string   `json:"template_id"`
+	MenuId        *string  `json:"menu_id"`
+	MenuName      string   `json:"menu_name"`
+	Fields        []string `json:"fields"`
+	TemplatePrice int      `json:"template_price"`
+	BranchPrice   *int     `json:"branch_price"`
+	Status        string   `json:"status"`
+}
+
+type SwaggerAuditLogs struct {
+	Base
+	Data []DataAuditLog `json:"data"`
+}
+
+type DataAuditLog struct {
+	AuditId     int64       `json:"audit_id"`
+	EntityType  string      `json:"entity_type"`
+	EntityId    string      `json:"entity_id"`
+	MenuId      string      `json:"menu_id"`
+	WartegId    string      `json:"warteg_id"`
+	Action      string      `json:"action"`
+	Actor       string      `json:"actor"`
+	RequestId   string      `json:"request_id"`
+	SourceIp    string      `json:"source_ip"`
+	Before      interface{} `json:"before"`
+	After       interface{} `json:"after"`
+	CreatedDate time.Time   `json:"created_date"`
+}
+
+type SwaggerWebhook struct {
+	Base
+	Data DataWebhook `json:"data"`
+}
+
+type SwaggerWebhooks struct {
+	Base
+	Data []DataWebhook `json:"data"`
+}
+
+type DataWebhook struct {
+	WebhookId   string    `json:"webhook_id"`
+	WartegId    string    `json:"warteg_id"`
+	Url         string    `json:"url"`
+	EventTypes  []string  `json:"event_types"`
+	Secret      string    `json:"secret,omitempty"`
+	IsActive    bool      `json:"is_active"`
+	CreatedDate time.Time `json:"created_date"`
+	UpdatedDate time.Time `json:"updated_date"`
+}
+
+type SwaggerWebhookDelivery struct {
+	Base
+	Data DataWebhookDelivery `json:"data"`
+}
+
+type SwaggerWebhookDeliveries struct {
+	Base
+	Data []DataWebhookDelivery `json:"data"`
+}
+
+type DataWebhookDelivery struct {
+	DeliveryId      int64       `json:"delivery_id"`
+	WebhookId       string      `json:"webhook_id"`
+	EventId         string      `json:"event_id"`
+	EventType       string      `json:"event_type"`
+	MenuId          string      `json:"menu_id"`
+	Payload         interface{} `json:"payload"`
+	OccurredDate    time.Time   `json:"occurred_date"`
+	Status          string      `json:"status"`
+	Attempts        int         `json:"attempts"`
+	NextAttemptDate time.Time   `json:"next_attempt_date"`
+	ResponseCode    int         `json:"response_code"`
+	LastError       string      `json:"last_error"`
+	CreatedDate     time.Time   `json:"created_date"`
+	UpdatedDate     time.Time   `json:"updated_date"`
+}
+
+type SwaggerWartegLocation struct {
+	Base
+	Data DataWartegLocation `json:"data"`
+}
+
+type DataWartegLocation struct {
+	WartegId    string    `json:"warteg_id"`
+	Latitude    float64   `json:"latitude"`
+	Longitude   float64   `json:"longitude"`
+	UpdatedDate time.Time `json:"updated_date"`
+}
+
+type SwaggerWartegHours struct {
+	Base
+	Data DataWartegHours `json:"data"`
+}
+
+type DataWartegHours struct {
+	WartegId    string              `json:"warteg_id"`
+	Timezone    string              `json:"timezone"`
+	Hours       []DataWartegHour    `json:"hours"`
+	Closures    []DataWartegClosure `json:"closures"`
+	OpenNow     bool                `json:"open_now"`
+	NextOpening *time.Time          `json:"next_opening"`
+	UpdatedDate time.Time           `json:"updated_date"`
+}
+
+type DataWartegHour struct {
+	Weekday int    `json:"weekday"`
+	Open    string `json:"open"`
+	Close   string `json:"close"`
+}
+
+type DataWartegClosure struct {
+	ClosureDate string `json:"closure_date"`
+	Note        string `json:"note"`
+}
+
+type SwaggerMenuWindows struct {
+	Base
+	Data DataMenuWindows `json:"data"`
+}
+
+type DataMenuWindows struct {
+	MenuId   string           `json:"menu_id"`
+	WartegId string           `json:"warteg_id"`
+	Timezone string           `json:"timezone"`
+	Windows  []DataMenuWindow `json:"windows"`
+}
+
+type DataMenuWindow struct {
+	Weekdays []int  `json:"weekdays"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+}
+
+type SwaggerMenuStatus struct {
+	Base
+	Data DataMenuStatus `json:"data"`
+}
+
+type DataMenuStatus struct {
+	MenuId      string     `json:"menu_id"`
+	WartegId    string     `json:"warteg_id"`
+	Status      string     `json:"status"`
+	PublishAt   *time.Time `json:"publish_at"`
+	UpdatedBy   string     `json:"updated_by"`
+	UpdatedDate *time.Time `json:"updated_date"`
 }
@@ -0,0 +1,33 @@
+-- foodmenu.tb_audit_log definition
+
+CREATE TABLE `tb_audit_log` (
+  `audit_id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `entity_type` varchar(30) NOT NULL,
+  `entity_id` varchar(36) NOT NULL,
+  `menu_id` varchar(36) DEFAULT NULL,
+  `warteg_id` varchar(36) DEFAULT NULL,
+  `action` varchar(10) NOT NULL,
+  `actor` varchar(100) NOT NULL,
+  `request_id` varchar(64) NOT NULL DEFAULT '',
+  `source_ip` varchar(45) NOT NULL DEFAULT '',
+  `before_data` longtext DEFAULT NULL,
+  `after_data` longtext DEFAULT NULL,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`audit_id`),
+  KEY `idx_audit_log_menu` (`menu_id`, `audit_id`),
+  KEY `idx_audit_log_warteg` (`warteg_id`, `audit_id`),
+  KEY `idx_audit_log_actor` (`actor`, `audit_id`),
+  KEY `idx_audit_log_created` (`created_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- the audit trail is append-only, rows can not be changed or removed
+
+DELIMITER //
+
+CREATE TRIGGER `trg_audit_log_no_update` BEFORE UPDATE ON `tb_audit_log`
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'tb_audit_log is append-only'//
+
+CREATE TRIGGER `trg_audit_log_no_delete` BEFORE DELETE ON `tb_audit_log`
+FOR EACH ROW SIGNAL SQLSTATE '45000' SET MESSAGE_TEXT = 'tb_audit_log is append-only'//
+
+DELIMITER ;
@@ -0,0 +1,53 @@
+-- foodmenu.tb_brand definition
+
+CREATE TABLE `tb_brand` (
+  `brand_id` varchar(36) NOT NULL,
+  `brand_name` varchar(255) NOT NULL,
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`brand_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_brand_menu definition
+-- menu templates of a brand, propagated to every branch warteg of the brand
+
+CREATE TABLE `tb_brand_menu` (
+  `template_id` varchar(36) NOT NULL,
+  `brand_id` varchar(36) NOT NULL,
+  `menu_type_id` int(11) NOT NULL,
+  `menu_name` varchar(255) NOT NULL,
+  `menu_detail` varchar(2000) DEFAULT NULL,
+  `menu_picture` varchar(2000) DEFAULT NULL,
+  `menu_price` int(11) NOT NULL,
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`template_id`),
+  KEY `idx_brand_menu_brand` (`brand_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_brand_warteg definition
+-- branch wartegs of a brand, a warteg belongs to at most one brand
+
+CREATE TABLE `tb_brand_warteg` (
+  `warteg_id` varchar(36) NOT NULL,
+  `brand_id` varchar(36) NOT NULL,
+  `assigned_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`warteg_id`),
+  KEY `idx_brand_warteg_brand` (`brand_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_menu_template definition
+-- menu of a branch created from a template, synced_price is the template price last propagated, a branch menu with
+-- another price keeps its own price when the template changes, change_id is the price change waiting for approval
+-- that a propagation requested, synced_price takes its price once it is approved
+
+CREATE TABLE `tb_menu_template` (
+  `template_id` varchar(36) NOT NULL,
+  `warteg_id` varchar(36) NOT NULL,
+  `menu_id` varchar(36) NOT NULL,
+  `synced_price` int(11) NOT NULL,
+  `synced_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `change_id` varchar(36) DEFAULT NULL,
+  PRIMARY KEY (`template_id`, `warteg_id`),
+  UNIQUE KEY `idx_menu_template_menu` (`menu_id`),
+  KEY `idx_menu_template_warteg` (`warteg_id`),
+  KEY `idx_menu_template_change` (`change_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,13 @@
+-- foodmenu.tb_menu_availability definition
+-- menus without a row are available and their portions are not tracked
+
+CREATE TABLE `tb_menu_availability` (
+  `menu_id` varchar(36) NOT NULL,
+  `business_date` date NOT NULL,
+  `is_sold_out` tinyint(1) NOT NULL DEFAULT 0,
+  `stock` int(11) DEFAULT NULL,
+  `daily_stock` int(11) DEFAULT NULL,
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`menu_id`),
+  KEY `idx_menu_availability_date` (`business_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
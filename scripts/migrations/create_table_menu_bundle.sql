@@ -0,0 +1,10 @@
+-- foodmenu.tb_menu_bundle_item definition
+
+CREATE TABLE `tb_menu_bundle_item` (
+  `bundle_menu_id` varchar(36) NOT NULL,
+  `component_menu_id` varchar(36) NOT NULL,
+  `quantity` int(11) NOT NULL DEFAULT 1,
+  `position` int(11) NOT NULL DEFAULT 0,
+  PRIMARY KEY (`bundle_menu_id`, `component_menu_id`),
+  KEY `idx_menu_bundle_item_component` (`component_menu_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,28 @@
+-- foodmenu.tb_menu_change definition
+
+CREATE TABLE `tb_menu_change` (
+  `change_id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `menu_id` varchar(36) NOT NULL,
+  `warteg_id` varchar(36) DEFAULT NULL,
+  `change_type` varchar(10) NOT NULL,
+  `changed_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`change_id`),
+  KEY `idx_menu_change_warteg` (`warteg_id`, `change_id`),
+  KEY `idx_menu_change_menu` (`menu_id`, `change_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_menu_change_sequence definition
+-- its single row is locked by every transaction writing the change log, so change ids commit in increasing order
+
+CREATE TABLE `tb_menu_change_sequence` (
+  `sequence_id` tinyint(4) NOT NULL,
+  `writes` bigint(20) NOT NULL DEFAULT 0,
+  PRIMARY KEY (`sequence_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+INSERT INTO tb_menu_change_sequence (sequence_id) VALUES (1);
+
+-- backfill existing menus so the first sync returns the whole catalog
+
+INSERT INTO tb_menu_change (menu_id, warteg_id, change_type, changed_date)
+SELECT menu_id, warteg_id, 'created', updated_date FROM tb_menu ORDER BY updated_date;
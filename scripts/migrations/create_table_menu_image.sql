@@ -0,0 +1,18 @@
+-- foodmenu.tb_menu_image definition
+
+CREATE TABLE `tb_menu_image` (
+  `image_id` varchar(36) NOT NULL,
+  `menu_id` varchar(36) NOT NULL,
+  `position` int(11) NOT NULL DEFAULT 0,
+  `original_key` varchar(500) NOT NULL,
+  `thumbnail_key` varchar(500) NOT NULL,
+  `webp_key` varchar(500) NOT NULL,
+  `thumbnail_webp_key` varchar(500) NOT NULL,
+  `content_type` varchar(50) NOT NULL,
+  `file_size` int(11) NOT NULL,
+  `width` int(11) NOT NULL,
+  `height` int(11) NOT NULL,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`image_id`),
+  KEY `idx_menu_image_menu` (`menu_id`, `position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,36 @@
+-- foodmenu.tb_modifier_group definition
+
+CREATE TABLE `tb_modifier_group` (
+  `group_id` varchar(36) NOT NULL,
+  `warteg_id` varchar(36) NOT NULL,
+  `group_name` varchar(100) NOT NULL,
+  `min_select` int(11) NOT NULL DEFAULT 0,
+  `max_select` int(11) NOT NULL DEFAULT 1,
+  `is_required` tinyint(1) NOT NULL DEFAULT 0,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`group_id`),
+  KEY `idx_modifier_group_warteg` (`warteg_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_modifier_option definition
+
+CREATE TABLE `tb_modifier_option` (
+  `option_id` varchar(36) NOT NULL,
+  `group_id` varchar(36) NOT NULL,
+  `option_name` varchar(100) NOT NULL,
+  `option_price` int(11) NOT NULL DEFAULT 0,
+  `position` int(11) NOT NULL DEFAULT 0,
+  PRIMARY KEY (`option_id`),
+  KEY `idx_modifier_option_group` (`group_id`, `position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_menu_modifier_group definition
+
+CREATE TABLE `tb_menu_modifier_group` (
+  `menu_id` varchar(36) NOT NULL,
+  `group_id` varchar(36) NOT NULL,
+  `position` int(11) NOT NULL DEFAULT 0,
+  PRIMARY KEY (`menu_id`, `group_id`),
+  KEY `idx_menu_modifier_group_group` (`group_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,34 @@
+-- foodmenu.tb_menu_price_history definition
+
+CREATE TABLE `tb_menu_price_history` (
+  `history_id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `menu_id` varchar(36) NOT NULL,
+  `old_price` int(11) DEFAULT NULL,
+  `new_price` int(11) NOT NULL,
+  `schedule_id` varchar(36) DEFAULT NULL,
+  `changed_by` varchar(100) NOT NULL,
+  `changed_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`history_id`),
+  KEY `idx_menu_price_history_menu` (`menu_id`, `history_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_menu_price_schedule definition
+
+CREATE TABLE `tb_menu_price_schedule` (
+  `schedule_id` varchar(36) NOT NULL,
+  `menu_id` varchar(36) NOT NULL,
+  `new_price` int(11) NOT NULL,
+  `effective_date` timestamp(3) NOT NULL,
+  `status` varchar(10) NOT NULL DEFAULT 'pending',
+  `created_by` varchar(100) NOT NULL,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `applied_date` timestamp(3) NULL DEFAULT NULL,
+  PRIMARY KEY (`schedule_id`),
+  KEY `idx_menu_price_schedule_menu` (`menu_id`),
+  KEY `idx_menu_price_schedule_due` (`status`, `effective_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- backfill current price of existing menus as the start of their history
+
+INSERT INTO tb_menu_price_history (menu_id, old_price, new_price, changed_by, changed_date)
+SELECT menu_id, NULL, menu_price, 'migration', updated_date FROM tb_menu ORDER BY updated_date;
@@ -0,0 +1,25 @@
+-- foodmenu.tb_menu_price_change definition
+-- price changes of menu update beyond the approval threshold wait here until another actor approves or rejects them
+
+CREATE TABLE `tb_menu_price_change` (
+  `change_id` varchar(36) NOT NULL,
+  `menu_id` varchar(36) NOT NULL,
+  `warteg_id` varchar(36) NOT NULL,
+  `old_price` int(11) NOT NULL,
+  `new_price` int(11) NOT NULL,
+  `status` varchar(10) NOT NULL DEFAULT 'pending',
+  `requested_by` varchar(100) NOT NULL,
+  `requested_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `reviewed_by` varchar(100) DEFAULT NULL,
+  `review_note` varchar(255) NOT NULL DEFAULT '',
+  `reviewed_date` timestamp(3) NULL DEFAULT NULL,
+  PRIMARY KEY (`change_id`),
+  KEY `idx_menu_price_change_menu` (`menu_id`, `status`),
+  KEY `idx_menu_price_change_status` (`status`, `requested_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- a due price schedule beyond the approval threshold waits for the price change it requested
+
+ALTER TABLE `tb_menu_price_schedule`
+  ADD COLUMN `change_id` varchar(36) DEFAULT NULL AFTER `applied_date`,
+  ADD KEY `idx_menu_price_schedule_change` (`change_id`);
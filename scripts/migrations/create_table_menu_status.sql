@@ -0,0 +1,12 @@
+-- foodmenu.tb_menu_status definition
+-- menus without a row are published, a draft with publish_at is published by the publish scheduler at that time
+
+CREATE TABLE `tb_menu_status` (
+  `menu_id` varchar(36) NOT NULL,
+  `status` varchar(20) NOT NULL,
+  `publish_at` timestamp(3) NULL DEFAULT NULL,
+  `updated_by` varchar(255) NOT NULL,
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`menu_id`),
+  KEY `idx_menu_status_publish` (`status`, `publish_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,14 @@
+-- foodmenu.tb_menu_variant definition
+
+CREATE TABLE `tb_menu_variant` (
+  `variant_id` varchar(36) NOT NULL,
+  `menu_id` varchar(36) NOT NULL,
+  `variant_name` varchar(100) NOT NULL,
+  `price_type` varchar(10) NOT NULL DEFAULT 'delta',
+  `price` int(11) NOT NULL DEFAULT 0,
+  `is_default` tinyint(1) NOT NULL DEFAULT 0,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`variant_id`),
+  KEY `idx_menu_variant_menu` (`menu_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,12 @@
+-- foodmenu.tb_menu_window definition
+-- menus without a row are served whenever the warteg is, weekdays is a comma separated list where 0 is Sunday and
+-- end_time at or before start_time ends the next day
+
+CREATE TABLE `tb_menu_window` (
+  `menu_id` varchar(36) NOT NULL,
+  `position` int(11) NOT NULL,
+  `weekdays` varchar(20) NOT NULL,
+  `start_time` time NOT NULL,
+  `end_time` time NOT NULL,
+  PRIMARY KEY (`menu_id`, `position`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
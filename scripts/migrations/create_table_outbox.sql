@@ -0,0 +1,20 @@
+-- foodmenu.tb_outbox definition
+
+CREATE TABLE `tb_outbox` (
+  `outbox_id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `event_id` varchar(36) NOT NULL,
+  `event_type` varchar(50) NOT NULL,
+  `aggregate_id` varchar(36) NOT NULL,
+  `warteg_id` varchar(36) DEFAULT NULL,
+  `payload` longtext NOT NULL,
+  `attempts` int(11) NOT NULL DEFAULT 0,
+  `next_attempt_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `last_error` varchar(500) NOT NULL DEFAULT '',
+  `published_date` timestamp(3) NULL DEFAULT NULL,
+  `failed_date` timestamp(3) NULL DEFAULT NULL,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`outbox_id`),
+  UNIQUE KEY `uq_outbox_event` (`event_id`),
+  KEY `idx_outbox_pending` (`published_date`, `failed_date`, `outbox_id`),
+  KEY `idx_outbox_aggregate` (`aggregate_id`, `outbox_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
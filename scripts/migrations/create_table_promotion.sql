@@ -0,0 +1,25 @@
+-- foodmenu.tb_promotion definition
+
+CREATE TABLE `tb_promotion` (
+  `promotion_id` varchar(36) NOT NULL,
+  `promotion_name` varchar(100) NOT NULL,
+  `promotion_type` varchar(20) NOT NULL,
+  `value` int(11) NOT NULL DEFAULT 0,
+  `buy_quantity` int(11) NOT NULL DEFAULT 0,
+  `get_quantity` int(11) NOT NULL DEFAULT 0,
+  `scope_type` varchar(20) NOT NULL,
+  `scope_id` varchar(36) NOT NULL,
+  `warteg_id` varchar(36) NOT NULL DEFAULT '',
+  `start_date` timestamp(3) NOT NULL,
+  `end_date` timestamp(3) NULL DEFAULT NULL,
+  `daily_start` varchar(5) NOT NULL DEFAULT '',
+  `daily_end` varchar(5) NOT NULL DEFAULT '',
+  `days_of_week` varchar(20) NOT NULL DEFAULT '',
+  `boundary_date` timestamp(3) NULL DEFAULT NULL,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3) ON UPDATE CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`promotion_id`),
+  KEY `idx_promotion_scope` (`scope_type`, `scope_id`),
+  KEY `idx_promotion_warteg` (`warteg_id`),
+  KEY `idx_promotion_end` (`end_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,12 @@
+-- foodmenu.tb_warteg definition
+-- location holds the same coordinates as latitude and longitude for the spatial index, it needs mysql 8
+
+CREATE TABLE `tb_warteg` (
+  `warteg_id` varchar(36) NOT NULL,
+  `latitude` decimal(9,6) NOT NULL,
+  `longitude` decimal(9,6) NOT NULL,
+  `location` point NOT NULL SRID 4326,
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`warteg_id`),
+  SPATIAL KEY `idx_warteg_location` (`location`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
@@ -0,0 +1,29 @@
+-- foodmenu.tb_warteg_schedule definition
+-- wartegs without a schedule are treated as always open
+
+CREATE TABLE `tb_warteg_schedule` (
+  `warteg_id` varchar(36) NOT NULL,
+  `timezone` varchar(64) NOT NULL,
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`warteg_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_warteg_hours definition
+-- close_time at or before open_time ends the next day, 24:00:00 is the end of the day
+
+CREATE TABLE `tb_warteg_hours` (
+  `warteg_id` varchar(36) NOT NULL,
+  `weekday` tinyint(1) NOT NULL,
+  `open_time` time NOT NULL,
+  `close_time` time NOT NULL,
+  PRIMARY KEY (`warteg_id`, `weekday`, `open_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_warteg_closure definition
+
+CREATE TABLE `tb_warteg_closure` (
+  `warteg_id` varchar(36) NOT NULL,
+  `closure_date` date NOT NULL,
+  `note` varchar(255) NOT NULL DEFAULT '',
+  PRIMARY KEY (`warteg_id`, `closure_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
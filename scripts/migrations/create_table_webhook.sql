@@ -0,0 +1,36 @@
+-- foodmenu.tb_webhook definition
+
+CREATE TABLE `tb_webhook` (
+  `webhook_id` varchar(36) NOT NULL,
+  `warteg_id` varchar(36) NOT NULL,
+  `url` varchar(500) NOT NULL,
+  `event_types` varchar(255) NOT NULL DEFAULT '',
+  `secret` varchar(100) NOT NULL,
+  `is_active` tinyint(1) NOT NULL DEFAULT 1,
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`webhook_id`),
+  KEY `idx_webhook_warteg` (`warteg_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;
+
+-- foodmenu.tb_webhook_delivery definition
+
+CREATE TABLE `tb_webhook_delivery` (
+  `delivery_id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `webhook_id` varchar(36) NOT NULL,
+  `event_id` varchar(36) NOT NULL,
+  `event_type` varchar(50) NOT NULL,
+  `menu_id` varchar(36) NOT NULL,
+  `payload` longtext NOT NULL,
+  `occurred_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `status` varchar(20) NOT NULL DEFAULT 'pending',
+  `attempts` int(11) NOT NULL DEFAULT 0,
+  `next_attempt_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `response_code` int(11) NOT NULL DEFAULT 0,
+  `last_error` varchar(500) NOT NULL DEFAULT '',
+  `created_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  `updated_date` timestamp(3) NOT NULL DEFAULT CURRENT_TIMESTAMP(3),
+  PRIMARY KEY (`delivery_id`),
+  UNIQUE KEY `uq_webhook_delivery_event` (`webhook_id`, `event_id`),
+  KEY `idx_webhook_delivery_due` (`status`, `next_attempt_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8;